@@ -32,6 +32,7 @@ import (
 
 	"github.com/release-argus/Argus/config"
 	"github.com/release-argus/Argus/db"
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/testing"
 	"github.com/release-argus/Argus/web"
@@ -48,6 +49,31 @@ var (
 		false,
 		"Print the fully-parsed config.",
 	)
+	dataMigrateOnlyFlag = flag.Bool(
+		"data.migrate-only",
+		false,
+		"Apply any pending database migrations, then exit.",
+	)
+	dataExportFlag = flag.String(
+		"data.export",
+		"",
+		"Write a backup archive of the config and database to this path, then exit.",
+	)
+	dataImportFlag = flag.String(
+		"data.import",
+		"",
+		"Import the backup archive at this path, then exit. Stop any running Argus first.",
+	)
+	dataImportModeFlag = flag.String(
+		"data.import-mode",
+		dbtype.ImportMerge,
+		"How --data.import applies the archive: merge, or replace (also replaces the config file).",
+	)
+	dataImportMapFlag = flag.String(
+		"data.import-map",
+		"",
+		"Comma-separated 'archive=current' service ID mappings for --data.import.",
+	)
 	testCommandsFlag = flag.String(
 		"test.commands",
 		"",
@@ -93,6 +119,36 @@ func run() (exitCode int) {
 	testing.RunAndExit(testing.CommandTest(testCommandsFlag, &cfg), testCommandsFlag)
 	testing.RunAndExit(testing.NotifyTest(testNotifyFlag, &cfg), testNotifyFlag)
 	testing.RunAndExit(testing.ServiceTest(testServiceFlag, &cfg), testServiceFlag)
+	// data.migrate-only
+	if *dataMigrateOnlyFlag {
+		if ok := db.Migrate(&cfg); !ok {
+			<-exitCodeChannel
+			return 1
+		}
+		return 0
+	}
+	// data.export
+	if *dataExportFlag != "" {
+		if ok := db.ExportFile(&cfg, *dataExportFlag); !ok {
+			<-exitCodeChannel
+			return 1
+		}
+		return 0
+	}
+	// data.import
+	if *dataImportFlag != "" {
+		serviceIDs, err := dbtype.ParseServiceIDs(*dataImportMapFlag)
+		if err != nil {
+			logx.Error(err, logx.LogFrom{Primary: "data.import-map"}, true)
+			return 1
+		}
+		opts := dbtype.ImportOptions{Mode: *dataImportModeFlag, ServiceIDs: serviceIDs}
+		if ok := db.ImportFile(&cfg, *dataImportFlag, opts); !ok {
+			<-exitCodeChannel
+			return 1
+		}
+		return 0
+	}
 
 	// Count of active services to monitor (if log level INFO or above).
 	if logx.Level() > 1 {
@@ -128,7 +184,7 @@ func run() (exitCode int) {
 		})
 
 		// Track all targets for changes in version and act on any found changes.
-		go cfg.Service.Track(&cfg.Order, &cfg.OrderMu)
+		cfg.Service.Track(gCtx, &cfg.Order, &cfg.OrderMu)
 
 		// Web server.
 		g.Go(func() error {
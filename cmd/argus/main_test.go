@@ -30,6 +30,11 @@ import (
 func resetFlags() {
 	configFile = new("")
 	configCheckFlag = new(false)
+	dataMigrateOnlyFlag = new(false)
+	dataExportFlag = new("")
+	dataImportFlag = new("")
+	dataImportModeFlag = new("merge")
+	dataImportMapFlag = new("")
 	testCommandsFlag = new("")
 	testNotifyFlag = new("")
 	testServiceFlag = new("")
@@ -40,6 +45,7 @@ func TestRun(t *testing.T) {
 	tests := []struct {
 		name           string
 		file           func(path string)
+		migrateOnly    bool
 		preStartFunc   func(baseDir string)
 		outputContains *[]string
 		outputExcludes *[]string
@@ -70,6 +76,32 @@ func TestRun(t *testing.T) {
 			},
 			exitCode: new(1),
 		},
+		{
+			name:        "data.migrate-only - migrates and exits without starting the server",
+			file:        testYAML_Argus,
+			migrateOnly: true,
+			outputContains: &[]string{
+				"is at schema version",
+			},
+			outputExcludes: &[]string{
+				"Listening on ",
+			},
+			exitCode: new(0),
+		},
+		{
+			name:        "data.migrate-only - db invalid format",
+			file:        testYAML_Argus,
+			migrateOnly: true,
+			preStartFunc: func(baseDir string) {
+				// Create an invalid database file.
+				dbFile := filepath.Join(baseDir, "argus.db")
+				_ = os.WriteFile(dbFile, []byte("invalid format"), 0644)
+			},
+			outputContains: &[]string{
+				"file is not a database",
+			},
+			exitCode: new(1),
+		},
 		{
 			name: "config with no services",
 			file: testYAML_NoServices,
@@ -106,6 +138,7 @@ func TestRun(t *testing.T) {
 			tc.file(file)
 			resetFlags()
 			configFile = &file
+			dataMigrateOnlyFlag = &tc.migrateOnly
 			env := map[string]string{
 				"ARGUS_SERVICE_LATEST_VERSION_GITHUB_ACCESS_TOKEN": test.GitHubToken(t),
 				"ARGUS_DATA_DATABASE_FILE":                         filepath.Join(tempDir, "argus.db"),
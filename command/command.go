@@ -16,12 +16,15 @@
 package command
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
 	"os/exec"
 	"time"
 
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	serviceinfo "github.com/release-argus/Argus/service/status/info"
 	"github.com/release-argus/Argus/util"
@@ -94,6 +97,7 @@ func (c *Controller) ExecIndex(
 
 	// Announce.
 	c.AnnounceCommand(index, serviceInfo)
+	c.ServiceStatus.Audit(dbtype.AuditCommandRun, (c.Command)[index].String(), err)
 
 	metricResult := metric.ActionResultSuccess
 	if failed {
@@ -137,3 +141,32 @@ func (c *Command) Exec(logFrom logx.LogFrom) error {
 	//nolint:wrapcheck
 	return err
 }
+
+// Output executes the command, killing it if it runs longer than timeout (when non-zero),
+// and returns what it wrote to stdout and stderr.
+func (c *Command) Output(timeout time.Duration) ([]byte, []byte, error) {
+	if c == nil || len(*c) == 0 {
+		return nil, nil, errors.New("no command to execute")
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	//#nosec G204 -- Command is user defined.
+	cmd := exec.CommandContext(ctx, (*c)[0], (*c)[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%q timed out after %s", c.String(), timeout)
+	}
+
+	//nolint:wrapcheck
+	return stdout.Bytes(), stderr.Bytes(), err
+}
@@ -19,6 +19,7 @@ package command
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/internal/test"
@@ -331,3 +332,83 @@ func TestCommand_Exec(t *testing.T) {
 		})
 	}
 }
+
+func TestCommand_Output(t *testing.T) {
+	// GIVEN: different Commands to execute.
+	tests := []struct {
+		name           string
+		cmd            *Command
+		timeout        time.Duration
+		stdout, stderr string
+		errRegex       string
+	}{
+		{
+			name:   "stdout",
+			cmd:    &Command{"echo", "1.2.3"},
+			stdout: "1.2.3\n",
+		},
+		{
+			name:   "stderr",
+			cmd:    &Command{"sh", "-c", "echo 1.2.3 >&2"},
+			stderr: "1.2.3\n",
+		},
+		{
+			name:     "fails",
+			cmd:      &Command{"sh", "-c", "echo foo; echo bar >&2; exit 2"},
+			stdout:   "foo\n",
+			stderr:   "bar\n",
+			errRegex: `^exit status 2$`,
+		},
+		{
+			name:     "times out",
+			cmd:      &Command{"sleep", "5"},
+			timeout:  100 * time.Millisecond,
+			errRegex: `^"sleep 5" timed out after 100ms$`,
+		},
+		{
+			name:     "empty",
+			cmd:      &Command{},
+			errRegex: `^no command to execute$`,
+		},
+		{
+			name:     "nil",
+			errRegex: `^no command to execute$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: Output is called on it.
+			stdout, stderr, err := tc.cmd.Output(tc.timeout)
+
+			prefix := fmt.Sprintf(
+				"%s\nCommand.Output(%s)",
+				packageName, tc.timeout,
+			)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+			// AND: stdout/stderr are captured.
+			if string(stdout) != tc.stdout {
+				t.Errorf(
+					"%s stdout mismatch\ngot:  %q\nwant: %q",
+					prefix, stdout, tc.stdout,
+				)
+			}
+			if string(stderr) != tc.stderr {
+				t.Errorf(
+					"%s stderr mismatch\ngot:  %q\nwant: %q",
+					prefix, stderr, tc.stderr,
+				)
+			}
+		})
+	}
+}
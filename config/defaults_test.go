@@ -1989,6 +1989,8 @@ var hardDefaultsStr = test.TrimYAML(`
 		service:
 			options:
 				interval: 10m
+				backoff: 30s
+				backoff_max: 1h
 				semantic_versioning: true
 			latest_version:
 				type: github
@@ -2000,6 +2002,7 @@ var hardDefaultsStr = test.TrimYAML(`
 				github:
 					use_prerelease: false
 				url:
+					method: GET
 					allow_invalid_certs: false
 			deployed_version:
 				type: url
@@ -106,7 +106,7 @@ func (c *Config) AddService(oldServiceID string, newService *service.Service) er
 	}
 
 	// Start tracking the service.
-	go c.Service[newService.ID].Track()
+	c.Service[newService.ID].Track()
 
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/service/latest_version/types/github"
 	"github.com/release-argus/Argus/util"
@@ -104,4 +105,9 @@ func (c *Config) InitDefaults() {
 	// Log.
 	logx.SetTimestamps(*c.Settings.LogTimestamps())
 	logx.SetLevel(c.Settings.LogLevel())
+
+	// Proxy.
+	httpx.SetProxy(c.Settings.Proxy)
+	// Limits.
+	httpx.SetLimits(c.Settings.Limits)
 }
@@ -0,0 +1,86 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/token"
+
+	"github.com/release-argus/Argus/util"
+)
+
+// RenameServices renames the keys of the 'service' block in the raw YAML config,
+// keeping comments and formatting. ids maps each old service ID to its new one.
+func RenameServices(data []byte, ids map[string]string) ([]byte, error) {
+	file, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if len(ids) == 0 || len(file.Docs) == 0 || file.Docs[0].Body == nil {
+		return data, nil
+	}
+
+	root, ok := file.Docs[0].Body.(*ast.MappingNode)
+	if !ok {
+		return nil, errors.New("invalid config: not a mapping")
+	}
+	renamed := 0
+	for _, block := range root.Values {
+		if block.Key.String() != "service" {
+			continue
+		}
+		services, ok := block.Value.(*ast.MappingNode)
+		if !ok {
+			continue
+		}
+
+		existing := make(map[string]bool, len(services.Values))
+		for _, svc := range services.Values {
+			if key, ok := svc.Key.(*ast.StringNode); ok {
+				existing[key.Value] = true
+			}
+		}
+		for _, svc := range services.Values {
+			key, ok := svc.Key.(*ast.StringNode)
+			if !ok {
+				continue
+			}
+			newID, rename := ids[key.Value]
+			if !rename || newID == key.Value {
+				continue
+			}
+			if existing[newID] {
+				if _, movedAway := ids[newID]; !movedAway {
+					return nil, fmt.Errorf("cannot rename service %q to %q, a service with that ID already exists",
+						key.Value, newID)
+				}
+			}
+			key.Value = newID
+			if key.Token.Type != token.SingleQuoteType && !util.RegexCheck(`^\w([\w ./-]*[\w./-])?$`, newID) {
+				key.Token.Type = token.DoubleQuoteType
+			}
+			renamed++
+		}
+	}
+	if renamed == 0 {
+		return data, nil
+	}
+
+	return []byte(file.String()), nil
+}
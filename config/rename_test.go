@@ -0,0 +1,166 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestRenameServices(t *testing.T) {
+	config := test.TrimYAML(`
+		# top comment
+		settings:
+		  log:
+		    level: INFO
+		service:
+		  alpha: # the first
+		    latest_version:
+		      url: https://example.com/alpha
+		  'beta':
+		    latest_version:
+		      url: https://example.com/beta
+	`)
+	// GIVEN: a config and a mapping of service IDs.
+	tests := map[string]struct {
+		data     string
+		ids      map[string]string
+		want     string
+		errRegex string
+	}{
+		"no IDs": {
+			data: config,
+			ids:  nil,
+			want: config,
+		},
+		"IDs not in the config": {
+			data: config,
+			ids:  map[string]string{"gamma": "delta"},
+			want: config,
+		},
+		"rename keeps comments and formatting": {
+			data: config,
+			ids:  map[string]string{"alpha": "one"},
+			want: test.TrimYAML(`
+				# top comment
+				settings:
+				  log:
+				    level: INFO
+				service:
+				  one: # the first
+				    latest_version:
+				      url: https://example.com/alpha
+				  'beta':
+				    latest_version:
+				      url: https://example.com/beta
+			`),
+		},
+		"rename quoted key": {
+			data: config,
+			ids:  map[string]string{"beta": "two"},
+			want: test.TrimYAML(`
+				# top comment
+				settings:
+				  log:
+				    level: INFO
+				service:
+				  alpha: # the first
+				    latest_version:
+				      url: https://example.com/alpha
+				  'two':
+				    latest_version:
+				      url: https://example.com/beta
+			`),
+		},
+		"rename to an ID that needs quoting": {
+			data: config,
+			ids:  map[string]string{"alpha": "one: 1"},
+			want: test.TrimYAML(`
+				# top comment
+				settings:
+				  log:
+				    level: INFO
+				service:
+				  "one: 1": # the first
+				    latest_version:
+				      url: https://example.com/alpha
+				  'beta':
+				    latest_version:
+				      url: https://example.com/beta
+			`),
+		},
+		"swap IDs": {
+			data: config,
+			ids:  map[string]string{"alpha": "beta", "beta": "alpha"},
+			want: test.TrimYAML(`
+				# top comment
+				settings:
+				  log:
+				    level: INFO
+				service:
+				  beta: # the first
+				    latest_version:
+				      url: https://example.com/alpha
+				  'alpha':
+				    latest_version:
+				      url: https://example.com/beta
+			`),
+		},
+		"rename to an existing ID": {
+			data:     config,
+			ids:      map[string]string{"alpha": "beta"},
+			errRegex: `^cannot rename service "alpha" to "beta", a service with that ID already exists$`,
+		},
+		"invalid YAML": {
+			data:     "service: [\n",
+			ids:      map[string]string{"alpha": "one"},
+			errRegex: `^invalid config:\s`,
+		},
+		"not a mapping": {
+			data:     "- alpha\n",
+			ids:      map[string]string{"alpha": "one"},
+			errRegex: `^invalid config: not a mapping$`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: RenameServices is called.
+			got, err := RenameServices([]byte(tc.data), tc.ids)
+
+			prefix := fmt.Sprintf("%s\nRenameServices(%v)", packageName, tc.ids)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(util.ValueOr(tc.errRegex, `^$`), e) {
+				t.Fatalf("%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex)
+			}
+			// AND: the services are renamed.
+			if err == nil && string(got) != tc.want {
+				t.Errorf("%s mismatch\ngot:\n%s\nwant:\n%s",
+					prefix, got, tc.want)
+			}
+		})
+	}
+}
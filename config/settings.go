@@ -19,11 +19,15 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/goccy/go-yaml"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/util"
 )
@@ -89,22 +93,37 @@ var (
 
 // SettingsBase holds the base settings for the binary.
 type SettingsBase struct {
-	Data DataSettings `json:"data,omitzero" yaml:"data,omitzero"` // Data settings
-	Log  LogSettings  `json:"log,omitzero" yaml:"log,omitzero"`   // Log settings
-	Web  WebSettings  `json:"web,omitzero" yaml:"web,omitzero"`   // Web settings
+	Data   DataSettings  `json:"data,omitzero" yaml:"data,omitzero"`     // Data settings
+	Log    LogSettings   `json:"log,omitzero" yaml:"log,omitzero"`       // Log settings
+	Web    WebSettings   `json:"web,omitzero" yaml:"web,omitzero"`       // Web settings
+	Proxy  *httpx.Proxy  `json:"proxy,omitzero" yaml:"proxy,omitzero"`   // Proxy settings
+	Limits *httpx.Limits `json:"limits,omitzero" yaml:"limits,omitzero"` // Outbound request limits
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (s SettingsBase) IsZero() bool {
 	return s.Log.IsZero() &&
 		s.Data.IsZero() &&
-		s.Web.IsZero()
+		s.Web.IsZero() &&
+		s.Proxy == nil &&
+		s.Limits == nil
 }
 
 // CheckValues validates the fields of the receiver.
 func (s *SettingsBase) CheckValues() error {
 	var errs []error
 
+	// Data.
+	if err := s.Data.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "data",
+				Err: err,
+			},
+		)
+	}
+
 	// Web.
 	if err := s.Web.CheckValues(); err != nil {
 		errs = append(
@@ -116,6 +135,28 @@ func (s *SettingsBase) CheckValues() error {
 		)
 	}
 
+	// Proxy.
+	if err := s.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+
+	// Limits.
+	if err := s.Limits.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "limits",
+				Err: err,
+			},
+		)
+	}
+
 	if len(errs) == 0 {
 		return nil
 	}
@@ -151,14 +192,50 @@ func (s *SettingsBase) MapEnvToStruct() error {
 
 // DataSettings holds data-related settings for the binary.
 type DataSettings struct {
-	DatabaseFile string `json:"database_file,omitzero" yaml:"database_file,omitzero"` // Database path.
-	Readonly     *bool  `json:"readonly,omitzero" yaml:"readonly,omitzero"`           // Disable saving config changes to disk.
+	DatabaseFile     string `json:"database_file,omitzero" yaml:"database_file,omitzero"`         // Database path.
+	Readonly         *bool  `json:"readonly,omitzero" yaml:"readonly,omitzero"`                   // Disable saving config changes to disk.
+	HistoryRetention string `json:"history_retention,omitzero" yaml:"history_retention,omitzero"` // Age to prune history at (empty/0 to keep forever).
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (s DataSettings) IsZero() bool {
 	return s.DatabaseFile == "" &&
-		s.Readonly == nil
+		s.Readonly == nil &&
+		s.HistoryRetention == ""
+}
+
+// CheckValues validates the fields of the receiver.
+func (s *DataSettings) CheckValues() error {
+	if s.HistoryRetention == "" {
+		return nil
+	}
+
+	if _, err := parseRetention(s.HistoryRetention); err != nil {
+		return &decode.ErrField{
+			Key:         "history_retention",
+			Value:       s.HistoryRetention,
+			Description: "use 'AhBmCs' or 'Nd' duration format",
+		}
+	}
+
+	return nil
+}
+
+// parseRetention parses a retention duration, accepting a 'd' (days) suffix.
+func parseRetention(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid days %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err == nil && duration < 0 {
+		err = fmt.Errorf("negative duration %q", value)
+	}
+	return duration, err
 }
 
 // LogSettings holds log-related settings for the binary.
@@ -203,6 +280,39 @@ func (b *WebSettingsBasicAuth) CheckValues() {
 	}
 }
 
+// WebSettingsPushToken is a bearer token that may push deployed versions to the API
+// for the services it is scoped to.
+type WebSettingsPushToken struct {
+	Name      string   `json:"name,omitzero" yaml:"name,omitzero"`           // Name to identify the token in logs.
+	Token     string   `json:"token,omitzero" yaml:"token,omitzero"`         // Bearer token.
+	TokenHash [32]byte `json:"-" yaml:"-"`                                   // SHA256 hash.
+	Services  []string `json:"services,omitempty" yaml:"services,omitempty"` // IDs of the services the token may push to (empty=all).
+}
+
+// CheckValues validates the fields of the receiver, and ensures the Token is SHA256 hashed.
+func (t *WebSettingsPushToken) CheckValues() error {
+	if t.Token == "" {
+		return &decode.ErrField{
+			Key:         "token",
+			Description: "bearer token used to push deployed versions",
+		}
+	}
+
+	token := util.EvalEnvVars(t.Token)
+	t.TokenHash = util.GetHash(token)
+	if token == t.Token {
+		// Token doesn't include an env var, so hash the config val.
+		t.Token = util.FmtHash(t.TokenHash)
+	}
+
+	return nil
+}
+
+// Allows reports whether the receiver may push to the service with serviceID.
+func (t *WebSettingsPushToken) Allows(serviceID string) bool {
+	return len(t.Services) == 0 || slices.Contains(t.Services, serviceID)
+}
+
 // FaviconSettings contains the favicon override settings.
 type FaviconSettings struct {
 	SVG string `json:"svg,omitzero" yaml:"svg,omitzero"`
@@ -211,14 +321,16 @@ type FaviconSettings struct {
 
 // WebSettings holds web server settings for the binary.
 type WebSettings struct {
-	ListenHost     string                `json:"listen_host,omitzero" yaml:"listen_host,omitzero"`           // Web listen host.
-	ListenPort     string                `json:"listen_port,omitzero" yaml:"listen_port,omitzero"`           // Web listen port.
-	RoutePrefix    string                `json:"route_prefix,omitzero" yaml:"route_prefix,omitzero"`         // Web endpoint prefix.
-	CertFile       string                `json:"cert_file,omitzero" yaml:"cert_file,omitzero"`               // HTTPS certificate path.
-	KeyFile        string                `json:"pkey_file,omitzero" yaml:"pkey_file,omitzero"`               // HTTPS privkey path.
-	BasicAuth      *WebSettingsBasicAuth `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`             // Basic auth creds.
-	DisabledRoutes []string              `json:"disabled_routes,omitempty" yaml:"disabled_routes,omitempty"` // Disabled API routes.
-	Favicon        *FaviconSettings      `json:"favicon,omitzero" yaml:"favicon,omitzero"`                   // Favicon settings.
+	ListenHost     string                  `json:"listen_host,omitzero" yaml:"listen_host,omitzero"`           // Web listen host.
+	ListenPort     string                  `json:"listen_port,omitzero" yaml:"listen_port,omitzero"`           // Web listen port.
+	RoutePrefix    string                  `json:"route_prefix,omitzero" yaml:"route_prefix,omitzero"`         // Web endpoint prefix.
+	CertFile       string                  `json:"cert_file,omitzero" yaml:"cert_file,omitzero"`               // HTTPS certificate path.
+	KeyFile        string                  `json:"pkey_file,omitzero" yaml:"pkey_file,omitzero"`               // HTTPS privkey path.
+	BasicAuth      *WebSettingsBasicAuth   `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`             // Basic auth creds.
+	PushTokens     []*WebSettingsPushToken `json:"push_tokens,omitempty" yaml:"push_tokens,omitempty"`         // Tokens for pushing deployed versions.
+	DisabledRoutes []string                `json:"disabled_routes,omitempty" yaml:"disabled_routes,omitempty"` // Disabled API routes.
+	EnabledRoutes  []string                `json:"enabled_routes,omitempty" yaml:"enabled_routes,omitempty"`   // Opt-in API routes (backup, restore).
+	Favicon        *FaviconSettings        `json:"favicon,omitzero" yaml:"favicon,omitzero"`                   // Favicon settings.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
@@ -229,7 +341,9 @@ func (s WebSettings) IsZero() bool {
 		s.CertFile == "" &&
 		s.KeyFile == "" &&
 		s.BasicAuth == nil &&
+		len(s.PushTokens) == 0 &&
 		len(s.DisabledRoutes) == 0 &&
+		len(s.EnabledRoutes) == 0 &&
 		s.Favicon == nil
 }
 
@@ -255,6 +369,29 @@ func (s *WebSettings) CheckValues() error {
 		}
 	}
 
+	// PushTokens.
+	var pushTokenErrs []error
+	for index, token := range s.PushTokens {
+		if err := token.CheckValues(); err != nil {
+			pushTokenErrs = append(
+				pushTokenErrs,
+				&decode.ErrKeyField{
+					Key: fmt.Sprintf("- item_%d", index),
+					Err: err,
+				},
+			)
+		}
+	}
+	if len(pushTokenErrs) != 0 {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "push_tokens",
+				Err: errors.Join(pushTokenErrs...),
+			},
+		)
+	}
+
 	// Route Prefix.
 	if s.RoutePrefix != "" {
 		// Ensure the RoutePrefix starts with one '/' and doesn't end with a '/'.
@@ -469,6 +606,18 @@ func (s *Settings) DataReadonly() bool {
 	)
 }
 
+// DataHistoryRetention resolves the age at which history is pruned (0 to keep forever).
+func (s *Settings) DataHistoryRetention() time.Duration {
+	retention, _ := parseRetention(
+		util.FirstNonDefaultWithEnv(
+			s.FromFlags.Data.HistoryRetention,
+			s.Data.HistoryRetention,
+			s.HardDefaults.Data.HistoryRetention,
+		),
+	)
+	return retention
+}
+
 // WebListenHost resolves the host to listen on.
 func (s *Settings) WebListenHost() string {
 	return util.FirstNonDefaultWithEnv(
@@ -21,9 +21,11 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-yaml"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/util"
@@ -307,6 +309,13 @@ func TestDataSettings_IsZero(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "non-empty/HistoryRetention",
+			data: DataSettings{
+				HistoryRetention: "90d",
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -327,6 +336,98 @@ func TestDataSettings_IsZero(t *testing.T) {
 	}
 }
 
+func TestDataSettings_CheckValues(t *testing.T) {
+	// GIVEN: a DataSettings struct.
+	tests := []struct {
+		name     string
+		data     DataSettings
+		errRegex string
+	}{
+		{
+			name:     "empty",
+			data:     DataSettings{},
+			errRegex: `^$`,
+		},
+		{
+			name:     "history_retention, duration",
+			data:     DataSettings{HistoryRetention: "2160h"},
+			errRegex: `^$`,
+		},
+		{
+			name:     "history_retention, days",
+			data:     DataSettings{HistoryRetention: "90d"},
+			errRegex: `^$`,
+		},
+		{
+			name:     "history_retention, invalid",
+			data:     DataSettings{HistoryRetention: "forever"},
+			errRegex: `^history_retention: "forever" <invalid>.*$`,
+		},
+		{
+			name:     "history_retention, negative days",
+			data:     DataSettings{HistoryRetention: "-1d"},
+			errRegex: `^history_retention: "-1d" <invalid>.*$`,
+		},
+		{
+			name:     "history_retention, negative duration",
+			data:     DataSettings{HistoryRetention: "-1h"},
+			errRegex: `^history_retention: "-1h" <invalid>.*$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: CheckValues is called on it.
+			err := tc.data.CheckValues()
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s\nDataSettings.CheckValues() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestSettings_DataHistoryRetention(t *testing.T) {
+	// GIVEN: a Settings with a history_retention.
+	tests := []struct {
+		name      string
+		retention string
+		want      time.Duration
+	}{
+		{name: "unset", retention: "", want: 0},
+		{name: "duration", retention: "36h", want: 36 * time.Hour},
+		{name: "days", retention: "7d", want: 7 * 24 * time.Hour},
+		{name: "invalid", retention: "forever", want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := Settings{}
+			settings.Data.HistoryRetention = tc.retention
+
+			// WHEN: DataHistoryRetention is called.
+			got := settings.DataHistoryRetention()
+
+			// THEN: the duration is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nSettings.DataHistoryRetention() mismatch\ngot:  %s\nwant: %s",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
 func TestLogSettings_IsZero(t *testing.T) {
 	// GIVEN: a LogSettings struct.
 	tests := []struct {
@@ -623,6 +724,135 @@ func TestWebSettingsBasicAuth_CheckValues(t *testing.T) {
 	}
 }
 
+func TestWebSettingsPushToken_CheckValues(t *testing.T) {
+	// GIVEN: a WebSettingsPushToken.
+	tests := []struct {
+		name          string
+		env           map[string]string
+		input         WebSettingsPushToken
+		wantToken     string
+		wantTokenHash string
+		errRegex      string
+	}{
+		{
+			name:     "no token",
+			input:    WebSettingsPushToken{Name: "ci"},
+			errRegex: `^token: <required>.*$`,
+		},
+		{
+			name:          "str token",
+			input:         WebSettingsPushToken{Token: "secret"},
+			wantToken:     util.FmtHash(util.GetHash("secret")),
+			wantTokenHash: util.FmtHash(util.GetHash("secret")),
+			errRegex:      `^$`,
+		},
+		{
+			name:          "hashed token",
+			input:         WebSettingsPushToken{Token: util.FmtHash(util.GetHash("secret"))},
+			wantToken:     util.FmtHash(util.GetHash("secret")),
+			wantTokenHash: util.FmtHash(util.GetHash("secret")),
+			errRegex:      `^$`,
+		},
+		{
+			name: "env token",
+			env: map[string]string{
+				"TEST_WEB_SETTINGS_PUSH_TOKEN__CHECK_VALUES__ONE": "secret",
+			},
+			input:         WebSettingsPushToken{Token: "${TEST_WEB_SETTINGS_PUSH_TOKEN__CHECK_VALUES__ONE}"},
+			wantToken:     "${TEST_WEB_SETTINGS_PUSH_TOKEN__CHECK_VALUES__ONE}",
+			wantTokenHash: util.FmtHash(util.GetHash("secret")),
+			errRegex:      `^$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.SetEnv(t, tc.env)
+
+			// WHEN: CheckValues is called on it.
+			err := tc.input.CheckValues()
+
+			prefix := fmt.Sprintf("%s\nWebSettingsPushToken.CheckValues()", packageName)
+
+			// THEN: the expected error is returned.
+			if e := errfmt.FormatError(err); !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+			if err != nil {
+				return
+			}
+
+			// AND: a plain Token is replaced with its hash.
+			if got := tc.input.Token; got != tc.wantToken {
+				t.Errorf(
+					"%s Token mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantToken,
+				)
+			}
+
+			// AND: the TokenHash is calculated correctly.
+			if got := util.FmtHash(tc.input.TokenHash); got != tc.wantTokenHash {
+				t.Errorf(
+					"%s TokenHash mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantTokenHash,
+				)
+			}
+		})
+	}
+}
+
+func TestWebSettingsPushToken_Allows(t *testing.T) {
+	// GIVEN: a WebSettingsPushToken scoped to some services.
+	tests := []struct {
+		name      string
+		services  []string
+		serviceID string
+		want      bool
+	}{
+		{
+			name:      "unscoped",
+			serviceID: "foo",
+			want:      true,
+		},
+		{
+			name:      "scoped/in scope",
+			services:  []string{"foo", "bar"},
+			serviceID: "bar",
+			want:      true,
+		},
+		{
+			name:      "scoped/out of scope",
+			services:  []string{"foo", "bar"},
+			serviceID: "baz",
+			want:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			token := WebSettingsPushToken{Token: "secret", Services: tc.services}
+
+			// WHEN: Allows is called for a service.
+			got := token.Allows(tc.serviceID)
+
+			// THEN: whether the service is in scope is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nWebSettingsPushToken.Allows(%q) mismatch\ngot:  %t\nwant: %t",
+					packageName, tc.serviceID, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
 // Settings.
 
 func TestWebSettings_IsZero(t *testing.T) {
@@ -689,6 +919,15 @@ func TestWebSettings_IsZero(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "non-empty/PushTokens",
+			data: WebSettings{
+				PushTokens: []*WebSettingsPushToken{
+					{Token: "secret"},
+				},
+			},
+			want: false,
+		},
 		{
 			name: "non-empty/DisabledRouted",
 			data: WebSettings{
@@ -882,6 +1121,43 @@ func TestWebSettings_CheckValues(t *testing.T) {
 			wantPasswordHash: util.FmtHash(util.GetHash("pass")),
 			ok:               true,
 		},
+		{
+			name: "PushTokens/hashed",
+			input: &WebSettings{
+				PushTokens: []*WebSettingsPushToken{
+					{Name: "ci", Token: "secret", Services: []string{"foo"}},
+				},
+			},
+			want: test.TrimYAML(`
+				push_tokens:
+					- name: ci
+						token: ` + util.FmtHash(util.GetHash("secret")) + `
+						services:
+							- foo
+			`),
+			ok: true,
+		},
+		{
+			name: "PushTokens/missing token",
+			input: &WebSettings{
+				PushTokens: []*WebSettingsPushToken{
+					{Name: "ci", Token: "secret"},
+					{Name: "cd"},
+				},
+			},
+			want: test.TrimYAML(`
+				push_tokens:
+					- name: ci
+						token: ` + util.FmtHash(util.GetHash("secret")) + `
+					- name: cd
+			`),
+			ok: false,
+			errRegex: test.TrimYAML(`
+				^push_tokens:
+					- item_1:
+						token: <required>.*$`,
+			),
+		},
 		{
 			name: "Favicon/empty",
 			input: &WebSettings{
@@ -1663,6 +1939,79 @@ func TestSettings_CheckValues(t *testing.T) {
 					pkey_file: .*no such file.*$`,
 			),
 		},
+		{
+			name: "Proxy, valid",
+			input: &Settings{
+				SettingsBase: SettingsBase{
+					Proxy: &httpx.Proxy{
+						URL:     "http://proxy:3128",
+						NoProxy: []string{"internal.example.com"},
+					},
+				},
+			},
+			want: test.TrimYAML(`
+				proxy:
+					url: http://proxy:3128
+					no_proxy:
+						- internal.example.com
+			`),
+			ok: true,
+		},
+		{
+			name: "Proxy, invalid scheme",
+			input: &Settings{
+				SettingsBase: SettingsBase{
+					Proxy: &httpx.Proxy{
+						URL: "ftp://proxy:21",
+					},
+				},
+			},
+			want: test.TrimYAML(`
+				proxy:
+					url: ftp://proxy:21
+			`),
+			errRegex: test.TrimYAML(`
+				^proxy:
+					url: "ftp://proxy:21" <invalid>.*$`,
+			),
+		},
+		{
+			name: "Limits, valid",
+			input: &Settings{
+				SettingsBase: SettingsBase{
+					Limits: &httpx.Limits{
+						PerHost: &httpx.Limit{Rate: "5/s", MaxConcurrent: 2},
+					},
+				},
+			},
+			want: test.TrimYAML(`
+				limits:
+					per_host:
+						rate: 5/s
+						max_concurrent: 2
+			`),
+			ok: true,
+		},
+		{
+			name: "Limits, invalid rate",
+			input: &Settings{
+				SettingsBase: SettingsBase{
+					Limits: &httpx.Limits{
+						Global: &httpx.Limit{Rate: "lots"},
+					},
+				},
+			},
+			want: test.TrimYAML(`
+				limits:
+					global:
+						rate: lots
+			`),
+			errRegex: test.TrimYAML(`
+				^limits:
+					global:
+						rate: "lots" <invalid>.*$`,
+			),
+		},
 	}
 
 	for _, tc := range tests {
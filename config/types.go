@@ -46,6 +46,7 @@ type Config struct {
 
 	DatabaseChannel chan dbtype.Message `json:"-" yaml:"-"` // Channel for broadcasts to the Database.
 	SaveChannel     chan bool           `json:"-" yaml:"-"` // Channel for triggering a save of the config.
+	Database        dbtype.Database     `json:"-" yaml:"-"` // Access to the Database (nil until opened).
 }
 
 // ConfigDecode is an unmarshal-only helper for [Config].
@@ -457,6 +457,8 @@ var configStr = test.TrimYAML(`
 		service:
 			options:
 				interval: 10m
+				backoff: 30s
+				backoff_max: 1h
 				semantic_versioning: true
 			latest_version:
 				type: github
@@ -468,6 +470,7 @@ var configStr = test.TrimYAML(`
 				github:
 					use_prerelease: false
 				url:
+					method: GET
 					allow_invalid_certs: false
 			deployed_version:
 				type: url
@@ -0,0 +1,821 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"modernc.org/sqlite"
+
+	"github.com/release-argus/Argus/config"
+	"github.com/release-argus/Argus/config/decode"
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/util"
+)
+
+// Backup archive layout.
+const (
+	archiveFormat       = 1
+	archiveManifestName = "manifest.json"
+	archiveConfigName   = "config.yml"
+	archiveDatabaseName = "argus.db"
+	maxArchiveEntrySize = 1 << 30 // 1 GiB.
+)
+
+// archiveManifest describes the contents of a backup archive.
+type archiveManifest struct {
+	Format        int      `json:"format"`
+	CreatedAt     string   `json:"created_at"`
+	ArgusVersion  string   `json:"argus_version,omitempty"`
+	SchemaVersion int      `json:"schema_version"`
+	Services      []string `json:"services"`
+}
+
+// archive is a backup archive, extracted for validation.
+type archive struct {
+	manifest     *archiveManifest
+	hasConfig    bool
+	config       []byte
+	databaseFile string
+}
+
+// statusRow is a row of the status table.
+type statusRow struct {
+	id                       string
+	latestVersion            string
+	latestVersionTimestamp   string
+	deployedVersion          string
+	deployedVersionTimestamp string
+	approvedVersion          string
+	instances                string // Encoded versions of the fleet instances.
+}
+
+// archiveState is the Database state held in a backup archive.
+type archiveState struct {
+	status  []statusRow
+	history []dbtype.Event
+	audit   []dbtype.AuditEntry
+}
+
+// snapshot writes a consistent copy of the database to path with SQLite's online backup API,
+// so it can be taken while the Handler is writing.
+func (api *api) snapshot(ctx context.Context, path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err //nolint:wrapcheck
+	}
+
+	conn, err := api.db.Conn(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error { //nolint:wrapcheck
+		backuper, ok := driverConn.(interface {
+			NewBackup(dstURI string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return errors.New("database driver does not support online backups")
+		}
+
+		backup, err := backuper.NewBackup(path)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		if _, err := backup.Step(-1); err != nil {
+			_ = backup.Finish()
+			return err //nolint:wrapcheck
+		}
+		return backup.Finish() //nolint:wrapcheck
+	})
+}
+
+// Export writes a backup archive (a gzipped tarball) of the database to w,
+// including the config file if opts.Config.
+func (api *api) Export(ctx context.Context, w io.Writer, opts dbtype.ExportOptions) error {
+	dir, err := os.MkdirTemp("", "argus-export-")
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Database.
+	databaseFile := filepath.Join(dir, archiveDatabaseName)
+	if err := api.snapshot(ctx, databaseFile); err != nil {
+		return fmt.Errorf("export database: %w", err)
+	}
+	snapshot, err := sql.Open("sqlite", databaseFile)
+	if err != nil {
+		return fmt.Errorf("export database: %w", err)
+	}
+	schemaVersion, err := readSchemaVersion(ctx, snapshot)
+	_ = snapshot.Close()
+	if err != nil {
+		return fmt.Errorf("export database: %w", err)
+	}
+
+	// Config.
+	var configData []byte
+	if opts.Config && api.config.File != "" {
+		//#nosec G304 -- The config file in use.
+		if configData, err = os.ReadFile(api.config.File); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("export config: %w", err)
+		}
+	}
+
+	// Manifest.
+	api.config.OrderMu.RLock()
+	services := slices.Clone(api.config.Order)
+	api.config.OrderMu.RUnlock()
+	manifestData, err := decode.Marshal("json", archiveManifest{
+		Format:        archiveFormat,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		ArgusVersion:  util.Version,
+		SchemaVersion: schemaVersion,
+		Services:      services,
+	})
+	if err != nil {
+		return fmt.Errorf("export manifest: %w", err)
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := writeArchiveEntry(tarWriter, archiveManifestName, manifestData); err != nil {
+		return err
+	}
+	if opts.Config {
+		if err := writeArchiveEntry(tarWriter, archiveConfigName, configData); err != nil {
+			return err
+		}
+	}
+	if err := writeArchiveFile(tarWriter, archiveDatabaseName, databaseFile); err != nil {
+		return err
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	return nil
+}
+
+// writeArchiveEntry writes data to the archive as the file name.
+func writeArchiveEntry(tarWriter *tar.Writer, name string, data []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0_600,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+	}); err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+	if _, err := tarWriter.Write(data); err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// writeArchiveFile writes the file at path to the archive as name.
+func writeArchiveFile(tarWriter *tar.Writer, name, path string) error {
+	//#nosec G304 -- Temporary file created by Export.
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0_600,
+		Size:     fileInfo.Size(),
+		ModTime:  fileInfo.ModTime(),
+	}); err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return fmt.Errorf("export %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// readArchive extracts the backup archive in r to dir, and checks it holds exactly
+// a supported manifest, a database and optionally a config.
+func readArchive(r io.Reader, dir string) (*archive, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", dbtype.ErrInvalidArchive, err)
+	}
+	defer gzipReader.Close()
+
+	var (
+		result    archive
+		tarReader = tar.NewReader(gzipReader)
+	)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", dbtype.ErrInvalidArchive, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("%w: unexpected entry %q", dbtype.ErrInvalidArchive, header.Name)
+		}
+		if header.Size > maxArchiveEntrySize {
+			return nil, fmt.Errorf("%w: %q is too large (%d bytes)",
+				dbtype.ErrInvalidArchive, header.Name, header.Size)
+		}
+		reader := io.LimitReader(tarReader, maxArchiveEntrySize)
+
+		switch header.Name {
+		case archiveManifestName:
+			if result.manifest != nil {
+				return nil, fmt.Errorf("%w: duplicate %q", dbtype.ErrInvalidArchive, header.Name)
+			}
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", dbtype.ErrInvalidArchive, err)
+			}
+			result.manifest = &archiveManifest{}
+			if err := decode.Unmarshal("json", data, result.manifest); err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", dbtype.ErrInvalidArchive, header.Name, err)
+			}
+		case archiveConfigName:
+			if result.hasConfig {
+				return nil, fmt.Errorf("%w: duplicate %q", dbtype.ErrInvalidArchive, header.Name)
+			}
+			result.hasConfig = true
+			if result.config, err = io.ReadAll(reader); err != nil {
+				return nil, fmt.Errorf("%w: %w", dbtype.ErrInvalidArchive, err)
+			}
+		case archiveDatabaseName:
+			if result.databaseFile != "" {
+				return nil, fmt.Errorf("%w: duplicate %q", dbtype.ErrInvalidArchive, header.Name)
+			}
+			result.databaseFile = filepath.Join(dir, archiveDatabaseName)
+			if err := writeFile(result.databaseFile, reader); err != nil {
+				return nil, fmt.Errorf("%w: %w", dbtype.ErrInvalidArchive, err)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unexpected entry %q", dbtype.ErrInvalidArchive, header.Name)
+		}
+	}
+
+	switch {
+	case result.manifest == nil:
+		return nil, fmt.Errorf("%w: missing %q", dbtype.ErrInvalidArchive, archiveManifestName)
+	case result.databaseFile == "":
+		return nil, fmt.Errorf("%w: missing %q", dbtype.ErrInvalidArchive, archiveDatabaseName)
+	case result.manifest.Format != archiveFormat:
+		return nil, fmt.Errorf("%w: unsupported format %d, want %d",
+			dbtype.ErrInvalidArchive, result.manifest.Format, archiveFormat)
+	}
+
+	return &result, nil
+}
+
+// writeFile writes the contents of r to a new file at path.
+func writeFile(path string, r io.Reader) error {
+	//#nosec G304 -- path is within a directory we created.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0_600)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		_ = file.Close()
+		return err //nolint:wrapcheck
+	}
+
+	return file.Close() //nolint:wrapcheck
+}
+
+// readSchemaVersion returns the schema version of db, without creating the schema_version table.
+func readSchemaVersion(ctx context.Context, db *sql.DB) (int, error) {
+	exists, err := tableExists(ctx, db, "schema_version")
+	if err != nil || !exists {
+		return 0, err
+	}
+
+	var version int
+	if err := db.QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(version), 0) FROM schema_version;",
+	).Scan(&version); err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return version, nil
+}
+
+// tableExists reports whether db has a table called name.
+func tableExists(ctx context.Context, db *sql.DB, name string) (bool, error) {
+	var count int
+	if err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?;",
+		name,
+	).Scan(&count); err != nil {
+		return false, err //nolint:wrapcheck
+	}
+
+	return count != 0, nil
+}
+
+// readState validates the database of the archive and reads its state.
+// Archives from older schema versions are read as-is, as the columns read here
+// exist in every version (other than instances, which is read as empty if missing).
+func (a *archive) readState(ctx context.Context) (*archiveState, error) {
+	db, err := sql.Open("sqlite", "file:"+a.databaseFile+"?mode=ro")
+	if err != nil {
+		return nil, fmt.Errorf("%w: database: %w", dbtype.ErrInvalidArchive, err)
+	}
+	defer db.Close()
+
+	var integrity string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check;").Scan(&integrity); err != nil {
+		return nil, fmt.Errorf("%w: database: %w", dbtype.ErrInvalidArchive, err)
+	}
+	if integrity != "ok" {
+		return nil, fmt.Errorf("%w: database: integrity check failed: %s",
+			dbtype.ErrInvalidArchive, integrity)
+	}
+	schemaVersion, err := readSchemaVersion(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("%w: database: %w", dbtype.ErrInvalidArchive, err)
+	}
+	if schemaVersion != a.manifest.SchemaVersion {
+		return nil, fmt.Errorf("%w: database: schema version %d does not match the manifest (%d)",
+			dbtype.ErrInvalidArchive, schemaVersion, a.manifest.SchemaVersion)
+	}
+	if schemaVersion > latestSchemaVersion() {
+		return nil, fmt.Errorf("%w: schema version %d is newer than this release supports (%d)",
+			dbtype.ErrInvalidArchive, schemaVersion, latestSchemaVersion())
+	}
+
+	instancesColumn := "''"
+	if exists, err := columnExists(ctx, db, "status", "instances"); err != nil {
+		return nil, fmt.Errorf("%w: database: %w", dbtype.ErrInvalidArchive, err)
+	} else if exists {
+		instancesColumn = "COALESCE(instances, '')"
+	}
+
+	var state archiveState
+	if err := readRows(ctx, db, "status", `
+		SELECT
+			id,
+			COALESCE(latest_version, ''),
+			COALESCE(latest_version_timestamp, ''),
+			COALESCE(deployed_version, ''),
+			COALESCE(deployed_version_timestamp, ''),
+			COALESCE(approved_version, ''),
+			`+instancesColumn+`
+		FROM status
+		ORDER BY id;`,
+		func(rows *sql.Rows) error {
+			var row statusRow
+			err := rows.Scan(&row.id,
+				&row.latestVersion, &row.latestVersionTimestamp,
+				&row.deployedVersion, &row.deployedVersionTimestamp,
+				&row.approvedVersion, &row.instances)
+			state.status = append(state.status, row)
+			return err //nolint:wrapcheck
+		},
+	); err != nil {
+		return nil, err
+	}
+	if err := readRows(ctx, db, "history", `
+		SELECT service_id, type, version, previous_version, source, timestamp
+		FROM history
+		ORDER BY id;`,
+		func(rows *sql.Rows) error {
+			var event dbtype.Event
+			err := rows.Scan(&event.ServiceID, &event.Type,
+				&event.Version, &event.PreviousVersion,
+				&event.Source, &event.Timestamp)
+			state.history = append(state.history, event)
+			return err //nolint:wrapcheck
+		},
+	); err != nil {
+		return nil, err
+	}
+	if err := readRows(ctx, db, "audit", `
+		SELECT timestamp, actor, ip, action, service_id, target, outcome, detail
+		FROM audit
+		ORDER BY id;`,
+		func(rows *sql.Rows) error {
+			var entry dbtype.AuditEntry
+			err := rows.Scan(&entry.Timestamp, &entry.Actor, &entry.IP,
+				&entry.Action, &entry.ServiceID, &entry.Target,
+				&entry.Outcome, &entry.Detail)
+			state.audit = append(state.audit, entry)
+			return err //nolint:wrapcheck
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// columnExists reports whether the table called table in db has a column called name.
+func columnExists(ctx context.Context, db *sql.DB, table, name string) (bool, error) {
+	var count int
+	if err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?;",
+		table, name,
+	).Scan(&count); err != nil {
+		return false, err //nolint:wrapcheck
+	}
+
+	return count != 0, nil
+}
+
+// readRows calls scan for each row of query, if table exists.
+func readRows(ctx context.Context, db *sql.DB, table, query string, scan func(rows *sql.Rows) error) error {
+	exists, err := tableExists(ctx, db, table)
+	if err != nil || !exists {
+		if err != nil {
+			return fmt.Errorf("%w: database: %w", dbtype.ErrInvalidArchive, err)
+		}
+		return nil
+	}
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("%w: database: %s: %w", dbtype.ErrInvalidArchive, table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("%w: database: %s: %w", dbtype.ErrInvalidArchive, table, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: database: %s: %w", dbtype.ErrInvalidArchive, table, err)
+	}
+
+	return nil
+}
+
+// mapServiceIDs renames the services of the state with ids.
+func (s *archiveState) mapServiceIDs(ids map[string]string) error {
+	mapped := func(id string) string {
+		if newID, ok := ids[id]; ok {
+			return newID
+		}
+		return id
+	}
+
+	seen := make(map[string]string, len(s.status))
+	for i := range s.status {
+		id := mapped(s.status[i].id)
+		if other, exists := seen[id]; exists {
+			return fmt.Errorf("%w: services %q and %q would both be imported as %q",
+				dbtype.ErrInvalidArchive, other, s.status[i].id, id)
+		}
+		seen[id] = s.status[i].id
+		s.status[i].id = id
+	}
+	for i := range s.history {
+		s.history[i].ServiceID = mapped(s.history[i].ServiceID)
+	}
+	for i := range s.audit {
+		if s.audit[i].ServiceID != "" {
+			s.audit[i].ServiceID = mapped(s.audit[i].ServiceID)
+		}
+	}
+
+	return nil
+}
+
+// Import validates the backup archive in r, and applies its Database state.
+// The config in the archive is only validated, see [ImportFile].
+func (api *api) Import(ctx context.Context, r io.Reader, opts dbtype.ImportOptions) (dbtype.ImportSummary, error) {
+	_, summary, err := api.importArchive(ctx, r, opts)
+	return summary, err
+}
+
+// importArchive validates the backup archive in r, backs up the database,
+// and then applies the state of the archive in a single transaction.
+func (api *api) importArchive(
+	ctx context.Context,
+	r io.Reader,
+	opts dbtype.ImportOptions,
+) (*archive, dbtype.ImportSummary, error) {
+	summary := dbtype.ImportSummary{Mode: util.ValueOr(opts.Mode, dbtype.ImportMerge)}
+	if summary.Mode != dbtype.ImportMerge && summary.Mode != dbtype.ImportReplace {
+		return nil, summary, fmt.Errorf("invalid import mode %q, must be one of %s, %s",
+			summary.Mode, dbtype.ImportMerge, dbtype.ImportReplace)
+	}
+
+	dir, err := os.MkdirTemp("", "argus-import-")
+	if err != nil {
+		return nil, summary, fmt.Errorf("import: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	a, err := readArchive(r, dir)
+	if err != nil {
+		return nil, summary, err
+	}
+	if a.hasConfig {
+		if a.config, err = config.RenameServices(a.config, opts.ServiceIDs); err != nil {
+			return nil, summary, fmt.Errorf("%w: %s: %w", dbtype.ErrInvalidArchive, archiveConfigName, err)
+		}
+	}
+	state, err := a.readState(ctx)
+	if err != nil {
+		return nil, summary, err
+	}
+	if err := state.mapServiceIDs(opts.ServiceIDs); err != nil {
+		return nil, summary, err
+	}
+
+	// Keep a copy of the state being replaced.
+	backupFile := api.config.Settings.DataDatabaseFile() + ".pre-import.bak"
+	if err := api.snapshot(ctx, backupFile); err != nil {
+		return nil, summary, fmt.Errorf("import - backup to %q: %w", backupFile, err)
+	}
+
+	if summary.History, summary.Audit, err = api.applyState(ctx, state, summary.Mode); err != nil {
+		return nil, summary, err
+	}
+	summary.Services, summary.Unknown = api.loadState(state, summary.Mode)
+
+	return a, summary, nil
+}
+
+// applyState writes the state to the database in a single transaction,
+// returning the number of history events and audit entries added.
+// History and audit already in the database are not duplicated,
+// and the audit is never cleared, so it keeps the record of the import.
+func (api *api) applyState(ctx context.Context, state *archiveState, mode string) (history, audit int, err error) {
+	tx, err := api.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("import: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if mode == dbtype.ImportReplace {
+		for _, table := range []string{"status", "history"} {
+			//#nosec G202 -- table is a constant.
+			if _, err := tx.ExecContext(ctx, "DELETE FROM "+table+";"); err != nil {
+				return 0, 0, fmt.Errorf("import - clear %s: %w", table, err)
+			}
+		}
+	}
+
+	for _, row := range state.status {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO status (
+				id,
+				latest_version,
+				latest_version_timestamp,
+				deployed_version,
+				deployed_version_timestamp,
+				approved_version,
+				instances
+			) VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET
+				latest_version = excluded.latest_version,
+				latest_version_timestamp = excluded.latest_version_timestamp,
+				deployed_version = excluded.deployed_version,
+				deployed_version_timestamp = excluded.deployed_version_timestamp,
+				approved_version = excluded.approved_version,
+				instances = excluded.instances;`,
+			row.id,
+			row.latestVersion, row.latestVersionTimestamp,
+			row.deployedVersion, row.deployedVersionTimestamp,
+			row.approvedVersion,
+			row.instances,
+		); err != nil {
+			return 0, 0, fmt.Errorf("import - status %q: %w", row.id, err)
+		}
+	}
+
+	for _, event := range state.history {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO history (service_id, type, version, previous_version, source, timestamp)
+			SELECT ?, ?, ?, ?, ?, ?
+			WHERE NOT EXISTS (
+				SELECT 1 FROM history
+				WHERE service_id = ? AND type = ? AND version = ? AND timestamp = ?
+			);`,
+			event.ServiceID, event.Type, event.Version, event.PreviousVersion, event.Source, event.Timestamp,
+			event.ServiceID, event.Type, event.Version, event.Timestamp,
+		)
+		if err != nil {
+			return 0, 0, fmt.Errorf("import - history: %w", err)
+		}
+		added, _ := result.RowsAffected()
+		history += int(added)
+	}
+
+	for _, entry := range state.audit {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO audit (timestamp, actor, ip, action, service_id, target, outcome, detail)
+			SELECT ?, ?, ?, ?, ?, ?, ?, ?
+			WHERE NOT EXISTS (
+				SELECT 1 FROM audit
+				WHERE timestamp = ? AND actor = ? AND action = ? AND service_id = ? AND target = ? AND outcome = ?
+			);`,
+			entry.Timestamp, entry.Actor, entry.IP, entry.Action,
+			entry.ServiceID, entry.Target, entry.Outcome, entry.Detail,
+			entry.Timestamp, entry.Actor, entry.Action, entry.ServiceID, entry.Target, entry.Outcome,
+		)
+		if err != nil {
+			return 0, 0, fmt.Errorf("import - audit: %w", err)
+		}
+		added, _ := result.RowsAffected()
+		audit += int(added)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("import: %w", err)
+	}
+
+	return history, audit, nil
+}
+
+// loadState sets the Status of each Service from the imported state, clearing those
+// without state when replacing. It returns the imported service IDs that are (and are not)
+// in the config.
+func (api *api) loadState(state *archiveState, mode string) (services, unknown []string) {
+	api.config.OrderMu.RLock()
+	defer api.config.OrderMu.RUnlock()
+
+	imported := make(map[string]bool, len(state.status))
+	for _, row := range state.status {
+		imported[row.id] = true
+		svc := api.config.Service[row.id]
+		if svc == nil {
+			unknown = append(unknown, row.id)
+			continue
+		}
+
+		services = append(services, row.id)
+		svc.Status.SetLatestVersion(row.latestVersion, row.latestVersionTimestamp, false)
+		svc.Status.SetDeployedVersion(row.deployedVersion, row.deployedVersionTimestamp, false)
+		svc.Status.SetApprovedVersion(row.approvedVersion, false)
+		if err := svc.Status.RestoreInstances(row.instances); err != nil {
+			logx.Error(fmt.Sprintf("import: %q instances, %s", row.id, err), logFrom, true)
+		}
+	}
+
+	if mode == dbtype.ImportReplace {
+		for id, svc := range api.config.Service {
+			if svc == nil || imported[id] {
+				continue
+			}
+			svc.Status.SetLatestVersion("", "", false)
+			svc.Status.SetDeployedVersion("", "", false)
+			svc.Status.SetApprovedVersion("", false)
+			_ = svc.Status.RestoreInstances("")
+		}
+	}
+
+	return services, unknown
+}
+
+// ExportFile writes a backup archive of the config file and database to path.
+func ExportFile(cfg *config.Config, path string) (ok bool) {
+	db, ok := openAndMigrate(cfg.Settings.DataDatabaseFile())
+	if !ok {
+		return
+	}
+	defer db.Close()
+	api := api{config: cfg, db: db}
+
+	if err := writeFileAtomic(path, func(w io.Writer) error {
+		return api.Export(context.Background(), w, dbtype.ExportOptions{Config: true})
+	}); err != nil {
+		logx.Fatal(fmt.Sprintf("ExportFile: %s", err), logFrom)
+		return false
+	}
+
+	logx.Info(fmt.Sprintf("Exported backup to %q", path), logFrom, true)
+	return true
+}
+
+// ImportFile applies the backup archive at path to the database.
+// When replacing, the config file is also replaced by the one in the archive
+// (with the original kept as '<file>.bak'), unless data.readonly is set or the archive has no config.
+func ImportFile(cfg *config.Config, path string, opts dbtype.ImportOptions) (ok bool) {
+	//#nosec G304 -- Importing the file asked for by the user.
+	file, err := os.Open(path)
+	if err != nil {
+		logx.Fatal(fmt.Sprintf("ImportFile: %s", err), logFrom)
+		return false
+	}
+	defer file.Close()
+
+	db, ok := openAndMigrate(cfg.Settings.DataDatabaseFile())
+	if !ok {
+		return
+	}
+	defer db.Close()
+	api := api{config: cfg, db: db}
+
+	a, summary, err := api.importArchive(context.Background(), file, opts)
+	if err != nil {
+		logx.Fatal(fmt.Sprintf("ImportFile: %s", err), logFrom)
+		return false
+	}
+	logx.Info(
+		fmt.Sprintf("Imported %q (%s) - %d services, %d history events, %d audit entries",
+			path, summary.Mode, len(summary.Services)+len(summary.Unknown), summary.History, summary.Audit),
+		logFrom, true,
+	)
+
+	if summary.Mode != dbtype.ImportReplace {
+		return true
+	}
+	if !a.hasConfig {
+		logx.Info("Archive has no config: not replacing "+cfg.File, logFrom, true)
+		return true
+	}
+	if cfg.Settings.DataReadonly() {
+		logx.Info("Readonly mode: not replacing "+cfg.File, logFrom, true)
+		return true
+	}
+	if err := restoreConfig(cfg.File, a.config); err != nil {
+		logx.Fatal(fmt.Sprintf("ImportFile: %s", err), logFrom)
+		return false
+	}
+	logx.Info(fmt.Sprintf("Replaced %q (previous config kept at %q)", cfg.File, cfg.File+".bak"), logFrom, true)
+	return true
+}
+
+// restoreConfig replaces the config file at path with data, keeping the current file as '<path>.bak'.
+func restoreConfig(path string, data []byte) error {
+	//#nosec G304 -- The config file in use.
+	current, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("restore config: %w", err)
+	}
+	if err == nil {
+		if err := os.WriteFile(path+".bak", current, 0_600); err != nil {
+			return fmt.Errorf("restore config: %w", err)
+		}
+	}
+
+	if err := writeFileAtomic(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err //nolint:wrapcheck
+	}); err != nil {
+		return fmt.Errorf("restore config: %w", err)
+	}
+
+	return nil
+}
+
+// writeFileAtomic writes a file at path with write, replacing any existing file only on success.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	defer os.Remove(file.Name())
+
+	if err := write(file); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return os.Rename(file.Name(), path) //nolint:wrapcheck
+}
@@ -0,0 +1,561 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package db
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+)
+
+// seededAPI returns an initialised API with a config file, and state for keep0 and delete0.
+func seededAPI(t *testing.T) *api {
+	t.Helper()
+
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	tAPI.config.File = filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(tAPI.config.File, []byte(test.TrimYAML(`
+		# comment
+		service:
+		  keep0:
+		    latest_version:
+		      type: url
+		      url: https://example.com
+		  delete0:
+		    latest_version:
+		      type: url
+		      url: https://example.com
+	`)), 0_600); err != nil {
+		t.Fatalf("%s\nfailed to write config: %v", packageName, err)
+	}
+
+	for _, id := range []string{"keep0", "delete0"} {
+		tAPI.updateRow(id, []dbtype.Cell{
+			{Column: "latest_version", Value: "1.1.0-" + id},
+			{Column: "latest_version_timestamp", Value: "2026-01-02T00:00:00Z"},
+			{Column: "deployed_version", Value: "1.0.0-" + id},
+			{Column: "deployed_version_timestamp", Value: "2026-01-01T00:00:00Z"},
+			{Column: "approved_version", Value: ""},
+			{Column: "instances", Value: `[{"name":"a","version":"1.0.0-` + id + `"}]`},
+		})
+		tAPI.insertEvents(id, []dbtype.Event{
+			{Type: dbtype.EventLatest, Version: "1.1.0-" + id, Timestamp: "2026-01-02T00:00:00Z"},
+			{Type: dbtype.EventDeployed, Version: "1.0.0-" + id, Timestamp: "2026-01-01T00:00:00Z"},
+		})
+	}
+	tAPI.insertAudit([]dbtype.AuditEntry{
+		{Timestamp: "2026-01-03T00:00:00Z", Actor: "alice", Action: dbtype.AuditServiceEdit,
+			ServiceID: "keep0", Outcome: dbtype.AuditSuccess},
+	})
+
+	return tAPI
+}
+
+// exportArchive returns a backup archive of tAPI, including the config file.
+func exportArchive(t *testing.T, tAPI *api) []byte {
+	t.Helper()
+
+	return exportArchiveWith(t, tAPI, dbtype.ExportOptions{Config: true})
+}
+
+// exportArchiveWith returns a backup archive of tAPI, exported with opts.
+func exportArchiveWith(t *testing.T, tAPI *api, opts dbtype.ExportOptions) []byte {
+	t.Helper()
+
+	var archive bytes.Buffer
+	if err := tAPI.Export(t.Context(), &archive, opts); err != nil {
+		t.Fatalf("%s\nExport failed: %v", packageName, err)
+	}
+	return archive.Bytes()
+}
+
+// buildArchive returns a gzipped tarball of the entries.
+func buildArchive(t *testing.T, entries [][2]string) []byte {
+	t.Helper()
+
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		_ = tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     entry[0],
+			Mode:     0_600,
+			Size:     int64(len(entry[1])),
+		})
+		_, _ = tarWriter.Write([]byte(entry[1]))
+	}
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+	return archive.Bytes()
+}
+
+// archiveEntries returns the contents of each file in the archive.
+func archiveEntries(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("%s\nnot a gzip archive: %v", packageName, err)
+	}
+	entries := make(map[string]string)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		var data bytes.Buffer
+		_, _ = data.ReadFrom(tarReader)
+		entries[header.Name] = data.String()
+	}
+	return entries
+}
+
+func TestAPI_Export(t *testing.T) {
+	// GIVEN: a DB with state, and a config file.
+	tests := []struct {
+		name string
+		opts dbtype.ExportOptions
+	}{
+		{
+			name: "with config",
+			opts: dbtype.ExportOptions{Config: true},
+		},
+		{
+			name: "without config",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tAPI := seededAPI(t)
+
+			// WHEN: Export is called.
+			archive := exportArchiveWith(t, tAPI, tc.opts)
+
+			prefix := fmt.Sprintf("%s\napi.Export(%+v)", packageName, tc.opts)
+
+			// THEN: the archive holds the manifest, database and (if asked for) config file.
+			entries := archiveEntries(t, archive)
+			wantManifest := fmt.Sprintf(
+				`^{"format":1,"created_at":"[^"]+","schema_version":%d,"services":\["delete0","keep0",`,
+				latestSchemaVersion())
+			if got := entries[archiveManifestName]; !util.RegexCheck(wantManifest, got) {
+				t.Errorf("%s manifest mismatch\ngot:  %q\nwant: %q",
+					prefix, got, wantManifest)
+			}
+			gotConfig, hasConfig := entries[archiveConfigName]
+			if hasConfig != tc.opts.Config {
+				t.Errorf("%s config included mismatch\ngot:  %t\nwant: %t",
+					prefix, hasConfig, tc.opts.Config)
+			}
+			if wantConfig, _ := os.ReadFile(tAPI.config.File); hasConfig && gotConfig != string(wantConfig) {
+				t.Errorf("%s config mismatch\ngot:  %q\nwant: %q",
+					prefix, gotConfig, wantConfig)
+			}
+			if got := entries[archiveDatabaseName]; !strings.HasPrefix(got, "SQLite format 3\x00") {
+				t.Errorf("%s database is not a SQLite database\ngot:  %q...",
+					prefix, got[:min(len(got), 16)])
+			}
+		})
+	}
+}
+
+func TestAPI_Import(t *testing.T) {
+	// GIVEN: a backup archive of a DB with state.
+	seeded := seededAPI(t)
+	archiveWithConfig := exportArchive(t, seeded)
+	archiveWithoutConfig := exportArchiveWith(t, seeded, dbtype.ExportOptions{})
+	tests := []struct {
+		name        string
+		noConfig    bool
+		opts        dbtype.ImportOptions
+		wantSummary string
+		wantStatus  map[string]string // service ID -> latest_version in the DB.
+		wantHistory int
+		wantAudit   int
+	}{
+		{
+			name: "merge",
+			opts: dbtype.ImportOptions{},
+			wantSummary: fmt.Sprintf("%+v", dbtype.ImportSummary{
+				Mode: dbtype.ImportMerge, Services: []string{"delete0", "keep0"}, History: 4, Audit: 1}),
+			wantStatus: map[string]string{
+				"delete0": "1.1.0-delete0",
+				"keep0":   "1.1.0-keep0",
+				"keep1":   "3.0.0-keep1"},
+			wantHistory: 5,
+			wantAudit:   2,
+		},
+		{
+			name:     "merge, archive without config",
+			noConfig: true,
+			opts:     dbtype.ImportOptions{},
+			wantSummary: fmt.Sprintf("%+v", dbtype.ImportSummary{
+				Mode: dbtype.ImportMerge, Services: []string{"delete0", "keep0"}, History: 4, Audit: 1}),
+			wantStatus: map[string]string{
+				"delete0": "1.1.0-delete0",
+				"keep0":   "1.1.0-keep0",
+				"keep1":   "3.0.0-keep1"},
+			wantHistory: 5,
+			wantAudit:   2,
+		},
+		{
+			name: "merge, with service IDs mapped",
+			opts: dbtype.ImportOptions{ServiceIDs: map[string]string{"delete0": "keep2", "keep0": "other"}},
+			wantSummary: fmt.Sprintf("%+v", dbtype.ImportSummary{
+				Mode: dbtype.ImportMerge, Services: []string{"keep2"}, Unknown: []string{"other"}, History: 4, Audit: 1}),
+			wantStatus: map[string]string{
+				"keep2": "1.1.0-delete0",
+				"other": "1.1.0-keep0",
+				"keep1": "3.0.0-keep1"},
+			wantHistory: 5,
+			wantAudit:   2,
+		},
+		{
+			name: "replace",
+			opts: dbtype.ImportOptions{Mode: dbtype.ImportReplace},
+			wantSummary: fmt.Sprintf("%+v", dbtype.ImportSummary{
+				Mode: dbtype.ImportReplace, Services: []string{"delete0", "keep0"}, History: 4, Audit: 1}),
+			wantStatus: map[string]string{
+				"delete0": "1.1.0-delete0",
+				"keep0":   "1.1.0-keep0",
+				"keep1":   ""},
+			wantHistory: 4,
+			wantAudit:   2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// AND: a DB with state for keep1.
+			tAPI := testAPI(t)
+			tAPI.initialise()
+			tAPI.updateRow("keep1", []dbtype.Cell{{Column: "latest_version", Value: "3.0.0-keep1"}})
+			tAPI.insertEvents("keep1", []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "3.0.0-keep1", Timestamp: "2026-01-01T00:00:00Z"}})
+			tAPI.insertAudit([]dbtype.AuditEntry{
+				{Timestamp: "2026-01-01T00:00:00Z", Action: dbtype.AuditOrderEdit, Outcome: dbtype.AuditSuccess}})
+
+			archive := archiveWithConfig
+			if tc.noConfig {
+				archive = archiveWithoutConfig
+			}
+
+			// WHEN: the archive is imported.
+			summary, err := tAPI.Import(t.Context(), bytes.NewReader(archive), tc.opts)
+
+			prefix := fmt.Sprintf("%s\napi.Import(%+v)", packageName, tc.opts)
+
+			// THEN: it succeeds, and summarises what was imported.
+			if err != nil {
+				t.Fatalf("%s unexpected error: %v", prefix, err)
+			}
+			if got := fmt.Sprintf("%+v", summary); got != tc.wantSummary {
+				t.Errorf("%s summary mismatch\ngot:  %s\nwant: %s",
+					prefix, got, tc.wantSummary)
+			}
+
+			// AND: the status table holds the expected state.
+			for id, want := range tc.wantStatus {
+				var got string
+				_ = tAPI.db.QueryRow("SELECT latest_version FROM status WHERE id = ?;", id).Scan(&got)
+				if got != want {
+					t.Errorf("%s %q latest_version mismatch\ngot:  %q\nwant: %q",
+						prefix, id, got, want)
+				}
+			}
+
+			// AND: the Status of the services in the config is updated.
+			for id, want := range tc.wantStatus {
+				if svc := tAPI.config.Service[id]; svc != nil && id != "keep1" {
+					if got := svc.Status.LatestVersion(); got != want {
+						t.Errorf("%s %q Status.LatestVersion mismatch\ngot:  %q\nwant: %q",
+							prefix, id, got, want)
+					}
+					// Including the versions of its fleet instances.
+					wantInstance := strings.Replace(want, "1.1.0-", "1.0.0-", 1)
+					if got := svc.Status.Instances(); len(got) != 1 || got[0].Version != wantInstance {
+						t.Errorf("%s %q Status.Instances mismatch\ngot:  %+v\nwant: [{a %s}]",
+							prefix, id, got, wantInstance)
+					}
+				}
+			}
+			if tc.opts.Mode == dbtype.ImportReplace {
+				if got := tAPI.config.Service["keep1"].Status.LatestVersion(); got != "" {
+					t.Errorf("%s %q Status.LatestVersion not cleared\ngot:  %q",
+						prefix, "keep1", got)
+				}
+			}
+
+			// AND: the history and audit were imported.
+			for table, want := range map[string]int{"history": tc.wantHistory, "audit": tc.wantAudit} {
+				var got int
+				_ = tAPI.db.QueryRow("SELECT COUNT(*) FROM " + table + ";").Scan(&got)
+				if got != want {
+					t.Errorf("%s %s rows mismatch\ngot:  %d\nwant: %d",
+						prefix, table, got, want)
+				}
+			}
+
+			// AND: the DB was backed up before the import.
+			if _, err := os.Stat(tAPI.config.Settings.DataDatabaseFile() + ".pre-import.bak"); err != nil {
+				t.Errorf("%s pre-import backup missing: %v",
+					prefix, err)
+			}
+
+			// WHEN: the archive is merged again.
+			summary, err = tAPI.Import(t.Context(), bytes.NewReader(archive), dbtype.ImportOptions{
+				ServiceIDs: tc.opts.ServiceIDs})
+
+			// THEN: no history or audit is duplicated.
+			if err != nil || summary.History != 0 || summary.Audit != 0 {
+				t.Errorf("%s re-import duplicated rows\ngot:  %+v, err=%v\nwant: 0 history, 0 audit",
+					prefix, summary, err)
+			}
+		})
+	}
+}
+
+func TestAPI_Import__invalid(t *testing.T) {
+	seeded := seededAPI(t)
+	entries := archiveEntries(t, exportArchive(t, seeded))
+	manifest := entries[archiveManifestName]
+	// GIVEN: an invalid backup archive.
+	tests := []struct {
+		name     string
+		archive  []byte
+		opts     dbtype.ImportOptions
+		errRegex string
+	}{
+		{
+			name:     "not gzipped",
+			archive:  []byte("not an archive"),
+			errRegex: `^invalid archive: gzip: invalid header$`,
+		},
+		{
+			name: "unexpected entry",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, manifest},
+				{"../../etc/passwd", "root"}}),
+			errRegex: `^invalid archive: unexpected entry "\.\./\.\./etc/passwd"$`,
+		},
+		{
+			name: "duplicate entry",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, manifest},
+				{archiveManifestName, manifest}}),
+			errRegex: `^invalid archive: duplicate "manifest.json"$`,
+		},
+		{
+			name: "missing database",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, manifest},
+				{archiveConfigName, ""}}),
+			errRegex: `^invalid archive: missing "argus.db"$`,
+		},
+		{
+			name: "unsupported format",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, strings.Replace(manifest, `"format":1`, `"format":2`, 1)},
+				{archiveConfigName, ""},
+				{archiveDatabaseName, entries[archiveDatabaseName]}}),
+			errRegex: `^invalid archive: unsupported format 2, want 1$`,
+		},
+		{
+			name: "schema version does not match the manifest",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, strings.Replace(manifest, `"schema_version":`, `"schema_version":1`, 1)},
+				{archiveConfigName, ""},
+				{archiveDatabaseName, entries[archiveDatabaseName]}}),
+			errRegex: `^invalid archive: database: schema version \d+ does not match the manifest \(1\d+\)$`,
+		},
+		{
+			name: "database is not a database",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, manifest},
+				{archiveConfigName, ""},
+				{archiveDatabaseName, "garbage"}}),
+			errRegex: `^invalid archive: database: .*not a database`,
+		},
+		{
+			name: "config is not YAML",
+			archive: buildArchive(t, [][2]string{
+				{archiveManifestName, manifest},
+				{archiveConfigName, "service: [\n"},
+				{archiveDatabaseName, entries[archiveDatabaseName]}}),
+			errRegex: `^invalid archive: config.yml: invalid config: `,
+		},
+		{
+			name:     "mapped IDs collide",
+			archive:  buildArchive(t, [][2]string{{archiveManifestName, manifest}, {archiveConfigName, ""}, {archiveDatabaseName, entries[archiveDatabaseName]}}),
+			opts:     dbtype.ImportOptions{ServiceIDs: map[string]string{"delete0": "keep0"}},
+			errRegex: `^invalid archive: services "[^"]+" and "[^"]+" would both be imported as "keep0"$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// AND: a DB with state.
+			tAPI := seededAPI(t)
+
+			// WHEN: the archive is imported.
+			_, err := tAPI.Import(t.Context(), bytes.NewReader(tc.archive), tc.opts)
+
+			prefix := fmt.Sprintf("%s\napi.Import()", packageName)
+
+			// THEN: it fails as invalid.
+			if !errors.Is(err, dbtype.ErrInvalidArchive) || !util.RegexCheck(tc.errRegex, err.Error()) {
+				t.Errorf("%s error mismatch\ngot:  %v\nwant: %q (ErrInvalidArchive)",
+					prefix, err, tc.errRegex)
+			}
+
+			// AND: the DB is unchanged.
+			var got string
+			_ = tAPI.db.QueryRow("SELECT latest_version FROM status WHERE id = 'keep0';").Scan(&got)
+			if got != "1.1.0-keep0" {
+				t.Errorf("%s DB changed\ngot:  %q\nwant: %q",
+					prefix, got, "1.1.0-keep0")
+			}
+		})
+	}
+}
+
+func TestAPI_Import__invalidMode(t *testing.T) {
+	// GIVEN: an unknown import mode.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	opts := dbtype.ImportOptions{Mode: "overwrite"}
+
+	// WHEN: an archive is imported with it.
+	_, err := tAPI.Import(t.Context(), bytes.NewReader(nil), opts)
+
+	// THEN: it fails.
+	want := `^invalid import mode "overwrite", must be one of merge, replace$`
+	if err == nil || !util.RegexCheck(want, err.Error()) {
+		t.Errorf("%s\napi.Import(%+v) error mismatch\ngot:  %v\nwant: %q",
+			packageName, opts, err, want)
+	}
+}
+
+func TestExportFile_ImportFile(t *testing.T) {
+	// GIVEN: a backup archive exported to a file.
+	seeded := seededAPI(t)
+	_ = seeded.db.Close()
+	seeded.db = nil
+	archiveFile := filepath.Join(t.TempDir(), "argus-backup.tar.gz")
+	releaseStdout := test.CaptureLog(t, logx.Default())
+	if ok := ExportFile(seeded.config, archiveFile); !ok {
+		t.Fatalf("%s\nExportFile failed\nstdout: %q", packageName, releaseStdout())
+	}
+	stdout := releaseStdout()
+	if want := `^INFO: .*Exported backup to "[^"]+argus-backup.tar.gz"\n$`; !util.RegexCheck(want, stdout) {
+		t.Errorf("%s\nExportFile stdout mismatch\ngot:  %q\nwant: %q",
+			packageName, stdout, want)
+	}
+
+	// AND: another Argus with its own config file.
+	tAPI := testAPI(t)
+	tAPI.config.File = filepath.Join(t.TempDir(), "config.yml")
+	originalConfig := "service:\n  something: {}\n"
+	_ = os.WriteFile(tAPI.config.File, []byte(originalConfig), 0_600)
+
+	// WHEN: that archive is imported, replacing the state, with keep0 renamed.
+	releaseStdout = test.CaptureLog(t, logx.Default())
+	ok := ImportFile(tAPI.config, archiveFile, dbtype.ImportOptions{
+		Mode:       dbtype.ImportReplace,
+		ServiceIDs: map[string]string{"keep0": "renamed"},
+	})
+	stdout = releaseStdout()
+
+	prefix := fmt.Sprintf("%s\nImportFile()", packageName)
+
+	// THEN: it succeeds.
+	if !ok {
+		t.Fatalf("%s failed\nstdout: %q", prefix, stdout)
+	}
+	if want := `Imported "[^"]+" \(replace\) - 2 services, 4 history events, 1 audit entries`; !util.RegexCheck(want, stdout) {
+		t.Errorf("%s stdout mismatch\ngot:  %q\nwant: %q",
+			prefix, stdout, want)
+	}
+	// AND: the config file was replaced by the archived one, with keep0 renamed.
+	gotConfig, _ := os.ReadFile(tAPI.config.File)
+	wantConfig := strings.Replace(archiveEntries(t, exportArchive(t, seededAPI(t)))[archiveConfigName],
+		"  keep0:", "  renamed:", 1)
+	if string(gotConfig) != wantConfig {
+		t.Errorf("%s config mismatch\ngot:  %q\nwant: %q",
+			prefix, gotConfig, wantConfig)
+	}
+	// AND: the previous config was kept.
+	if got, _ := os.ReadFile(tAPI.config.File + ".bak"); string(got) != originalConfig {
+		t.Errorf("%s config backup mismatch\ngot:  %q\nwant: %q",
+			prefix, got, originalConfig)
+	}
+	// AND: the state was imported with keep0 renamed.
+	tAPI.initialise()
+	if got := queryRow(t, tAPI.db, "renamed").LatestVersion(); got != "1.1.0-keep0" {
+		t.Errorf("%s renamed latest_version mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "1.1.0-keep0")
+	}
+}
+
+func TestImportFile__fail(t *testing.T) {
+	// GIVEN: an archive that does not exist.
+	tAPI := testAPI(t)
+	releaseStdout := test.CaptureLog(t, logx.Default())
+
+	// WHEN: it is imported.
+	resultChannel := make(chan bool, 1)
+	resultChannel <- ImportFile(tAPI.config, filepath.Join(t.TempDir(), "missing.tar.gz"), dbtype.ImportOptions{})
+
+	prefix := fmt.Sprintf("%s\nImportFile()", packageName)
+
+	// THEN: it fails.
+	if err := test.AssertChannelBool(
+		t,
+		false,
+		resultChannel,
+		logx.ExitCodeChannel(),
+		releaseStdout,
+	); err != nil {
+		t.Fatal(prefix + err.Error())
+	}
+	stdout := releaseStdout()
+	if want := `^FATAL: .*ImportFile: open .*missing.tar.gz: no such file or directory`; !util.RegexCheck(want, stdout) {
+		t.Errorf("%s stdout mismatch\ngot:  %q\nwant: %q",
+			prefix, stdout, want)
+	}
+}
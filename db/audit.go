@@ -0,0 +1,170 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package db provides database functionality for Argus to keep track of versions found/deployed/approved.
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// createAuditTable ensures the audit table, and its indexes, exist.
+func createAuditTable(db schemaExecer) bool {
+	if _, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS audit (
+			id         INTEGER  PRIMARY KEY AUTOINCREMENT,
+			timestamp  DATETIME NOT NULL,
+			actor      TEXT     DEFAULT  '',
+			ip         TEXT     DEFAULT  '',
+			action     TEXT     NOT NULL,
+			service_id TEXT     DEFAULT  '',
+			target     TEXT     DEFAULT  '',
+			outcome    TEXT     DEFAULT  '',
+			detail     TEXT     DEFAULT  ''
+		);
+		CREATE INDEX IF NOT EXISTS audit_timestamp
+			ON audit (timestamp);
+		CREATE INDEX IF NOT EXISTS audit_service_id_timestamp
+			ON audit (service_id, timestamp);`); err != nil {
+		logx.Fatal(fmt.Sprintf("createAuditTable: %s", err), logFrom)
+		return false
+	}
+
+	return true
+}
+
+// insertAudit records the entries in the audit table.
+func (api *api) insertAudit(entries []dbtype.AuditEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?,?,?,?,?,?,?,?),", len(entries)), ",")
+	params := make([]any, 0, len(entries)*8)
+	for _, entry := range entries {
+		params = append(params,
+			entry.Timestamp,
+			entry.Actor,
+			entry.IP,
+			entry.Action,
+			entry.ServiceID,
+			entry.Target,
+			entry.Outcome,
+			entry.Detail)
+	}
+
+	//#nosec G201 -- placeholders is safe.
+	sqlStmt := fmt.Sprintf(`
+		INSERT INTO audit (timestamp, actor, ip, action, service_id, target, outcome, detail)
+		VALUES %s;`,
+		placeholders,
+	)
+	if logx.IsLevel("DEBUG") {
+		logx.Debug(
+			fmt.Sprintf("%s, %v", sqlStmt, params),
+			logFrom,
+			true,
+		)
+	}
+	if _, err := api.db.Exec(sqlStmt, params...); err != nil {
+		logx.Error(
+			fmt.Sprintf("insertAudit: %q %v, %s", sqlStmt, params, err),
+			logFrom,
+			true,
+		)
+	}
+}
+
+// Audit returns the audit entries matching query (newest first), and the total number of matches.
+func (api *api) Audit(ctx context.Context, query dbtype.AuditQuery) ([]dbtype.AuditEntry, int, error) {
+	var (
+		conditions []string
+		params     []any
+	)
+	for _, filter := range []struct {
+		condition string
+		value     string
+	}{
+		{"service_id = ?", query.ServiceID},
+		{"actor = ?", query.Actor},
+		{"action = ?", query.Action},
+		{"outcome = ?", query.Outcome},
+		{"timestamp >= ?", query.Since},
+		{"timestamp < ?", query.Until},
+	} {
+		if filter.value != "" {
+			conditions = append(conditions, filter.condition)
+			params = append(params, filter.value)
+		}
+	}
+	var where string
+	if len(conditions) != 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	//#nosec G202 -- where only holds placeholders.
+	if err := api.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM audit "+where,
+		params...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("audit count: %w", err)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = -1 // No limit.
+	}
+	//#nosec G202 -- where only holds placeholders.
+	rows, err := api.db.QueryContext(ctx, `
+		SELECT id, timestamp, actor, ip, action, service_id, target, outcome, detail
+		FROM audit `+where+`
+		ORDER BY timestamp DESC, id DESC
+		LIMIT ? OFFSET ?;`,
+		append(params, limit, max(query.Offset, 0))...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("audit query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]dbtype.AuditEntry, 0)
+	for rows.Next() {
+		var entry dbtype.AuditEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.Timestamp,
+			&entry.Actor,
+			&entry.IP,
+			&entry.Action,
+			&entry.ServiceID,
+			&entry.Target,
+			&entry.Outcome,
+			&entry.Detail,
+		); err != nil {
+			return nil, 0, fmt.Errorf("audit row: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("audit rows: %w", err)
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,164 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package db
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+func TestAPI_Audit(t *testing.T) {
+	// GIVEN: a DB with some audit entries.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	base := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) string {
+		return base.Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339)
+	}
+	tAPI.insertAudit([]dbtype.AuditEntry{
+		{Timestamp: at(0), Actor: "alice", IP: "192.0.2.1", Action: dbtype.AuditServiceCreate,
+			ServiceID: "keep0", Outcome: dbtype.AuditSuccess},
+		{Timestamp: at(1), Actor: "bob", IP: "192.0.2.2", Action: dbtype.AuditApprove,
+			ServiceID: "keep0", Target: "ARGUS_ALL", Outcome: dbtype.AuditAccepted},
+		{Timestamp: at(2), Actor: dbtype.AuditActorArgus, Action: dbtype.AuditCommandRun,
+			ServiceID: "keep0", Target: "ls -lah", Outcome: dbtype.AuditFailure, Detail: "exit status 1"},
+		{Timestamp: at(3), Actor: "alice", IP: "192.0.2.1", Action: dbtype.AuditServiceDelete,
+			ServiceID: "keep1", Outcome: dbtype.AuditSuccess},
+	})
+
+	tests := []struct {
+		name      string
+		query     dbtype.AuditQuery
+		wantIDs   []int64
+		wantTotal int
+	}{
+		{
+			name:      "all, newest first",
+			query:     dbtype.AuditQuery{},
+			wantIDs:   []int64{4, 3, 2, 1},
+			wantTotal: 4,
+		},
+		{
+			name:      "limit and offset",
+			query:     dbtype.AuditQuery{Limit: 2, Offset: 1},
+			wantIDs:   []int64{3, 2},
+			wantTotal: 4,
+		},
+		{
+			name:      "service_id",
+			query:     dbtype.AuditQuery{ServiceID: "keep1"},
+			wantIDs:   []int64{4},
+			wantTotal: 1,
+		},
+		{
+			name:      "actor",
+			query:     dbtype.AuditQuery{Actor: "alice"},
+			wantIDs:   []int64{4, 1},
+			wantTotal: 2,
+		},
+		{
+			name:      "action",
+			query:     dbtype.AuditQuery{Action: dbtype.AuditCommandRun},
+			wantIDs:   []int64{3},
+			wantTotal: 1,
+		},
+		{
+			name:      "outcome",
+			query:     dbtype.AuditQuery{Outcome: dbtype.AuditSuccess},
+			wantIDs:   []int64{4, 1},
+			wantTotal: 2,
+		},
+		{
+			name:      "since and until",
+			query:     dbtype.AuditQuery{Since: at(1), Until: at(3)},
+			wantIDs:   []int64{3, 2},
+			wantTotal: 2,
+		},
+		{
+			name:      "no matches",
+			query:     dbtype.AuditQuery{Actor: "eve"},
+			wantIDs:   []int64{},
+			wantTotal: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're sharing a DB connection.
+
+			// WHEN: Audit is called.
+			entries, total, err := tAPI.Audit(t.Context(), tc.query)
+
+			prefix := fmt.Sprintf("%s\napi.Audit(%+v)", packageName, tc.query)
+
+			// THEN: the matching entries are returned, with the total.
+			if err != nil {
+				t.Fatalf("%s unexpected error: %v", prefix, err)
+			}
+			gotIDs := make([]int64, len(entries))
+			for i, entry := range entries {
+				gotIDs[i] = entry.ID
+			}
+			if fmt.Sprint(gotIDs) != fmt.Sprint(tc.wantIDs) {
+				t.Errorf("%s IDs mismatch\ngot:  %v\nwant: %v",
+					prefix, gotIDs, tc.wantIDs)
+			}
+			if total != tc.wantTotal {
+				t.Errorf("%s total mismatch\ngot:  %d\nwant: %d",
+					prefix, total, tc.wantTotal)
+			}
+		})
+	}
+}
+
+func TestAPI_Handler__audit(t *testing.T) {
+	// GIVEN: a DB with a Handler running.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	go tAPI.Handler(t.Context())
+	entry := dbtype.AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Actor:     "alice",
+		IP:        "192.0.2.1",
+		Action:    dbtype.AuditServiceDelete,
+		ServiceID: "keep0",
+		Outcome:   dbtype.AuditSuccess,
+	}
+
+	// WHEN: a delete message carrying an audit entry is sent.
+	tAPI.config.DatabaseChannel <- dbtype.Message{
+		ServiceID: entry.ServiceID,
+		Delete:    true,
+		Audit:     []dbtype.AuditEntry{entry},
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	// THEN: the entry is recorded, and kept despite the delete.
+	entries, total, err := tAPI.Audit(t.Context(), dbtype.AuditQuery{ServiceID: entry.ServiceID})
+	if err != nil || total != 1 {
+		t.Fatalf("%s\napi.Handler() audit mismatch\ngot:  %d entries (err=%v)\nwant: 1",
+			packageName, total, err)
+	}
+	entry.ID = entries[0].ID
+	if entries[0] != entry {
+		t.Errorf("%s\napi.Handler() audit entry mismatch\ngot:  %+v\nwant: %+v",
+			packageName, entries[0], entry)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
@@ -31,10 +32,13 @@ func writeQuoted(b *strings.Builder, s string) {
 	b.WriteByte('`')
 }
 
-// Handler processes database update and delete messages until ctx is cancelled.
+// Handler processes database update and delete messages, and prunes history, until ctx is cancelled.
 func (api *api) Handler(ctx context.Context) {
 	defer api.db.Close()
 
+	pruneTicker := time.NewTicker(historyPruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		case message, ok := <-api.config.DatabaseChannel:
@@ -43,6 +47,8 @@ func (api *api) Handler(ctx context.Context) {
 				return
 			}
 
+			api.insertAudit(message.Audit)
+
 			// If the message is to delete a row.
 			if message.Delete {
 				api.deleteRow(message.ServiceID)
@@ -52,8 +58,15 @@ func (api *api) Handler(ctx context.Context) {
 					message.ServiceID,
 					message.Cells,
 				)
+				api.insertEvents(
+					message.ServiceID,
+					message.Events,
+				)
 			}
 
+		case now := <-pruneTicker.C:
+			api.pruneHistory(now)
+
 		case <-ctx.Done():
 			return
 		}
@@ -136,10 +149,18 @@ func (api *api) updateRow(serviceID string, cells []dbtype.Cell) {
 			logFrom,
 			true,
 		)
+		return
+	}
+
+	// Renamed, so move the history to the new ID.
+	for _, cell := range cells {
+		if cell.Column == "id" && cell.Value != serviceID {
+			api.renameHistory(serviceID, cell.Value)
+		}
 	}
 }
 
-// deleteRow removes the status row for serviceID.
+// deleteRow removes the status row, and history, for serviceID.
 func (api *api) deleteRow(serviceID string) {
 	// The SQL statement.
 	sqlStmt := "DELETE FROM status WHERE id = ?"
@@ -163,4 +184,5 @@ func (api *api) deleteRow(serviceID string) {
 			true,
 		)
 	}
+	api.deleteHistory(serviceID)
 }
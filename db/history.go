@@ -0,0 +1,249 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package db provides database functionality for Argus to keep track of versions found/deployed/approved.
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// historyPruneInterval is how often history older than the retention is pruned.
+var historyPruneInterval = time.Hour
+
+// createHistoryTable ensures the history table, and its index, exist.
+func createHistoryTable(db schemaExecer) bool {
+	if _, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS history (
+			id               INTEGER  PRIMARY KEY AUTOINCREMENT,
+			service_id       TEXT     NOT NULL,
+			type             TEXT     NOT NULL,
+			version          TEXT     DEFAULT  '',
+			previous_version TEXT     DEFAULT  '',
+			source           TEXT     DEFAULT  '',
+			timestamp        DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS history_service_id_timestamp
+			ON history (service_id, timestamp);`); err != nil {
+		logx.Fatal(fmt.Sprintf("createHistoryTable: %s", err), logFrom)
+		return false
+	}
+
+	return true
+}
+
+// insertEvents records the events for serviceID in the history table.
+func (api *api) insertEvents(serviceID string, events []dbtype.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?,?,?,?,?,?),", len(events)), ",")
+	params := make([]any, 0, len(events)*6)
+	for _, event := range events {
+		params = append(params,
+			serviceID,
+			event.Type,
+			event.Version,
+			event.PreviousVersion,
+			event.Source,
+			event.Timestamp)
+	}
+
+	//#nosec G201 -- placeholders is safe.
+	sqlStmt := fmt.Sprintf(`
+		INSERT INTO history (service_id, type, version, previous_version, source, timestamp)
+		VALUES %s;`,
+		placeholders,
+	)
+	if logx.IsLevel("DEBUG") {
+		logx.Debug(
+			fmt.Sprintf("%s, %v", sqlStmt, params),
+			logFrom,
+			true,
+		)
+	}
+	if _, err := api.db.Exec(sqlStmt, params...); err != nil {
+		logx.Error(
+			fmt.Sprintf("insertEvents: %q %v, %s", sqlStmt, params, err),
+			logFrom,
+			true,
+		)
+	}
+}
+
+// renameHistory moves the history of oldID to newID.
+func (api *api) renameHistory(oldID, newID string) {
+	if _, err := api.db.Exec(
+		"UPDATE history SET service_id = ? WHERE service_id = ?",
+		newID, oldID,
+	); err != nil {
+		logx.Error(
+			fmt.Sprintf("renameHistory: %q to %q, %s", oldID, newID, err),
+			logFrom,
+			true,
+		)
+	}
+}
+
+// deleteHistory removes the history of serviceID.
+func (api *api) deleteHistory(serviceID string) {
+	if _, err := api.db.Exec("DELETE FROM history WHERE service_id = ?", serviceID); err != nil {
+		logx.Error(
+			fmt.Sprintf("deleteHistory: %q, %s", serviceID, err),
+			logFrom,
+			true,
+		)
+	}
+}
+
+// pruneHistory removes history older than the retention setting (if set).
+func (api *api) pruneHistory(now time.Time) {
+	retention := api.config.Settings.DataHistoryRetention()
+	if retention <= 0 {
+		return
+	}
+
+	cutoff := now.Add(-retention).UTC().Format(time.RFC3339)
+	result, err := api.db.Exec("DELETE FROM history WHERE timestamp < ?", cutoff)
+	if err != nil {
+		logx.Error(
+			fmt.Sprintf("pruneHistory: %s", err),
+			logFrom,
+			true,
+		)
+		return
+	}
+	if pruned, _ := result.RowsAffected(); pruned != 0 {
+		logx.Verbose(
+			fmt.Sprintf("Pruned %d history events older than %s", pruned, cutoff),
+			logFrom,
+			true,
+		)
+	}
+}
+
+// replayHistory restores the versions behind, last lead time, and last reminder, of each Service from its history.
+func (api *api) replayHistory() {
+	api.config.OrderMu.RLock()
+	defer api.config.OrderMu.RUnlock()
+
+	for _, id := range api.config.Order {
+		svc := api.config.Service[id]
+		events, err := api.deploymentEvents(id)
+		if err != nil {
+			logx.Error(
+				fmt.Sprintf("replayHistory: %q, %s", id, err),
+				logFrom,
+				true,
+			)
+			continue
+		}
+		if len(events) != 0 {
+			svc.Status.ReplayHistory(events)
+		}
+	}
+}
+
+// deploymentEvents returns the 'latest', 'deployed' and 'reminded' events of serviceID, oldest first.
+func (api *api) deploymentEvents(serviceID string) ([]dbtype.Event, error) {
+	rows, err := api.db.Query(`
+		SELECT type, version, source, timestamp
+		FROM history
+		WHERE service_id = ? AND type IN (?, ?, ?)
+		ORDER BY timestamp, id;`,
+		serviceID, dbtype.EventLatest, dbtype.EventDeployed, dbtype.EventReminded,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []dbtype.Event
+	for rows.Next() {
+		event := dbtype.Event{ServiceID: serviceID}
+		if err := rows.Scan(&event.Type, &event.Version, &event.Source, &event.Timestamp); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// History returns the events matching query (newest first), and the total number of matches.
+func (api *api) History(ctx context.Context, query dbtype.HistoryQuery) ([]dbtype.Event, int, error) {
+	where := "WHERE service_id = ?"
+	params := []any{query.ServiceID}
+	if len(query.Types) != 0 {
+		where += " AND type IN (" + strings.TrimSuffix(strings.Repeat("?,", len(query.Types)), ",") + ")"
+		for _, eventType := range query.Types {
+			params = append(params, eventType)
+		}
+	}
+
+	var total int
+	//#nosec G202 -- where only holds placeholders.
+	if err := api.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM history "+where,
+		params...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("history count: %w", err)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = -1 // No limit.
+	}
+	//#nosec G202 -- where only holds placeholders.
+	rows, err := api.db.QueryContext(ctx, `
+		SELECT id, service_id, type, version, previous_version, source, timestamp
+		FROM history `+where+`
+		ORDER BY timestamp DESC, id DESC
+		LIMIT ? OFFSET ?;`,
+		append(params, limit, max(query.Offset, 0))...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("history query: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]dbtype.Event, 0)
+	for rows.Next() {
+		var event dbtype.Event
+		if err := rows.Scan(
+			&event.ID,
+			&event.ServiceID,
+			&event.Type,
+			&event.Version,
+			&event.PreviousVersion,
+			&event.Source,
+			&event.Timestamp,
+		); err != nil {
+			return nil, 0, fmt.Errorf("history row: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("history rows: %w", err)
+	}
+
+	return events, total, nil
+}
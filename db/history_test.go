@@ -0,0 +1,282 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package db
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+// historyVersions returns the versions of the events, in order.
+func historyVersions(events []dbtype.Event) []string {
+	versions := make([]string, len(events))
+	for i, event := range events {
+		versions[i] = event.Version
+	}
+	return versions
+}
+
+func TestAPI_History(t *testing.T) {
+	// GIVEN: a DB with history for a couple of services.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	base := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) string {
+		return base.Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339)
+	}
+	tAPI.insertEvents("keep0", []dbtype.Event{
+		{Type: dbtype.EventLatest, Version: "1.0.0", Source: dbtype.SourceLatestVersion, Timestamp: at(0)},
+		{Type: dbtype.EventDeployed, Version: "1.0.0", Source: dbtype.SourceLatestVersion, Timestamp: at(0)},
+		{Type: dbtype.EventLatest, Version: "1.1.0", PreviousVersion: "1.0.0", Source: dbtype.SourceLatestVersion, Timestamp: at(1)},
+		{Type: dbtype.EventApproved, Version: "1.1.0", Source: dbtype.SourceAPI, Timestamp: at(2)},
+		{Type: dbtype.EventDeployed, Version: "1.1.0", PreviousVersion: "1.0.0", Source: dbtype.SourceActions, Timestamp: at(3)},
+	})
+	tAPI.insertEvents("keep1", []dbtype.Event{
+		{Type: dbtype.EventLatest, Version: "9.9.9", Timestamp: at(0)},
+	})
+
+	tests := []struct {
+		name      string
+		query     dbtype.HistoryQuery
+		want      []string
+		wantTotal int
+	}{
+		{
+			name:      "all, newest first",
+			query:     dbtype.HistoryQuery{ServiceID: "keep0"},
+			want:      []string{"1.1.0", "1.1.0", "1.1.0", "1.0.0", "1.0.0"},
+			wantTotal: 5,
+		},
+		{
+			name:      "limit",
+			query:     dbtype.HistoryQuery{ServiceID: "keep0", Limit: 2},
+			want:      []string{"1.1.0", "1.1.0"},
+			wantTotal: 5,
+		},
+		{
+			name:      "limit and offset",
+			query:     dbtype.HistoryQuery{ServiceID: "keep0", Limit: 2, Offset: 3},
+			want:      []string{"1.0.0", "1.0.0"},
+			wantTotal: 5,
+		},
+		{
+			name:      "offset past the end",
+			query:     dbtype.HistoryQuery{ServiceID: "keep0", Offset: 10},
+			want:      []string{},
+			wantTotal: 5,
+		},
+		{
+			name:      "types",
+			query:     dbtype.HistoryQuery{ServiceID: "keep0", Types: []string{dbtype.EventDeployed}},
+			want:      []string{"1.1.0", "1.0.0"},
+			wantTotal: 2,
+		},
+		{
+			name:      "unknown service",
+			query:     dbtype.HistoryQuery{ServiceID: "unknown"},
+			want:      []string{},
+			wantTotal: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're sharing a DB connection.
+
+			// WHEN: History is called.
+			events, total, err := tAPI.History(t.Context(), tc.query)
+
+			prefix := fmt.Sprintf("%s\napi.History(%+v)", packageName, tc.query)
+
+			// THEN: the matching events are returned, with the total.
+			if err != nil {
+				t.Fatalf("%s unexpected error: %v", prefix, err)
+			}
+			if got := fmt.Sprint(historyVersions(events)); got != fmt.Sprint(tc.want) {
+				t.Errorf("%s versions mismatch\ngot:  %s\nwant: %v",
+					prefix, got, tc.want)
+			}
+			if total != tc.wantTotal {
+				t.Errorf("%s total mismatch\ngot:  %d\nwant: %d",
+					prefix, total, tc.wantTotal)
+			}
+			for _, event := range events {
+				if event.ServiceID != tc.query.ServiceID {
+					t.Errorf("%s ServiceID mismatch\ngot:  %q\nwant: %q",
+						prefix, event.ServiceID, tc.query.ServiceID)
+				}
+			}
+		})
+	}
+}
+
+func TestAPI_Handler__history(t *testing.T) {
+	// GIVEN: a DB with a Handler running.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	go tAPI.Handler(t.Context())
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	// WHEN: a message with events is sent.
+	tAPI.config.DatabaseChannel <- dbtype.Message{
+		ServiceID: "keep0",
+		Cells:     []dbtype.Cell{{Column: "latest_version", Value: "1.2.3"}},
+		Events: []dbtype.Event{
+			{Type: dbtype.EventLatest, Version: "1.2.3", Source: dbtype.SourceLatestVersion, Timestamp: now},
+		},
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	prefix := fmt.Sprintf("%s\napi.Handler()", packageName)
+
+	// THEN: the event is recorded against the service.
+	events, total, _ := tAPI.History(t.Context(), dbtype.HistoryQuery{ServiceID: "keep0"})
+	if total != 1 || events[0].Version != "1.2.3" || events[0].Type != dbtype.EventLatest {
+		t.Fatalf("%s history mismatch after insert\ngot:  %+v",
+			prefix, events)
+	}
+
+	// WHEN: the service is renamed.
+	tAPI.config.DatabaseChannel <- dbtype.Message{
+		ServiceID: "keep0",
+		Cells:     []dbtype.Cell{{Column: "id", Value: "renamed"}},
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	// THEN: the history moves to the new ID.
+	if _, total, _ := tAPI.History(t.Context(), dbtype.HistoryQuery{ServiceID: "keep0"}); total != 0 {
+		t.Errorf("%s history of old ID not moved, %d events remain",
+			prefix, total)
+	}
+	if _, total, _ := tAPI.History(t.Context(), dbtype.HistoryQuery{ServiceID: "renamed"}); total != 1 {
+		t.Errorf("%s history of new ID mismatch\ngot:  %d events\nwant: 1",
+			prefix, total)
+	}
+
+	// WHEN: the service is deleted.
+	tAPI.config.DatabaseChannel <- dbtype.Message{
+		ServiceID: "renamed",
+		Delete:    true,
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	// THEN: its history is deleted.
+	if _, total, _ := tAPI.History(t.Context(), dbtype.HistoryQuery{ServiceID: "renamed"}); total != 0 {
+		t.Errorf("%s history not deleted, %d events remain",
+			prefix, total)
+	}
+}
+
+func TestAPI_PruneHistory(t *testing.T) {
+	// GIVEN: history events of various ages.
+	now := time.Now().UTC()
+	tests := []struct {
+		name      string
+		retention string
+		wantTotal int
+	}{
+		{name: "no retention keeps all", retention: "", wantTotal: 3},
+		{name: "0 retention keeps all", retention: "0s", wantTotal: 3},
+		{name: "hours", retention: "36h", wantTotal: 2},
+		{name: "days", retention: "3d", wantTotal: 3},
+		{name: "less than the newest", retention: "1s", wantTotal: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tAPI := testAPI(t)
+			tAPI.config.Settings.Data.HistoryRetention = tc.retention
+			tAPI.initialise()
+			var events []dbtype.Event
+			for _, age := range []time.Duration{time.Minute, 24 * time.Hour, 48 * time.Hour} {
+				events = append(events, dbtype.Event{
+					Type:      dbtype.EventLatest,
+					Timestamp: now.Add(-age).Format(time.RFC3339),
+				})
+			}
+			tAPI.insertEvents("keep0", events)
+
+			// WHEN: pruneHistory is called.
+			tAPI.pruneHistory(now)
+
+			// THEN: only the events within the retention remain.
+			_, total, err := tAPI.History(t.Context(), dbtype.HistoryQuery{ServiceID: "keep0"})
+			if err != nil {
+				t.Fatalf("%s\nunexpected error: %v", packageName, err)
+			}
+			if total != tc.wantTotal {
+				t.Errorf("%s\napi.pruneHistory() with retention %q mismatch\ngot:  %d events\nwant: %d",
+					packageName, tc.retention, total, tc.wantTotal)
+			}
+		})
+	}
+}
+
+func TestAPI_ReplayHistory(t *testing.T) {
+	// GIVEN: a DB with the status and history of a service three versions behind.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	tAPI.updateRow("keep0", []dbtype.Cell{
+		{Column: "latest_version", Value: "1.3.0"},
+		{Column: "latest_version_timestamp", Value: "2026-01-04T00:00:00Z"},
+		{Column: "deployed_version", Value: "1.0.0"},
+		{Column: "deployed_version_timestamp", Value: "2026-01-01T02:00:00Z"},
+	})
+	tAPI.insertEvents("keep0", []dbtype.Event{
+		{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+		{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T02:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.1.0", Timestamp: "2026-01-02T00:00:00Z"},
+		{Type: dbtype.EventApproved, Version: "1.1.0", Timestamp: "2026-01-02T01:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.2.0", Timestamp: "2026-01-03T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.2.0", Source: dbtype.SourceEscalation, Timestamp: "2026-01-03T12:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.3.0", Timestamp: "2026-01-04T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.3.0", Source: dbtype.SourceReminder, Timestamp: "2026-01-07T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.3.0", Source: dbtype.SourceReminder, Timestamp: "2026-01-14T00:00:00Z"},
+	})
+	_ = tAPI.db.Close()
+	tAPI.db = nil
+
+	// WHEN: the DB is loaded.
+	loaded := Get(tAPI.config)
+	t.Cleanup(func() { dbCleanup(loaded) })
+
+	prefix := fmt.Sprintf("%s\nGet()", packageName)
+
+	// THEN: the service is three versions behind, since 1.1.0 was found.
+	svc := tAPI.config.Service["keep0"]
+	versionsBehind, since := svc.Status.Drift()
+	if got := fmt.Sprintf("%d since %s", versionsBehind, since.UTC().Format(time.RFC3339)); got != "3 since 2026-01-02T00:00:00Z" {
+		t.Errorf("%s drift mismatch\ngot:  %s\nwant: %s",
+			prefix, got, "3 since 2026-01-02T00:00:00Z")
+	}
+	// AND: the lead time of the last deployment is restored.
+	if leadTime, ok := svc.Status.LeadTime(); !ok || leadTime != 2*time.Hour {
+		t.Errorf("%s lead time mismatch\ngot:  %v (%t)\nwant: %v",
+			prefix, leadTime, ok, 2*time.Hour)
+	}
+	// AND: the last reminder of the LatestVersion is restored, ignoring those of older versions.
+	release, _ := svc.Status.Undeployed()
+	if got := fmt.Sprintf("%s (escalated=%t)", release.LastReminded.UTC().Format(time.RFC3339), release.Escalated); got != "2026-01-14T00:00:00Z (escalated=false)" {
+		t.Errorf("%s last reminder mismatch\ngot:  %s\nwant: %s",
+			prefix, got, "2026-01-14T00:00:00Z (escalated=false)")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/release-argus/Argus/config"
 	"github.com/release-argus/Argus/internal/logx"
@@ -57,44 +58,64 @@ func Get(cfg *config.Config) *api {
 		if ok := api.extractServiceStatus(); !ok {
 			return nil
 		}
+		api.replayHistory()
 	}
+	api.pruneHistory(time.Now())
 
+	cfg.Database = &api
 	return &api
 }
 
-// initialise opens the SQLite database and ensures the status table exists.
+// initialise opens the SQLite database and migrates its schema to the latest version.
 func (api *api) initialise() (ok bool) {
 	databaseFile := api.config.Settings.DataDatabaseFile()
+	db, ok := openAndMigrate(databaseFile)
+	if !ok {
+		return
+	}
+
+	api.db = db
+	return true
+}
+
+// Migrate applies any pending schema migrations to the database and closes it.
+func Migrate(cfg *config.Config) (ok bool) {
+	databaseFile := cfg.Settings.DataDatabaseFile()
+	db, ok := openAndMigrate(databaseFile)
+	if !ok {
+		return
+	}
+	defer db.Close()
+
+	logx.Info(
+		fmt.Sprintf("Database %q is at schema version %d", databaseFile, latestSchemaVersion()),
+		logFrom, true,
+	)
+	return true
+}
+
+// openAndMigrate opens the SQLite database at databaseFile and applies any pending migrations,
+// backing up a pre-existing database first.
+func openAndMigrate(databaseFile string) (db *sql.DB, ok bool) {
 	if ok := checkFile(databaseFile); !ok {
-		return ok
+		return nil, ok
 	}
+	existing := hasData(databaseFile)
 	db, err := openDatabase("sqlite", databaseFile+"?_pragma=busy_timeout(5000)")
 	if err != nil {
 		logx.Fatal(err, logFrom)
 		return
 	}
 
-	// Create the table.
-	if _, err := db.Exec(`
-		CREATE TABLE IF NOT EXISTS status (
-			id                         TEXT     NOT NULL PRIMARY KEY,
-			latest_version             TEXT     DEFAULT  '',
-			latest_version_timestamp   DATETIME DEFAULT  (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
-			deployed_version           TEXT     DEFAULT  '',
-			deployed_version_timestamp DATETIME DEFAULT  (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
-			approved_version           TEXT     DEFAULT  ''
-	);`); err != nil {
-		logx.Fatal(err, logFrom)
-		return
+	if ok := migrate(db, databaseFile, existing); !ok {
+		_ = db.Close()
+		return nil, false
 	}
 
-	ok = updateTable(db)
-
-	api.db = db
-	return
+	return db, true
 }
 
-// removeUnknownServices deletes status rows whose IDs are not in config.Order.
+// removeUnknownServices deletes status rows and history whose IDs are not in config.Order.
 func (api *api) removeUnknownServices() bool {
 	// ? for each service.
 	servicePlaceholders := strings.Repeat("?,", len(api.config.Order))
@@ -119,6 +140,18 @@ func (api *api) removeUnknownServices() bool {
 		return false
 	}
 
+	// Remove their history too.
+	//#nosec G201 -- servicePlaceholders is safe.
+	sqlStmt = fmt.Sprintf(`
+		DELETE FROM history
+		WHERE service_id NOT IN (%s);`,
+		servicePlaceholders,
+	)
+	if _, err := api.db.Exec(sqlStmt, params...); err != nil {
+		logx.Fatal(fmt.Sprintf("removeUnknownServices - history: %s", err), logFrom)
+		return false
+	}
+
 	return true
 }
 
@@ -131,7 +164,8 @@ func (api *api) extractServiceStatus() (ok bool) {
 			latest_version_timestamp,
 			deployed_version,
 			deployed_version_timestamp,
-			approved_version
+			approved_version,
+			COALESCE(instances, '')
 		FROM status;`,
 	)
 	if err != nil {
@@ -150,8 +184,9 @@ func (api *api) extractServiceStatus() (ok bool) {
 			dv  string
 			dvt string
 			av  string
+			ins string
 		)
-		if err := rows.Scan(&id, &lv, &lvt, &dv, &dvt, &av); err != nil {
+		if err := rows.Scan(&id, &lv, &lvt, &dv, &dvt, &av, &ins); err != nil {
 			logx.Fatal(fmt.Sprintf("extractServiceStatus row: %s", err), logFrom)
 			return
 		}
@@ -159,6 +194,13 @@ func (api *api) extractServiceStatus() (ok bool) {
 		svc.Status.SetLatestVersion(lv, lvt, false)
 		svc.Status.SetDeployedVersion(dv, dvt, false)
 		svc.Status.SetApprovedVersion(av, false)
+		if err := svc.Status.RestoreInstances(ins); err != nil {
+			logx.Error(
+				fmt.Sprintf("extractServiceStatus: %q instances, %s", id, err),
+				logFrom,
+				true,
+			)
+		}
 	}
 	if err := serviceStatusRowsErr(rows); err != nil {
 		logx.Fatal(
@@ -171,6 +213,12 @@ func (api *api) extractServiceStatus() (ok bool) {
 	return true
 }
 
+// hasData reports whether path is a non-empty file.
+func hasData(path string) bool {
+	fileInfo, err := os.Stat(path)
+	return err == nil && fileInfo.Size() > 0
+}
+
 // checkFile ensures the database directory exists and path is not a directory.
 func checkFile(path string) (ok bool) {
 	file := filepath.Base(path)
@@ -231,7 +279,7 @@ func checkFile(path string) (ok bool) {
 }
 
 // updateTable migrates the status table when legacy column types are detected.
-func updateTable(db *sql.DB) bool {
+func updateTable(db schemaExecer) bool {
 	// Get the type of the *_version columns.
 	var columnType string
 	if err := db.QueryRow("SELECT type FROM pragma_table_info('status') WHERE name = 'latest_version'").Scan(&columnType); err != nil {
@@ -251,7 +299,7 @@ func updateTable(db *sql.DB) bool {
 }
 
 // updateColumnTypes recreates status with TEXT version columns and copies existing rows.
-func updateColumnTypes(db *sql.DB) (ok bool) {
+func updateColumnTypes(db schemaExecer) (ok bool) {
 	// Create the new table.
 	if _, err := db.Exec(`
 		CREATE TABLE IF NOT EXISTS status_backup (
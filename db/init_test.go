@@ -735,6 +735,37 @@ func TestAPI_ExtractServiceStatus(t *testing.T) {
 	}
 }
 
+func TestAPI_ExtractServiceStatus__instances(t *testing.T) {
+	// GIVEN: an API on a DB with the instances of a Service.
+	tAPI := testAPI(t)
+	tAPI.initialise()
+	id := tAPI.config.Order[0]
+	if _, err := tAPI.db.Exec(
+		"INSERT OR REPLACE INTO status (id, instances) VALUES (?, ?);",
+		id, `[{"name":"a","version":"1.2.3","timestamp":"2026-01-02T03:04:05Z"},{"name":"b","error":"timeout"}]`,
+	); err != nil {
+		t.Fatalf(
+			"%s\nfailed to insert status row: %s",
+			packageName, err,
+		)
+	}
+
+	// WHEN: extractServiceStatus is called.
+	tAPI.extractServiceStatus()
+
+	// THEN: the instances of the Service are restored.
+	want := []status.Instance{
+		{Name: "a", Version: "1.2.3", Timestamp: "2026-01-02T03:04:05Z"},
+		{Name: "b", Error: "timeout"},
+	}
+	if got := tAPI.config.Service[id].Status.Instances(); !slices.Equal(got, want) {
+		t.Errorf(
+			"%s\napi.extractServiceStatus() instances mismatch\ngot:  %+v\nwant: %+v",
+			packageName, got, want,
+		)
+	}
+}
+
 func TestAPI_ExtractServiceStatus__fail(t *testing.T) {
 	// GIVEN: an API with different 'status' columns.
 	tests := []struct {
@@ -752,7 +783,8 @@ func TestAPI_ExtractServiceStatus__fail(t *testing.T) {
 					latest_version_timestamp TEXT,
 					deployed_version TEXT,
 					deployed_version_timestamp TEXT,
-					approved_version TEXT
+					approved_version TEXT,
+					instances TEXT
 				);`,
 		},
 		{
@@ -765,7 +797,8 @@ func TestAPI_ExtractServiceStatus__fail(t *testing.T) {
 					latest_version_timestamp TEXT,
 					deployed_version TEXT,
 					deployed_version_timestamp TEXT,
-					approved_version TEXT
+					approved_version TEXT,
+					instances TEXT
 				);`,
 			insertDataStmt: `
 				INSERT OR REPLACE INTO status (
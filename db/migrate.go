@@ -0,0 +1,209 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// schemaExecer is the subset of *sql.DB and *sql.Tx used to modify the schema.
+type schemaExecer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// migration is a single, ordered, up-only change to the database schema.
+type migration struct {
+	version     int
+	description string
+	up          func(tx schemaExecer) bool // Logs its own failure.
+}
+
+// migrations to apply, in order. Append only; never edit or re-number
+// a migration that has been released.
+var migrations = []migration{
+	{version: 1, description: "create status table", up: ensureStatusTable},
+	{version: 2, description: "create history table", up: createHistoryTable},
+	{version: 3, description: "create audit table", up: createAuditTable},
+	{version: 4, description: "add status instances column", up: addInstancesColumn},
+}
+
+// latestSchemaVersion is the version the schema is at once all migrations are applied.
+func latestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// ensureStatusTable ensures the status table exists with TEXT version columns.
+func ensureStatusTable(tx schemaExecer) bool {
+	if _, err := tx.Exec(`
+		CREATE TABLE IF NOT EXISTS status (
+			id                         TEXT     NOT NULL PRIMARY KEY,
+			latest_version             TEXT     DEFAULT  '',
+			latest_version_timestamp   DATETIME DEFAULT  (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+			deployed_version           TEXT     DEFAULT  '',
+			deployed_version_timestamp DATETIME DEFAULT  (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+			approved_version           TEXT     DEFAULT  ''
+	);`); err != nil {
+		logx.Fatal(fmt.Sprintf("ensureStatusTable: %s", err), logFrom)
+		return false
+	}
+
+	// Databases created before the schema was versioned may have non-TEXT version columns.
+	return updateTable(tx)
+}
+
+// addInstancesColumn adds the column holding the versions reported by each instance of a service.
+func addInstancesColumn(tx schemaExecer) bool {
+	var count int
+	if err := tx.QueryRow("SELECT COUNT(*) FROM pragma_table_info('status') WHERE name = 'instances'").Scan(&count); err != nil {
+		logx.Fatal(fmt.Sprintf("addInstancesColumn: %s", err), logFrom)
+		return false
+	}
+	// Already added.
+	if count != 0 {
+		return true
+	}
+
+	if _, err := tx.Exec(`
+		ALTER TABLE status
+			ADD COLUMN instances TEXT DEFAULT '';`); err != nil {
+		logx.Fatal(fmt.Sprintf("addInstancesColumn: %s", err), logFrom)
+		return false
+	}
+
+	return true
+}
+
+// schemaVersion ensures the schema_version table exists, and returns the current version.
+func schemaVersion(db *sql.DB) (version int, ok bool) {
+	if _, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_version (
+			version    INTEGER  NOT NULL PRIMARY KEY,
+			applied_at DATETIME DEFAULT  (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+		);`); err != nil {
+		logx.Fatal(fmt.Sprintf("schemaVersion - create: %s", err), logFrom)
+		return
+	}
+
+	if err := db.QueryRow(`
+		SELECT COALESCE(MAX(version), 0)
+		FROM schema_version;`,
+	).Scan(&version); err != nil {
+		logx.Fatal(fmt.Sprintf("schemaVersion - read: %s", err), logFrom)
+		return
+	}
+
+	return version, true
+}
+
+// migrate applies all pending migrations, each in its own transaction.
+// If existing is true, the database is copied to backupPath(databaseFile, current)
+// before the first pending migration is applied, and each migration is logged.
+func migrate(db *sql.DB, databaseFile string, existing bool) (ok bool) {
+	current, ok := schemaVersion(db)
+	if !ok {
+		return
+	}
+	latest := latestSchemaVersion()
+	if current > latest {
+		logx.Fatal(
+			fmt.Sprintf("schema version %d is newer than this release supports (%d)",
+				current, latest),
+			logFrom,
+		)
+		return false
+	}
+	if current == latest {
+		return true
+	}
+
+	if existing {
+		if ok := backupDatabase(db, backupPath(databaseFile, current)); !ok {
+			return false
+		}
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if ok := applyMigration(db, m); !ok {
+			return false
+		}
+		logx.Info(
+			fmt.Sprintf("Applied migration %d (%s)", m.version, m.description),
+			logFrom, existing,
+		)
+	}
+
+	return true
+}
+
+// applyMigration runs m and records its version in a single transaction.
+func applyMigration(db *sql.DB, m migration) bool {
+	tx, err := db.Begin()
+	if err != nil {
+		logx.Fatal(fmt.Sprintf("migration %d - begin: %s", m.version, err), logFrom)
+		return false
+	}
+
+	if ok := m.up(tx); !ok {
+		_ = tx.Rollback()
+		return false
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO schema_version (version)
+		VALUES (?);`,
+		m.version,
+	); err != nil {
+		_ = tx.Rollback()
+		logx.Fatal(fmt.Sprintf("migration %d - record: %s", m.version, err), logFrom)
+		return false
+	}
+
+	if err := tx.Commit(); err != nil {
+		logx.Fatal(fmt.Sprintf("migration %d - commit: %s", m.version, err), logFrom)
+		return false
+	}
+
+	return true
+}
+
+// backupPath returns the path of the pre-migration backup of databaseFile at schema version.
+func backupPath(databaseFile string, version int) string {
+	return fmt.Sprintf("%s.v%d.bak", databaseFile, version)
+}
+
+// backupDatabase writes a consistent copy of the database to path,
+// replacing any backup left there by an earlier failed migration.
+func backupDatabase(db *sql.DB, path string) bool {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		logx.Fatal(fmt.Sprintf("backupDatabase - remove %q: %s", path, err), logFrom)
+		return false
+	}
+
+	if _, err := db.Exec("VACUUM INTO ?;", path); err != nil {
+		logx.Fatal(fmt.Sprintf("backupDatabase - %q: %s", path, err), logFrom)
+		return false
+	}
+
+	logx.Info(fmt.Sprintf("Backed up database to %q before migrating", path), logFrom, true)
+	return true
+}
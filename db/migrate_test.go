@@ -0,0 +1,375 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestMigrate(t *testing.T) {
+	latest := latestSchemaVersion()
+	// GIVEN: a database at various stages of migration.
+	tests := []struct {
+		name        string
+		setupDB     func(t *testing.T, db *sql.DB)
+		existing    bool
+		ok          bool
+		wantVersion int
+		wantBackup  string
+		stdoutRegex string
+	}{
+		{
+			name:        "new database",
+			ok:          true,
+			wantVersion: latest,
+			stdoutRegex: `^$`,
+		},
+		{
+			name: "unversioned database with legacy column types",
+			setupDB: func(t *testing.T, db *sql.DB) {
+				if err := createStatusTable(db, "status", "STRING"); err != nil {
+					t.Fatalf("%s\nfailed to create status table: %v", packageName, err)
+				}
+				if err := insertStatusRow(db, "keepMe", "0.0.3", "", "0.0.2", "", "0.0.1"); err != nil {
+					t.Fatalf("%s\nfailed to insert row: %v", packageName, err)
+				}
+			},
+			existing:    true,
+			ok:          true,
+			wantVersion: latest,
+			wantBackup:  ".v0.bak",
+			stdoutRegex: `Backed up database to "[^"]+\.v0\.bak".*\n.*Updating column types` +
+				fmt.Sprintf(`(.|\n)*Applied migration %d \(`, latest),
+		},
+		{
+			name: "partially migrated database",
+			setupDB: func(t *testing.T, db *sql.DB) {
+				if ok := migrate(db, "", false); !ok {
+					t.Fatalf("%s\nfailed to migrate db", packageName)
+				}
+				if _, err := db.Exec("DELETE FROM schema_version WHERE version > 1;"); err != nil {
+					t.Fatalf("%s\nfailed to reset schema_version: %v", packageName, err)
+				}
+			},
+			existing:    true,
+			ok:          true,
+			wantVersion: latest,
+			wantBackup:  ".v1.bak",
+			stdoutRegex: `Applied migration 2 \(create history table\)`,
+		},
+		{
+			name: "up to date database",
+			setupDB: func(t *testing.T, db *sql.DB) {
+				if ok := migrate(db, "", false); !ok {
+					t.Fatalf("%s\nfailed to migrate db", packageName)
+				}
+			},
+			existing:    true,
+			ok:          true,
+			wantVersion: latest,
+			stdoutRegex: `^$`,
+		},
+		{
+			name: "database from a newer release",
+			setupDB: func(t *testing.T, db *sql.DB) {
+				if ok := migrate(db, "", false); !ok {
+					t.Fatalf("%s\nfailed to migrate db", packageName)
+				}
+				if _, err := db.Exec("INSERT INTO schema_version (version) VALUES (?);", latest+1); err != nil {
+					t.Fatalf("%s\nfailed to insert schema_version: %v", packageName, err)
+				}
+			},
+			existing:    true,
+			ok:          false,
+			wantVersion: latest + 1,
+			stdoutRegex: fmt.Sprintf(`^FATAL: .*schema version %d is newer than this release supports \(%d\)`,
+				latest+1, latest),
+		},
+		{
+			name: "migration fails",
+			setupDB: func(t *testing.T, db *sql.DB) {
+				// 'status' exists, but is missing the column migration 1 inspects.
+				if _, err := db.Exec("CREATE TABLE status (id TEXT NOT NULL PRIMARY KEY);"); err != nil {
+					t.Fatalf("%s\nfailed to create status table: %v", packageName, err)
+				}
+			},
+			existing:    true,
+			ok:          false,
+			wantVersion: 0,
+			wantBackup:  ".v0.bak",
+			stdoutRegex: `FATAL: .*updateTable: sql: no rows in result set`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're using stdout.
+			databaseFile := filepath.Join(t.TempDir(), "argus.db")
+			db, err := sql.Open("sqlite", databaseFile)
+			if err != nil {
+				t.Fatalf("%s\nfailed to open db: %v", packageName, err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			if tc.setupDB != nil {
+				tc.setupDB(t, db)
+			}
+			releaseStdout := test.CaptureLog(t, logx.Default())
+
+			resultChannel := make(chan bool, 1)
+			// WHEN: migrate is called.
+			resultChannel <- migrate(db, databaseFile, tc.existing)
+
+			prefix := fmt.Sprintf("%s\nmigrate(existing=%t)", packageName, tc.existing)
+
+			// THEN: it returns ok only if the schema is now up to date.
+			if err := test.AssertChannelBool(
+				t,
+				tc.ok,
+				resultChannel,
+				logx.ExitCodeChannel(),
+				releaseStdout,
+			); err != nil {
+				t.Fatal(prefix + err.Error())
+			}
+
+			// AND: the expected messages were logged.
+			stdout := releaseStdout()
+			if !util.RegexCheck(tc.stdoutRegex, stdout) {
+				t.Errorf(
+					"%s stdout mismatch\ngot:  %q\nwant: %q",
+					prefix, stdout, tc.stdoutRegex,
+				)
+			}
+
+			// AND: the schema is at the expected version.
+			var gotVersion int
+			_ = db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_version;").Scan(&gotVersion)
+			if gotVersion != tc.wantVersion {
+				t.Errorf(
+					"%s schema version mismatch\ngot:  %d\nwant: %d",
+					prefix, gotVersion, tc.wantVersion,
+				)
+			}
+
+			// AND: a backup was taken only when an existing database was migrated.
+			matches, _ := filepath.Glob(databaseFile + ".v*.bak")
+			gotBackup := ""
+			if len(matches) > 0 {
+				gotBackup = strings.TrimPrefix(strings.Join(matches, ","), databaseFile)
+			}
+			if gotBackup != tc.wantBackup {
+				t.Errorf(
+					"%s backup mismatch\ngot:  %q\nwant: %q",
+					prefix, gotBackup, tc.wantBackup,
+				)
+			}
+		})
+	}
+}
+
+func TestMigrate__backupHasPreMigrationData(t *testing.T) {
+	// GIVEN: an unversioned database with a row in a legacy status table.
+	databaseFile := filepath.Join(t.TempDir(), "argus.db")
+	db, _ := sql.Open("sqlite", databaseFile)
+	t.Cleanup(func() { _ = db.Close() })
+	if err := createStatusTable(db, "status", "STRING"); err != nil {
+		t.Fatalf("%s\nfailed to create status table: %v", packageName, err)
+	}
+	if err := insertStatusRow(db, "keepMe", "0.0.3", "", "0.0.2", "", "0.0.1"); err != nil {
+		t.Fatalf("%s\nfailed to insert row: %v", packageName, err)
+	}
+	// AND: a stale backup from an earlier failed attempt.
+	backupFile := backupPath(databaseFile, 0)
+	if err := os.WriteFile(backupFile, []byte("stale"), 0_600); err != nil {
+		t.Fatalf("%s\nfailed to write stale backup: %v", packageName, err)
+	}
+	releaseStdout := test.CaptureLog(t, logx.Default())
+
+	// WHEN: it is migrated.
+	ok := migrate(db, databaseFile, true)
+	_ = releaseStdout()
+
+	prefix := fmt.Sprintf("%s\nmigrate()", packageName)
+	if !ok {
+		t.Fatalf("%s failed", prefix)
+	}
+
+	// THEN: the backup replaced the stale file, and holds the pre-migration schema and data.
+	backup, err := sql.Open("sqlite", backupFile)
+	if err != nil {
+		t.Fatalf("%s\nfailed to open backup: %v", prefix, err)
+	}
+	t.Cleanup(func() { _ = backup.Close() })
+	var columnType string
+	if err := backup.QueryRow(
+		"SELECT type FROM pragma_table_info('status') WHERE name = 'latest_version';",
+	).Scan(&columnType); err != nil {
+		t.Fatalf("%s\nfailed to read backup: %v", prefix, err)
+	}
+	if columnType != "STRING" {
+		t.Errorf(
+			"%s backup column type mismatch\ngot:  %q\nwant: %q",
+			prefix, columnType, "STRING",
+		)
+	}
+	if got := queryRow(t, backup, "keepMe").LatestVersion(); got != "0.0.3" {
+		t.Errorf(
+			"%s backup row mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "0.0.3",
+		)
+	}
+}
+
+func TestApplyMigration(t *testing.T) {
+	// GIVEN: a versioned database.
+	tests := []struct {
+		name        string
+		up          func(tx schemaExecer) bool
+		ok          bool
+		stdoutRegex string
+	}{
+		{
+			name: "success",
+			up: func(tx schemaExecer) bool {
+				_, err := tx.Exec("CREATE TABLE test_table (id TEXT);")
+				return err == nil
+			},
+			ok:          true,
+			stdoutRegex: `^$`,
+		},
+		{
+			name: "fails after partial change - rolled back",
+			up: func(tx schemaExecer) bool {
+				if _, err := tx.Exec("CREATE TABLE test_table (id TEXT);"); err != nil {
+					return false
+				}
+				_, err := tx.Exec("INSERT INTO missing_table VALUES (1);")
+				if err != nil {
+					logx.Fatal(err, logFrom)
+				}
+				return err == nil
+			},
+			ok:          false,
+			stdoutRegex: `^FATAL: .*no such table: missing_table`,
+		},
+		{
+			name: "version already recorded",
+			up: func(tx schemaExecer) bool {
+				_, err := tx.Exec("CREATE TABLE test_table (id TEXT);")
+				return err == nil
+			},
+			ok:          false,
+			stdoutRegex: `^FATAL: .*migration 1 - record: .*UNIQUE constraint failed`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're using stdout.
+			db, _ := sql.Open("sqlite", filepath.Join(t.TempDir(), "argus.db"))
+			t.Cleanup(func() { _ = db.Close() })
+			if _, ok := schemaVersion(db); !ok {
+				t.Fatalf("%s\nfailed to create schema_version", packageName)
+			}
+			if strings.HasPrefix(tc.name, "version already") {
+				_, _ = db.Exec("INSERT INTO schema_version (version) VALUES (1);")
+			}
+			releaseStdout := test.CaptureLog(t, logx.Default())
+
+			resultChannel := make(chan bool, 1)
+			// WHEN: applyMigration is called.
+			resultChannel <- applyMigration(db, migration{version: 1, description: "test", up: tc.up})
+
+			prefix := fmt.Sprintf("%s\napplyMigration()", packageName)
+
+			// THEN: it returns ok only if the migration was applied.
+			if err := test.AssertChannelBool(
+				t,
+				tc.ok,
+				resultChannel,
+				logx.ExitCodeChannel(),
+				releaseStdout,
+			); err != nil {
+				t.Fatal(prefix + err.Error())
+			}
+			stdout := releaseStdout()
+			if !util.RegexCheck(tc.stdoutRegex, stdout) {
+				t.Errorf(
+					"%s stdout mismatch\ngot:  %q\nwant: %q",
+					prefix, stdout, tc.stdoutRegex,
+				)
+			}
+
+			// AND: the change was only kept if the migration succeeded.
+			var tables int
+			_ = db.QueryRow(
+				"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'test_table';",
+			).Scan(&tables)
+			if got := tables == 1; got != tc.ok {
+				t.Errorf(
+					"%s test_table exists mismatch\ngot:  %t\nwant: %t",
+					prefix, got, tc.ok,
+				)
+			}
+		})
+	}
+}
+
+func TestMigrate__exported(t *testing.T) {
+	// GIVEN: a config pointing at a new database.
+	tAPI := testAPI(t)
+	releaseStdout := test.CaptureLog(t, logx.Default())
+
+	// WHEN: Migrate is called.
+	ok := Migrate(tAPI.config)
+	stdout := releaseStdout()
+
+	prefix := fmt.Sprintf("%s\nMigrate()", packageName)
+
+	// THEN: it succeeds.
+	if !ok {
+		t.Fatalf("%s failed\nstdout: %q", prefix, stdout)
+	}
+	// AND: the schema version was logged.
+	wantStdout := fmt.Sprintf(`^INFO: .*is at schema version %d\n$`, latestSchemaVersion())
+	if !util.RegexCheck(wantStdout, stdout) {
+		t.Errorf(
+			"%s stdout mismatch\ngot:  %q\nwant: %q",
+			prefix, stdout, wantStdout,
+		)
+	}
+	// AND: the database was migrated.
+	db, _ := sql.Open("sqlite", tAPI.config.Settings.Data.DatabaseFile)
+	t.Cleanup(func() { _ = db.Close() })
+	if got, _ := schemaVersion(db); got != latestSchemaVersion() {
+		t.Errorf(
+			"%s schema version mismatch\ngot:  %d\nwant: %d",
+			prefix, got, latestSchemaVersion(),
+		)
+	}
+}
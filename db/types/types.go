@@ -15,6 +15,14 @@
 // Package types provides types for the Database.
 package types
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
 // Message to be used in the channel for messages to the Database.
 //
 //	e.g. update deployed_version/latest_version_timestamp.
@@ -22,6 +30,8 @@ type Message struct {
 	ServiceID string
 	Delete    bool
 	Cells     []Cell
+	Events    []Event      // History to record (ServiceID taken from the Message).
+	Audit     []AuditEntry // Audit log entries to record.
 }
 
 // Cell to be modified in the Database.
@@ -29,3 +39,171 @@ type Cell struct {
 	Column string
 	Value  string
 }
+
+// Event types recorded in the history table.
+const (
+	EventLatest   = "latest"   // New latest version found.
+	EventDeployed = "deployed" // New deployed version found/set.
+	EventApproved = "approved" // Latest version approved.
+	EventSkipped  = "skipped"  // Latest version skipped.
+	EventReminded = "reminded" // Reminder sent that the latest version is undeployed.
+)
+
+// Event sources recorded in the history table.
+const (
+	SourceLatestVersion   = "latest_version"   // latest_version lookup.
+	SourceDeployedVersion = "deployed_version" // deployed_version lookup.
+	SourceActions         = "actions"          // Commands/WebHooks completing.
+	SourceAPI             = "api"              // Web API/UI.
+	SourceReminder        = "reminder"         // Reminder of an undeployed latest version.
+	SourceEscalation      = "escalation"       // Escalated reminder of an undeployed latest version.
+)
+
+// Event is a version transition of a Service.
+type Event struct {
+	ID              int64
+	ServiceID       string
+	Type            string
+	Version         string
+	PreviousVersion string
+	Source          string
+	Timestamp       string
+}
+
+// HistoryQuery filters and paginates a history lookup.
+type HistoryQuery struct {
+	ServiceID string
+	Types     []string // Empty for all types.
+	Limit     int
+	Offset    int
+}
+
+// Audit actions recorded in the audit log.
+const (
+	AuditApprove       = "approve"               // Commands/WebHooks approved.
+	AuditSkip          = "skip"                  // Latest version skipped.
+	AuditCommandRun    = "command_run"           // Command ran.
+	AuditWebHookSend   = "webhook_send"          // WebHook sent.
+	AuditServiceCreate = "service_create"        // Service created.
+	AuditServiceEdit   = "service_edit"          // Service edited.
+	AuditServiceDelete = "service_delete"        // Service deleted.
+	AuditOrderEdit     = "order_edit"            // Service order changed.
+	AuditDeployedPush  = "deployed_version_push" // Deployed version pushed.
+	AuditBackupExport  = "backup_export"         // Backup archive exported.
+	AuditBackupImport  = "backup_import"         // Backup archive imported.
+)
+
+// Audit outcomes recorded in the audit log.
+const (
+	AuditSuccess  = "success"  // Completed.
+	AuditFailure  = "failure"  // Rejected or failed.
+	AuditAccepted = "accepted" // Started, with the result audited separately.
+)
+
+// AuditActorArgus is the actor of actions Argus takes itself (e.g. auto_approve).
+const AuditActorArgus = "argus"
+
+// AuditEntry is a record of an action taken on Argus.
+type AuditEntry struct {
+	ID        int64
+	Timestamp string
+	Actor     string // Basic auth username, token identity, or AuditActorArgus.
+	IP        string
+	Action    string
+	ServiceID string
+	Target    string // e.g. the Command/WebHook, or version.
+	Outcome   string
+	Detail    string // e.g. the error.
+}
+
+// AuditQuery filters and paginates an audit log lookup.
+type AuditQuery struct {
+	ServiceID string
+	Actor     string
+	Action    string
+	Outcome   string
+	Since     string // RFC3339, inclusive.
+	Until     string // RFC3339, exclusive.
+	Limit     int    // 0 for all.
+	Offset    int
+}
+
+// Reader provides read access to data held in the Database.
+type Reader interface {
+	// History returns the events matching query (newest first), and the total number of matches.
+	History(ctx context.Context, query HistoryQuery) ([]Event, int, error)
+	// Audit returns the audit entries matching query (newest first), and the total number of matches.
+	Audit(ctx context.Context, query AuditQuery) ([]AuditEntry, int, error)
+}
+
+// Import modes.
+const (
+	ImportMerge   = "merge"   // Add to the existing state, overwriting the status of imported services.
+	ImportReplace = "replace" // Discard the existing state first.
+)
+
+// ExportOptions control what a backup archive holds.
+type ExportOptions struct {
+	Config bool // Include the config file, which may hold secrets.
+}
+
+// ImportOptions control how a backup archive is applied.
+type ImportOptions struct {
+	Mode       string            // ImportMerge or ImportReplace.
+	ServiceIDs map[string]string // Service ID in the archive -> ID to import it as.
+}
+
+// ParseServiceIDs parses a comma-separated list of 'archive=current' service ID mappings.
+func ParseServiceIDs(value string) (map[string]string, error) {
+	ids := make(map[string]string)
+	targets := make(map[string]string)
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		from, to, found := strings.Cut(pair, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !found || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid service ID mapping %q, want 'archive=current'", pair)
+		}
+		if _, exists := ids[from]; exists {
+			return nil, fmt.Errorf("service ID %q mapped more than once", from)
+		}
+		if other, exists := targets[to]; exists {
+			return nil, fmt.Errorf("service IDs %q and %q both mapped to %q", other, from, to)
+		}
+
+		ids[from] = to
+		targets[to] = from
+	}
+
+	return ids, nil
+}
+
+// ErrInvalidArchive is returned by Import when the backup archive cannot be imported.
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// ImportSummary reports what an import applied.
+type ImportSummary struct {
+	Mode     string
+	Services []string // Imported service IDs that are in the config.
+	Unknown  []string // Imported service IDs that are not in the config.
+	History  int      // Number of history events added.
+	Audit    int      // Number of audit entries added.
+}
+
+// Archiver exports and imports the Database as a portable backup archive.
+type Archiver interface {
+	// Export writes a backup archive of the Database (and optionally the config file) to w.
+	Export(ctx context.Context, w io.Writer, opts ExportOptions) error
+	// Import validates the backup archive in r, and applies its Database state.
+	Import(ctx context.Context, r io.Reader, opts ImportOptions) (ImportSummary, error)
+}
+
+// Database provides access to the Database for the web API.
+type Database interface {
+	Reader
+	Archiver
+}
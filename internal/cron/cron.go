@@ -0,0 +1,265 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cron parses cron expressions and finds the times they next match.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64         // Bitsets of the values each field matches.
+	domStar, dowStar              bool           // Whether day-of-month/day-of-week were '*' (or '?').
+	location                      *time.Location // Location the expression is evaluated in.
+}
+
+// macros are the shorthand expressions, and their equivalents.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// bounds of a cron field.
+type bounds struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteBounds = bounds{name: "minute", min: 0, max: 59}
+	hourBounds   = bounds{name: "hour", min: 0, max: 23}
+	domBounds    = bounds{name: "day-of-month", min: 1, max: 31}
+	monthBounds  = bounds{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is also Sunday.
+	dowBounds = bounds{name: "day-of-week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Parse parses a standard 5-field cron expression ('minute hour day-of-month month day-of-week'),
+// or one of the macros (@yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly).
+//
+// The expression is evaluated in local time, unless prefixed with 'CRON_TZ=<zone> ' (or 'TZ=<zone> ').
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	location := time.Local
+
+	// Time zone.
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		zone, rest, _ := strings.Cut(expr, " ")
+		_, zone, _ = strings.Cut(zone, "=")
+		var err error
+		if location, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", zone)
+		}
+		expr = strings.TrimSpace(rest)
+	}
+
+	// Macros.
+	if strings.HasPrefix(expr, "@") {
+		macro, ok := macros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro %q", expr)
+		}
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+
+	schedule := &Schedule{
+		location: location,
+		domStar:  isStar(fields[2]),
+		dowStar:  isStar(fields[4]),
+	}
+	var errs []error
+	for i, target := range []struct {
+		bits   *uint64
+		bounds bounds
+	}{
+		{&schedule.minute, minuteBounds},
+		{&schedule.hour, hourBounds},
+		{&schedule.dom, domBounds},
+		{&schedule.month, monthBounds},
+		{&schedule.dow, dowBounds},
+	} {
+		bits, err := parseField(fields[i], target.bounds)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*target.bits = bits
+	}
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// Sunday as 7.
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1 << 0
+	}
+
+	return schedule, nil
+}
+
+// isStar reports whether the field matches every value.
+func isStar(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+// parseField parses a comma-separated list of values, ranges and steps into a bitset.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		// Range.
+		var start, end uint
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			start, end = b.min, b.max
+		default:
+			lowStr, highStr, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseValue(lowStr, b); err != nil {
+				return 0, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseValue(highStr, b); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// 'N/step' runs from N to the max.
+				end = b.max
+			}
+		}
+		if start > end {
+			return 0, fmt.Errorf("%s: invalid range %q (start after end)", b.name, part)
+		}
+
+		// Step.
+		step := uint(1)
+		if hasStep {
+			parsed, err := strconv.ParseUint(stepPart, 10, 8)
+			if err != nil || parsed == 0 {
+				return 0, fmt.Errorf("%s: invalid step %q", b.name, stepPart)
+			}
+			step = uint(parsed)
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+// parseValue parses a single number, or name, within the bounds.
+func parseValue(value string, b bounds) (uint, error) {
+	if n, ok := b.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", b.name, value)
+	}
+	if uint(n) < b.min || uint(n) > b.max {
+		return 0, fmt.Errorf("%s: %d out of range [%d-%d]", b.name, n, b.min, b.max)
+	}
+	return uint(n), nil
+}
+
+// Next returns the first time after t that the schedule matches,
+// or the zero time if it does not match within the next 5 years (e.g. 30 February).
+func (s *Schedule) Next(t time.Time) time.Time {
+	origLocation := t.Location()
+	t = t.In(s.location)
+
+	// Start at the next whole minute.
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, s.location).
+		Add(time.Minute)
+	yearLimit := t.Year() + 5
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t.In(origLocation)
+	}
+
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t matches the day-of-month and day-of-week fields.
+//
+// When both are restricted, either matching is enough.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// MinInterval returns the shortest gap between the next `runs` times the schedule matches after t,
+// or 0 if it does not match twice.
+func (s *Schedule) MinInterval(t time.Time, runs int) time.Duration {
+	var shortest time.Duration
+	prev := s.Next(t)
+	for range runs - 1 {
+		next := s.Next(prev)
+		if prev.IsZero() || next.IsZero() {
+			break
+		}
+		if gap := next.Sub(prev); shortest == 0 || gap < shortest {
+			shortest = gap
+		}
+		prev = next
+	}
+	return shortest
+}
@@ -0,0 +1,218 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestParse(t *testing.T) {
+	// GIVEN: a cron expression.
+	tests := map[string]struct {
+		expr     string
+		errRegex string
+	}{
+		"every minute": {
+			expr:     "* * * * *",
+			errRegex: `^$`},
+		"lists, ranges and steps": {
+			expr:     "0,30 9-17/2 1-15 */3 1-5",
+			errRegex: `^$`},
+		"names": {
+			expr:     "0 9 * JAN-jun mon-FRI",
+			errRegex: `^$`},
+		"sunday as 7": {
+			expr:     "0 0 * * 7",
+			errRegex: `^$`},
+		"macro": {
+			expr:     "@daily",
+			errRegex: `^$`},
+		"time zone": {
+			expr:     "CRON_TZ=UTC 0 9 * * 1-5",
+			errRegex: `^$`},
+		"unknown time zone": {
+			expr:     "CRON_TZ=Nowhere/Special 0 9 * * *",
+			errRegex: `^unknown time zone "Nowhere/Special"$`},
+		"unknown macro": {
+			expr:     "@fortnightly",
+			errRegex: `^unknown macro "@fortnightly"$`},
+		"too few fields": {
+			expr:     "0 9 * *",
+			errRegex: `^expected 5 fields .*, got 4$`},
+		"value out of range": {
+			expr:     "60 * * * *",
+			errRegex: `^minute: 60 out of range \[0-59\]$`},
+		"invalid value": {
+			expr:     "* * * foo *",
+			errRegex: `^month: invalid value "foo"$`},
+		"invalid range": {
+			expr:     "* 17-9 * * *",
+			errRegex: `^hour: invalid range "17-9" \(start after end\)$`},
+		"invalid step": {
+			expr:     "*/0 * * * *",
+			errRegex: `^minute: invalid step "0"$`},
+		"multiple errors": {
+			expr: "60 24 * * *",
+			errRegex: `^minute: 60 out of range \[0-59\]
+hour: 24 out of range \[0-23\]$`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: Parse is called.
+			_, err := Parse(tc.expr)
+
+			// THEN: any error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s\nParse(%q) error mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.expr, e, tc.errRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// Monday.
+	from := time.Date(2026, time.March, 2, 10, 30, 15, 0, time.UTC)
+
+	// GIVEN: a Schedule.
+	tests := map[string]struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		"every minute": {
+			expr: "* * * * *",
+			from: from,
+			want: time.Date(2026, time.March, 2, 10, 31, 0, 0, time.UTC)},
+		"later today": {
+			expr: "45 10 * * *",
+			from: from,
+			want: time.Date(2026, time.March, 2, 10, 45, 0, 0, time.UTC)},
+		"tomorrow": {
+			expr: "0 9 * * *",
+			from: from,
+			want: time.Date(2026, time.March, 3, 9, 0, 0, 0, time.UTC)},
+		"weekdays before standup, from friday": {
+			expr: "0 9 * * mon-fri",
+			from: time.Date(2026, time.March, 6, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2026, time.March, 9, 9, 0, 0, 0, time.UTC)},
+		"step": {
+			expr: "*/20 * * * *",
+			from: from,
+			want: time.Date(2026, time.March, 2, 10, 40, 0, 0, time.UTC)},
+		"next month": {
+			expr: "0 0 1 * *",
+			from: from,
+			want: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		"day-of-month or day-of-week when both restricted": {
+			expr: "0 0 15 * sun",
+			from: from,
+			want: time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC)},
+		"leap day": {
+			expr: "0 0 29 2 *",
+			from: from,
+			want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		"never matches": {
+			expr: "0 0 30 2 *",
+			from: from,
+			want: time.Time{}},
+		"time zone": {
+			expr: "CRON_TZ=Asia/Tokyo 0 9 * * *",
+			from: from,
+			want: time.Date(2026, time.March, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := Parse(tc.expr)
+			if err != nil {
+				t.Fatalf("%s\nParse(%q) unexpected error: %v",
+					packageName, tc.expr, err)
+			}
+
+			// WHEN: Next is called.
+			got := schedule.Next(tc.from)
+
+			// THEN: the next matching time is returned.
+			if !got.Equal(tc.want) {
+				t.Errorf(
+					"%s\nSchedule.Next(%s) mismatch\ngot:  %s\nwant: %s",
+					packageName, tc.from, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestSchedule_MinInterval(t *testing.T) {
+	// Friday.
+	from := time.Date(2026, time.March, 6, 12, 0, 0, 0, time.UTC)
+
+	// GIVEN: a Schedule.
+	tests := map[string]struct {
+		expr string
+		want time.Duration
+	}{
+		"every 5 minutes": {
+			expr: "*/5 * * * *",
+			want: 5 * time.Minute},
+		"weekdays crosses the weekend": {
+			expr: "CRON_TZ=UTC 0 9 * * 1-5",
+			want: 24 * time.Hour},
+		"uneven hours": {
+			expr: "0 9,12,20 * * *",
+			want: 3 * time.Hour},
+		"never matches": {
+			expr: "0 0 30 2 *",
+			want: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := Parse(tc.expr)
+			if err != nil {
+				t.Fatalf("%s\nParse(%q) unexpected error: %v",
+					packageName, tc.expr, err)
+			}
+
+			// WHEN: MinInterval is called.
+			got := schedule.MinInterval(from, 8)
+
+			// THEN: the shortest gap is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nSchedule.MinInterval() mismatch\ngot:  %s\nwant: %s",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
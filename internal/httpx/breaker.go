@@ -0,0 +1,229 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// BreakerThreshold is the number of consecutive failures to a host before its circuit opens.
+	BreakerThreshold = 5
+	// BreakerCooldown is how long a circuit stays open after the threshold is first reached.
+	//
+	// The cooldown doubles with every further failure, up to [BreakerMaxCooldown].
+	BreakerCooldown = 30 * time.Second
+	// BreakerMaxCooldown is the longest a circuit stays open after a failure.
+	BreakerMaxCooldown = 30 * time.Minute
+	// MaxRetryAfter is the longest a Retry-After, or rate-limit reset, header may hold a circuit open.
+	MaxRetryAfter = 24 * time.Hour
+)
+
+// ErrCircuitOpen is returned for requests to a host whose circuit is open.
+type ErrCircuitOpen struct {
+	Host  string    // Host the request was for.
+	Until time.Time // Time the circuit closes.
+}
+
+// Error returns the error message.
+func (e *ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("circuit open for %q after repeated failures, retrying after %s",
+		e.Host, e.Until.UTC().Format(time.RFC3339))
+}
+
+// ErrRetryAfter is returned for a failed response that says when the request may be retried.
+type ErrRetryAfter struct {
+	Host       string    // Host the request was for.
+	StatusCode int       // Status code of the response.
+	Until      time.Time // Time the request may be retried.
+}
+
+// Error returns the error message.
+func (e *ErrRetryAfter) Error() string {
+	return fmt.Sprintf("%d %s from %q, retrying after %s",
+		e.StatusCode, http.StatusText(e.StatusCode), e.Host, e.Until.UTC().Format(time.RFC3339))
+}
+
+// RetryAt returns the time err says a request may be retried,
+// and whether err is (or wraps) an [ErrCircuitOpen] or [ErrRetryAfter].
+func RetryAt(err error) (time.Time, bool) {
+	var circuitErr *ErrCircuitOpen
+	if errors.As(err, &circuitErr) {
+		return circuitErr.Until, true
+	}
+	var retryErr *ErrRetryAfter
+	if errors.As(err, &retryErr) {
+		return retryErr.Until, true
+	}
+	return time.Time{}, false
+}
+
+// circuit is the state of the requests to a host.
+type circuit struct {
+	failures  int       // Consecutive failures.
+	openUntil time.Time // Requests are refused until this time.
+}
+
+// circuits holds the circuit of each host that has failed since its last success.
+var circuits = struct {
+	mu    sync.Mutex
+	hosts map[string]*circuit
+}{hosts: make(map[string]*circuit)}
+
+// allow returns an [ErrCircuitOpen] if requests to host are refused at now.
+func allow(host string, now time.Time) error {
+	circuits.mu.Lock()
+	defer circuits.mu.Unlock()
+
+	if c := circuits.hosts[host]; c != nil && now.Before(c.openUntil) {
+		return &ErrCircuitOpen{Host: host, Until: c.openUntil}
+	}
+	return nil
+}
+
+// record updates the circuit of host with the outcome of a request sent at now.
+//
+// Transport errors, 429 and 5xx responses are failures.
+// A Retry-After, or rate-limit reset, header on an error response holds the circuit open until then,
+// and is returned as an [ErrRetryAfter] for a failure.
+func record(host string, resp *http.Response, err error, now time.Time) error {
+	circuits.mu.Lock()
+	defer circuits.mu.Unlock()
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	failed := err != nil ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+	if !failed {
+		// Error responses that aren't failures of the host (e.g. 404) leave its circuit alone.
+		if resp.StatusCode < http.StatusBadRequest {
+			delete(circuits.hosts, host)
+		} else if retryAt, ok := RetryAfter(resp.Header, now); ok {
+			circuitFor(host).holdUntil(retryAt)
+		}
+		return nil
+	}
+
+	c := circuitFor(host)
+	c.failures++
+	if c.failures >= BreakerThreshold {
+		c.holdUntil(now.Add(cooldown(c.failures - BreakerThreshold)))
+	}
+	if resp != nil {
+		if retryAt, ok := RetryAfter(resp.Header, now); ok {
+			c.holdUntil(retryAt)
+			return &ErrRetryAfter{Host: host, StatusCode: resp.StatusCode, Until: retryAt}
+		}
+	}
+	return nil
+}
+
+// circuitFor returns the circuit of host, creating it if needed.
+//
+// circuits.mu must be held.
+func circuitFor(host string) *circuit {
+	c := circuits.hosts[host]
+	if c == nil {
+		c = &circuit{}
+		circuits.hosts[host] = c
+	}
+	return c
+}
+
+// holdUntil keeps the circuit open until at least t.
+func (c *circuit) holdUntil(t time.Time) {
+	if t.After(c.openUntil) {
+		c.openUntil = t
+	}
+}
+
+// cooldown returns how long a circuit stays open after the nth failure beyond the threshold.
+func cooldown(n int) time.Duration {
+	if n >= 32 {
+		return BreakerMaxCooldown
+	}
+	return time.Duration(min(float64(BreakerCooldown)*math.Pow(2, float64(n)), float64(BreakerMaxCooldown)))
+}
+
+// RetryAfter returns the time the headers say a request may be retried,
+// and whether they said so.
+//
+// Checked in order:
+//   - Retry-After (delay in seconds, or an HTTP date).
+//   - X-RateLimit-Reset (Unix time) when X-RateLimit-Remaining is 0.
+//   - RateLimit-Reset (delay in seconds) when RateLimit-Remaining is 0.
+//   - X-RateLimit-Reset-After (delay in seconds).
+//
+// The time is capped at [MaxRetryAfter] after now.
+func RetryAfter(header http.Header, now time.Time) (time.Time, bool) {
+	retryAt, ok := retryAfter(header, now)
+	if !ok || !retryAt.After(now) {
+		return time.Time{}, false
+	}
+	if limit := now.Add(MaxRetryAfter); retryAt.After(limit) {
+		return limit, true
+	}
+	return retryAt, true
+}
+
+// retryAfter returns the uncapped time the headers say a request may be retried,
+// and whether they said so.
+func retryAfter(header http.Header, now time.Time) (time.Time, bool) {
+	if value := strings.TrimSpace(header.Get("Retry-After")); value != "" {
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+			return now.Add(secondsDuration(seconds)), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return date, true
+		}
+	}
+
+	if strings.TrimSpace(header.Get("X-RateLimit-Remaining")) == "0" {
+		if epoch, err := strconv.ParseInt(strings.TrimSpace(header.Get("X-RateLimit-Reset")), 10, 64); err == nil {
+			return time.Unix(epoch, 0), true
+		}
+	}
+
+	if strings.TrimSpace(header.Get("RateLimit-Remaining")) == "0" {
+		if seconds, err := strconv.ParseFloat(strings.TrimSpace(header.Get("RateLimit-Reset")), 64); err == nil {
+			return now.Add(secondsDuration(seconds)), true
+		}
+	}
+
+	if seconds, err := strconv.ParseFloat(strings.TrimSpace(header.Get("X-RateLimit-Reset-After")), 64); err == nil {
+		return now.Add(secondsDuration(seconds)), true
+	}
+
+	return time.Time{}, false
+}
+
+// secondsDuration returns seconds as a duration, clamped to [0, MaxRetryAfter].
+func secondsDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return min(time.Duration(seconds*float64(time.Second)), MaxRetryAfter)
+}
@@ -0,0 +1,368 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// GIVEN: response headers.
+	tests := map[string]struct {
+		header http.Header
+		want   time.Time
+		wantOk bool
+	}{
+		"no headers": {
+			header: http.Header{},
+		},
+		"Retry-After seconds": {
+			header: http.Header{"Retry-After": {"120"}},
+			want:   now.Add(2 * time.Minute),
+			wantOk: true,
+		},
+		"Retry-After HTTP date": {
+			header: http.Header{"Retry-After": {now.Add(time.Hour).Format(http.TimeFormat)}},
+			want:   now.Add(time.Hour),
+			wantOk: true,
+		},
+		"Retry-After in the past": {
+			header: http.Header{"Retry-After": {now.Add(-time.Hour).Format(http.TimeFormat)}},
+		},
+		"Retry-After invalid": {
+			header: http.Header{"Retry-After": {"soon"}},
+		},
+		"Retry-After capped": {
+			header: http.Header{"Retry-After": {"999999999"}},
+			want:   now.Add(MaxRetryAfter),
+			wantOk: true,
+		},
+		"X-RateLimit-Reset with none remaining": {
+			header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {fmt.Sprint(now.Add(30 * time.Minute).Unix())},
+			},
+			want:   now.Add(30 * time.Minute),
+			wantOk: true,
+		},
+		"X-RateLimit-Reset with some remaining": {
+			header: http.Header{
+				"X-Ratelimit-Remaining": {"10"},
+				"X-Ratelimit-Reset":     {fmt.Sprint(now.Add(30 * time.Minute).Unix())},
+			},
+		},
+		"RateLimit-Reset with none remaining": {
+			header: http.Header{
+				"Ratelimit-Remaining": {"0"},
+				"Ratelimit-Reset":     {"90"},
+			},
+			want:   now.Add(90 * time.Second),
+			wantOk: true,
+		},
+		"X-RateLimit-Reset-After": {
+			header: http.Header{"X-Ratelimit-Reset-After": {"1.5"}},
+			want:   now.Add(1500 * time.Millisecond),
+			wantOk: true,
+		},
+		"Retry-After takes precedence": {
+			header: http.Header{
+				"Retry-After":             {"10"},
+				"X-Ratelimit-Reset-After": {"60"},
+			},
+			want:   now.Add(10 * time.Second),
+			wantOk: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: RetryAfter is called.
+			got, gotOk := RetryAfter(tc.header, now)
+
+			// THEN: the retry time is as expected.
+			if !got.Equal(tc.want) || gotOk != tc.wantOk {
+				t.Errorf("%s\nRetryAfter() mismatch\ngot:  %v, %t\nwant: %v, %t",
+					packageName, got, gotOk, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	failure := &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	success := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+
+	type outcome struct {
+		resp *http.Response
+		err  error
+	}
+	// GIVEN: a series of request outcomes for a host.
+	tests := map[string]struct {
+		outcomes     []outcome
+		wantFailures int
+		wantOpen     bool
+		wantUntil    time.Time
+		wantRetryErr bool
+	}{
+		"failures below the threshold": {
+			outcomes:     []outcome{{resp: failure}, {err: errors.New("connection refused")}},
+			wantFailures: 2,
+		},
+		"failures reach the threshold": {
+			outcomes: []outcome{
+				{resp: failure}, {resp: failure}, {resp: failure}, {resp: failure}, {resp: failure}},
+			wantFailures: 5,
+			wantOpen:     true,
+			wantUntil:    now.Add(BreakerCooldown),
+		},
+		"cooldown doubles after the threshold": {
+			outcomes: []outcome{
+				{resp: failure}, {resp: failure}, {resp: failure}, {resp: failure}, {resp: failure},
+				{resp: failure}, {resp: failure}},
+			wantFailures: 7,
+			wantOpen:     true,
+			wantUntil:    now.Add(4 * BreakerCooldown),
+		},
+		"success resets": {
+			outcomes: []outcome{
+				{resp: failure}, {resp: failure}, {resp: failure}, {resp: failure}, {resp: failure},
+				{resp: success}},
+		},
+		"404 is not a failure": {
+			outcomes: []outcome{
+				{resp: &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}}}},
+		},
+		"429 with Retry-After opens until then": {
+			outcomes: []outcome{
+				{resp: &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": {"600"}}}}},
+			wantFailures: 1,
+			wantOpen:     true,
+			wantUntil:    now.Add(10 * time.Minute),
+			wantRetryErr: true,
+		},
+		"403 with rate-limit reset opens until then": {
+			outcomes: []outcome{
+				{resp: &http.Response{
+					StatusCode: http.StatusForbidden,
+					Header: http.Header{
+						"X-Ratelimit-Remaining": {"0"},
+						"X-Ratelimit-Reset":     {fmt.Sprint(now.Add(time.Hour).Unix())}}}}},
+			wantOpen:  true,
+			wantUntil: now.Add(time.Hour),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			host := "record.example.com/" + name
+
+			// WHEN: each outcome is recorded.
+			var err error
+			for _, o := range tc.outcomes {
+				err = record(host, o.resp, o.err, now)
+			}
+
+			// THEN: a failure with a retry time returns it.
+			if gotRetry := err != nil; gotRetry != tc.wantRetryErr {
+				t.Errorf("%s\nrecord() error mismatch\ngot:  %v\nwant error: %t",
+					packageName, err, tc.wantRetryErr)
+			}
+
+			// AND: the failures are counted.
+			circuits.mu.Lock()
+			var gotFailures int
+			var gotUntil time.Time
+			if c := circuits.hosts[host]; c != nil {
+				gotFailures, gotUntil = c.failures, c.openUntil
+			}
+			circuits.mu.Unlock()
+			if gotFailures != tc.wantFailures {
+				t.Errorf("%s\nfailures mismatch\ngot:  %d\nwant: %d",
+					packageName, gotFailures, tc.wantFailures)
+			}
+			// AND: the circuit is open/closed as expected.
+			err = allow(host, now)
+			if gotOpen := err != nil; gotOpen != tc.wantOpen {
+				t.Fatalf("%s\nallow() mismatch\ngot:  %v\nwant open: %t",
+					packageName, err, tc.wantOpen)
+			}
+			if tc.wantOpen && !gotUntil.Equal(tc.wantUntil) {
+				t.Errorf("%s\nopen until mismatch\ngot:  %v\nwant: %v",
+					packageName, gotUntil, tc.wantUntil)
+			}
+		})
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	// GIVEN: failures beyond the threshold.
+	tests := map[string]struct {
+		n    int
+		want time.Duration
+	}{
+		"at threshold": {n: 0, want: BreakerCooldown},
+		"one beyond":   {n: 1, want: 2 * BreakerCooldown},
+		"capped":       {n: 20, want: BreakerMaxCooldown},
+		"overflow":     {n: 100, want: BreakerMaxCooldown},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: cooldown is called.
+			got := cooldown(tc.n)
+
+			// THEN: the cooldown is as expected.
+			if got != tc.want {
+				t.Errorf("%s\ncooldown(%d) mismatch\ngot:  %v\nwant: %v",
+					packageName, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDo__circuitOpen(t *testing.T) {
+	// GIVEN: a server that always fails.
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	parsed, _ := url.Parse(server.URL)
+	t.Cleanup(func() {
+		circuits.mu.Lock()
+		delete(circuits.hosts, parsed.Host)
+		circuits.mu.Unlock()
+	})
+
+	// WHEN: more requests than the threshold are made.
+	var err error
+	for i := range BreakerThreshold + 2 {
+		req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%d", server.URL, i), nil)
+		_, _, err = Do(Client, req)
+	}
+
+	// THEN: the server only received requests up to the threshold.
+	if got := int(hits.Load()); got != BreakerThreshold {
+		t.Errorf("%s\nDo() server hits mismatch\ngot:  %d\nwant: %d",
+			packageName, got, BreakerThreshold)
+	}
+	// AND: later requests fail with ErrCircuitOpen.
+	until, ok := RetryAt(err)
+	if !ok || !until.After(time.Now()) {
+		t.Errorf("%s\nDo() error mismatch\ngot:  %v\nwant: ErrCircuitOpen",
+			packageName, err)
+	}
+	// AND: the circuit counted the failures.
+	circuits.mu.Lock()
+	failures := circuits.hosts[parsed.Host].failures
+	circuits.mu.Unlock()
+	if failures != BreakerThreshold {
+		t.Errorf("%s\nfailures mismatch\ngot:  %d\nwant: %d",
+			packageName, failures, BreakerThreshold)
+	}
+}
+
+func TestDo__retryAfter(t *testing.T) {
+	// GIVEN: a server that rate-limits with a Retry-After.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+	parsed, _ := url.Parse(server.URL)
+	t.Cleanup(func() {
+		circuits.mu.Lock()
+		delete(circuits.hosts, parsed.Host)
+		circuits.mu.Unlock()
+	})
+
+	// WHEN: a request is made.
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	start := time.Now()
+	resp, _, err := Do(Client, req)
+
+	// THEN: the first failure returns an ErrRetryAfter.
+	var retryErr *ErrRetryAfter
+	if resp != nil || !errors.As(err, &retryErr) || retryErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("%s\nDo() error mismatch\ngot:  %v\nwant: ErrRetryAfter",
+			packageName, err)
+	}
+	// AND: RetryAt gives the time from the header.
+	until, ok := RetryAt(err)
+	if want := start.Add(10 * time.Minute); !ok || until.Before(want) || until.After(want.Add(time.Minute)) {
+		t.Errorf("%s\nRetryAt() mismatch\ngot:  %v, %t\nwant: ~%v, true",
+			packageName, until, ok, want)
+	}
+}
+
+func TestRetryAt(t *testing.T) {
+	until := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// GIVEN: errors.
+	tests := map[string]struct {
+		err    error
+		wantOk bool
+	}{
+		"nil":          {},
+		"other error":  {err: errors.New("fail")},
+		"circuit open": {err: &ErrCircuitOpen{Host: "example.com", Until: until}, wantOk: true},
+		"wrapped circuit open": {
+			err:    fmt.Errorf("request failed: %w", &ErrCircuitOpen{Host: "example.com", Until: until}),
+			wantOk: true},
+		"retry after": {
+			err:    &ErrRetryAfter{Host: "example.com", StatusCode: http.StatusServiceUnavailable, Until: until},
+			wantOk: true},
+		"wrapped retry after": {
+			err: fmt.Errorf("request failed: %w",
+				&ErrRetryAfter{Host: "example.com", StatusCode: http.StatusTooManyRequests, Until: until}),
+			wantOk: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: RetryAt is called.
+			got, gotOk := RetryAt(tc.err)
+
+			// THEN: the time is only returned for an ErrCircuitOpen or ErrRetryAfter.
+			if gotOk != tc.wantOk || (tc.wantOk && !got.Equal(until)) {
+				t.Errorf("%s\nRetryAt() mismatch\ngot:  %v, %t\nwant: %v, %t",
+					packageName, got, gotOk, until, tc.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"net/http"
+	"sync"
+)
+
+// CacheValidators holds the ETag and Last-Modified of the last response,
+// to make conditional requests with.
+//
+// A nil *CacheValidators is valid, and makes no requests conditional.
+type CacheValidators struct {
+	mu           sync.RWMutex
+	eTag         string
+	lastModified string
+}
+
+// Apply sets the If-None-Match and If-Modified-Since headers of req from the receiver,
+// returning whether the request was made conditional.
+func (v *CacheValidators) Apply(req *http.Request) bool {
+	if v == nil {
+		return false
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.eTag != "" {
+		req.Header.Set("If-None-Match", v.eTag)
+	}
+	if v.lastModified != "" {
+		req.Header.Set("If-Modified-Since", v.lastModified)
+	}
+	return v.eTag != "" || v.lastModified != ""
+}
+
+// Update stores the ETag and Last-Modified of header in the receiver.
+func (v *CacheValidators) Update(header http.Header) {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.eTag = header.Get("ETag")
+	v.lastModified = header.Get("Last-Modified")
+}
+
+// Reset clears the validators, so that the next request is unconditional.
+func (v *CacheValidators) Reset() {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.eTag = ""
+	v.lastModified = ""
+}
@@ -0,0 +1,124 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCacheValidators(t *testing.T) {
+	// GIVEN: response headers.
+	tests := []struct {
+		name                                 string
+		header                               http.Header
+		wantConditional                      bool
+		wantIfNoneMatch, wantIfModifiedSince string
+	}{
+		{
+			name:            "no validators",
+			header:          http.Header{},
+			wantConditional: false,
+		},
+		{
+			name: "ETag",
+			header: http.Header{
+				"Etag": {`"abc"`},
+			},
+			wantConditional: true,
+			wantIfNoneMatch: `"abc"`,
+		},
+		{
+			name: "Last-Modified",
+			header: http.Header{
+				"Last-Modified": {"Wed, 21 Oct 2015 07:28:00 GMT"},
+			},
+			wantConditional:     true,
+			wantIfModifiedSince: "Wed, 21 Oct 2015 07:28:00 GMT",
+		},
+		{
+			name: "ETag and Last-Modified",
+			header: http.Header{
+				"Etag":          {`W/"abc"`},
+				"Last-Modified": {"Wed, 21 Oct 2015 07:28:00 GMT"},
+			},
+			wantConditional:     true,
+			wantIfNoneMatch:     `W/"abc"`,
+			wantIfModifiedSince: "Wed, 21 Oct 2015 07:28:00 GMT",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var validators CacheValidators
+			// WHEN: the validators are updated from the header.
+			validators.Update(tc.header)
+			// AND: applied to a request.
+			req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			gotConditional := validators.Apply(req)
+
+			// THEN: the request is conditional as expected.
+			if gotConditional != tc.wantConditional {
+				t.Errorf(
+					"%s\nCacheValidators.Apply() mismatch\ngot:  %t\nwant: %t",
+					packageName, gotConditional, tc.wantConditional,
+				)
+			}
+			// AND: the conditional headers are set as expected.
+			if got := req.Header.Get("If-None-Match"); got != tc.wantIfNoneMatch {
+				t.Errorf(
+					"%s\nIf-None-Match mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.wantIfNoneMatch,
+				)
+			}
+			if got := req.Header.Get("If-Modified-Since"); got != tc.wantIfModifiedSince {
+				t.Errorf(
+					"%s\nIf-Modified-Since mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.wantIfModifiedSince,
+				)
+			}
+
+			// WHEN: the validators are reset.
+			validators.Reset()
+			req, _ = http.NewRequest(http.MethodGet, "https://example.com", nil)
+
+			// THEN: the request is no longer conditional.
+			if validators.Apply(req) {
+				t.Errorf("%s\nCacheValidators.Apply() after Reset() made the request conditional",
+					packageName)
+			}
+		})
+	}
+}
+
+func TestCacheValidators__nil(t *testing.T) {
+	// GIVEN: nil CacheValidators.
+	var validators *CacheValidators
+
+	// WHEN: the validators are updated, applied and reset.
+	validators.Update(http.Header{"Etag": {`"abc"`}})
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	got := validators.Apply(req)
+	validators.Reset()
+
+	// THEN: the request is not conditional.
+	if got || req.Header.Get("If-None-Match") != "" {
+		t.Errorf("%s\nnil CacheValidators made the request conditional", packageName)
+	}
+}
@@ -19,12 +19,14 @@ import (
 	"crypto/tls"
 	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
 var (
 	Transport = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: proxyFromSettings,
 
 		DialContext: (&net.Dialer{
 			Timeout:   5 * time.Second,
@@ -41,17 +43,42 @@ var (
 	}
 	Client = &http.Client{
 		Timeout:   15 * time.Second,
-		Transport: Transport,
+		Transport: Limited(Transport),
 	}
 	InsecureTransport = Transport.Clone()
 	InsecureClient    = &http.Client{
 		Timeout:   15 * time.Second,
-		Transport: InsecureTransport,
+		Transport: Limited(InsecureTransport),
 	}
 )
 
+// clientKey identifies a cached client by its (env-evaluated) TLS and proxy settings.
+type clientKey struct {
+	tls      TLS
+	proxyURL string
+	noProxy  string // Comma-separated.
+	insecure bool
+}
+
+// cachedClient is a client and the modification times of the TLS files it was built from.
+type cachedClient struct {
+	client   *http.Client
+	modTimes [3]int64
+}
+
+// clients caches a client per TLS/proxy config, so requests with the same settings share connections.
+var clients = struct {
+	mu sync.Mutex
+	m  map[clientKey]*cachedClient
+}{m: make(map[clientKey]*cachedClient)}
+
 func init() {
 	configureInsecureTransport(InsecureTransport)
+
+	// Route requests made by dependencies through the global proxy too.
+	if tr, ok := http.DefaultTransport.(*http.Transport); ok {
+		tr.Proxy = proxyFromSettings
+	}
 }
 
 func configureInsecureTransport(tr *http.Transport) {
@@ -62,3 +89,61 @@ func configureInsecureTransport(tr *http.Transport) {
 	//#nosec G402 -- explicitly wanted InsecureSkipVerify
 	tr.TLSClientConfig.InsecureSkipVerify = true
 }
+
+// ClientFor returns the client to use for the TLS and proxy settings given,
+// skipping certificate verification when insecure.
+// A nil proxy uses the global proxy (see [SetProxy]).
+//
+// Clients are cached by TLS/proxy config, and rebuilt when a file they reference is modified.
+func ClientFor(tlsCfg *TLS, proxy *Proxy, insecure bool) (*http.Client, error) {
+	if tlsCfg.IsZero() && proxy == nil {
+		if insecure {
+			return InsecureClient, nil
+		}
+		return Client, nil
+	}
+
+	key := clientKey{insecure: insecure}
+	if tlsCfg != nil {
+		key.tls = tlsCfg.evaluated()
+	}
+	var evaluatedProxy Proxy
+	if proxy != nil {
+		evaluatedProxy = proxy.evaluated()
+		key.proxyURL = evaluatedProxy.URL
+		key.noProxy = strings.Join(evaluatedProxy.NoProxy, ",")
+	}
+	modTimes := key.tls.modTimes()
+
+	clients.mu.Lock()
+	defer clients.mu.Unlock()
+	cached := clients.m[key]
+	if cached != nil && cached.modTimes == modTimes {
+		return cached.client, nil
+	}
+
+	cfg, err := key.tls.config()
+	if err != nil {
+		return nil, err
+	}
+	tr := Transport.Clone()
+	tr.TLSClientConfig = cfg
+	if proxy != nil {
+		tr.Proxy = evaluatedProxy.forRequest
+	}
+	if insecure {
+		configureInsecureTransport(tr)
+	}
+
+	// Close the connections of the client being replaced.
+	if cached != nil {
+		cached.client.CloseIdleConnections()
+	}
+	client := &http.Client{
+		Timeout:   Client.Timeout,
+		Transport: Limited(tr),
+	}
+	clients.m[key] = &cachedClient{client: client, modTimes: modTimes}
+
+	return client, nil
+}
@@ -18,9 +18,16 @@ package httpx
 
 import (
 	"crypto/tls"
+	"encoding/pem"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/util"
 )
 
 func TestConfigureInsecureTransport(t *testing.T) {
@@ -85,3 +92,188 @@ func TestInit(t *testing.T) {
 		t.Fatalf("%s\nInsecureTransport.TLSClientConfig.InsecureSkipVerify is false after init", packageName)
 	}
 }
+
+func TestClientFor(t *testing.T) {
+	certFile, keyFile := writeCertFiles(t, t.TempDir())
+
+	// GIVEN: TLS settings, and whether to skip verification.
+	tests := []struct {
+		name       string
+		tls        *TLS
+		proxy      *Proxy
+		insecure   bool
+		wantClient *http.Client
+		wantErr    bool
+	}{
+		{
+			name:       "nil TLS",
+			wantClient: Client,
+		},
+		{
+			name:       "nil TLS, insecure",
+			insecure:   true,
+			wantClient: InsecureClient,
+		},
+		{
+			name:       "empty TLS",
+			tls:        &TLS{},
+			wantClient: Client,
+		},
+		{
+			name: "ca_file",
+			tls:  &TLS{CAFile: certFile},
+		},
+		{
+			name:     "client certificate, insecure",
+			tls:      &TLS{CertFile: certFile, KeyFile: keyFile},
+			insecure: true,
+		},
+		{
+			name:  "proxy",
+			proxy: &Proxy{URL: "http://proxy:3128"},
+		},
+		{
+			name:    "invalid ca_file",
+			tls:     &TLS{CAFile: keyFile},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: ClientFor is called.
+			got, err := ClientFor(tc.tls, tc.proxy, tc.insecure)
+
+			prefix := fmt.Sprintf("%s\nClientFor(%+v, %+v, %t)", packageName, tc.tls, tc.proxy, tc.insecure)
+
+			// THEN: an error is returned when expected.
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s error mismatch\ngot:  %v\nwantErr: %t", prefix, err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			// AND: the shared clients are returned without TLS settings.
+			if tc.wantClient != nil {
+				if got != tc.wantClient {
+					t.Errorf("%s returned the wrong shared client", prefix)
+				}
+				return
+			}
+			// AND: the client is built from the TLS settings.
+			tr := got.Transport.(*limitedTransport).base.(*http.Transport)
+			cfg := tr.TLSClientConfig
+			tlsCfg := util.DerefOrZero(tc.tls)
+			if cfg.InsecureSkipVerify != tc.insecure {
+				t.Errorf(
+					"%s InsecureSkipVerify mismatch\ngot:  %t\nwant: %t",
+					prefix, cfg.InsecureSkipVerify, tc.insecure,
+				)
+			}
+			if (cfg.RootCAs != nil) != (tlsCfg.CAFile != "") {
+				t.Errorf("%s RootCAs set=%t, want %t", prefix, cfg.RootCAs != nil, tlsCfg.CAFile != "")
+			}
+			if len(cfg.Certificates) != 0 != (tlsCfg.CertFile != "") {
+				t.Errorf("%s Certificates=%d, want cert=%t", prefix, len(cfg.Certificates), tlsCfg.CertFile != "")
+			}
+			// AND: the proxy is used when given.
+			if tc.proxy != nil {
+				req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+				if proxyURL, _ := tr.Proxy(req); proxyURL == nil || proxyURL.String() != tc.proxy.URL {
+					t.Errorf("%s proxy mismatch\ngot:  %v\nwant: %s", prefix, proxyURL, tc.proxy.URL)
+				}
+			}
+			// AND: the client is cached.
+			if again, _ := ClientFor(tc.tls.Copy(), tc.proxy.Copy(), tc.insecure); again != got {
+				t.Errorf("%s client wasn't cached", prefix)
+			}
+		})
+	}
+}
+
+func TestClientFor__rebuildOnModify(t *testing.T) {
+	// GIVEN: a client for a CA file.
+	certFile, _ := writeCertFiles(t, t.TempDir())
+	cfg := &TLS{CAFile: certFile, ServerName: "rebuild"}
+	first, err := ClientFor(cfg, nil, false)
+	if err != nil {
+		t.Fatalf("%s\nClientFor() unexpected error: %v", packageName, err)
+	}
+
+	// WHEN: the CA file is modified.
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(certFile, later, later); err != nil {
+		t.Fatalf("%s\nfailed modifying file: %v", packageName, err)
+	}
+	second, err := ClientFor(cfg, nil, false)
+	if err != nil {
+		t.Fatalf("%s\nClientFor() unexpected error: %v", packageName, err)
+	}
+
+	// THEN: a new client is built.
+	if first == second {
+		t.Errorf("%s\nClientFor() returned the cached client after the CA file changed", packageName)
+	}
+}
+
+func TestClientFor__caFile(t *testing.T) {
+	// GIVEN: a TLS server, and a CA file containing its certificate.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("%s\nfailed writing CA file: %v", packageName, err)
+	}
+
+	// WHEN: requests are made with and without the CA file.
+	_, errDefault := Client.Get(server.URL)
+	client, err := ClientFor(&TLS{CAFile: caFile, ServerName: "example.com"}, nil, false)
+	if err != nil {
+		t.Fatalf("%s\nClientFor() unexpected error: %v", packageName, err)
+	}
+	resp, errCA := client.Get(server.URL)
+	if errCA == nil {
+		_ = resp.Body.Close()
+	}
+
+	// THEN: only the request trusting the CA file succeeds.
+	if errDefault == nil {
+		t.Errorf("%s\nrequest without the CA file succeeded", packageName)
+	}
+	if errCA != nil {
+		t.Errorf("%s\nrequest with the CA file failed: %v", packageName, errCA)
+	}
+}
+
+func TestClientFor__caPEM(t *testing.T) {
+	// GIVEN: a TLS server, and its certificate as a PEM CA bundle.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// WHEN: a request is made trusting only the PEM CA bundle.
+	client, err := ClientFor(TLSFromPEM(caPEM, nil, nil, "example.com"), nil, false)
+	if err != nil {
+		t.Fatalf("%s\nClientFor() unexpected error: %v", packageName, err)
+	}
+	resp, errCA := client.Get(server.URL)
+	if errCA == nil {
+		_ = resp.Body.Close()
+	}
+
+	// THEN: the request succeeds.
+	if errCA != nil {
+		t.Errorf("%s\nrequest with the PEM CA bundle failed: %v", packageName, errCA)
+	}
+	// AND: the client is cached.
+	if again, _ := ClientFor(TLSFromPEM(caPEM, nil, nil, "example.com"), nil, false); again != client {
+		t.Errorf("%s\nClientFor() returned a new client for the same PEM CA bundle", packageName)
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+// rateUnits are the units a Limit.Rate may be given per.
+var rateUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+}
+
+// Limit is a limit on the rate and concurrency of requests.
+type Limit struct {
+	Rate          string `json:"rate,omitzero" yaml:"rate,omitzero"`                     // e.g. '10/s', '100/m' or '1000/h' (a bare number is per second).
+	Burst         int    `json:"burst,omitzero" yaml:"burst,omitzero"`                   // Requests that may be sent at once before the rate applies (default: 1).
+	MaxConcurrent int    `json:"max_concurrent,omitzero" yaml:"max_concurrent,omitzero"` // Requests that may be in-flight at once (0 = unlimited).
+}
+
+// Limits holds the limits on outbound requests.
+//
+// A request waits for the limit of its host (Hosts, else PerHost) and then the Global limit.
+// Time spent waiting counts towards the timeout of the request.
+type Limits struct {
+	Global  *Limit            `json:"global,omitzero" yaml:"global,omitzero"`     // Limit on all requests.
+	PerHost *Limit            `json:"per_host,omitzero" yaml:"per_host,omitzero"` // Limit on the requests to each host.
+	Hosts   map[string]*Limit `json:"hosts,omitempty" yaml:"hosts,omitempty"`     // Limit on the requests to specific hosts, instead of PerHost.
+}
+
+// Copy returns a deep copy of the receiver.
+func (l *Limits) Copy() *Limits {
+	if l == nil {
+		return nil
+	}
+
+	limits := &Limits{
+		Global:  l.Global.Copy(),
+		PerHost: l.PerHost.Copy(),
+	}
+	if l.Hosts != nil {
+		limits.Hosts = make(map[string]*Limit, len(l.Hosts))
+		for host, limit := range l.Hosts {
+			limits.Hosts[host] = limit.Copy()
+		}
+	}
+	return limits
+}
+
+// Copy returns a copy of the receiver.
+func (l *Limit) Copy() *Limit {
+	if l == nil {
+		return nil
+	}
+
+	limit := *l
+	return &limit
+}
+
+// CheckValues validates the fields of the receiver.
+func (l *Limits) CheckValues() error {
+	if l == nil {
+		return nil
+	}
+
+	var errs []error
+	// Global.
+	if err := l.Global.CheckValues(); err != nil {
+		errs = append(errs, &decode.ErrKeyField{Key: "global", Err: err})
+	}
+	// PerHost.
+	if err := l.PerHost.CheckValues(); err != nil {
+		errs = append(errs, &decode.ErrKeyField{Key: "per_host", Err: err})
+	}
+	// Hosts.
+	var hostErrs []error
+	for _, host := range util.SortedKeys(l.Hosts) {
+		if err := l.Hosts[host].CheckValues(); err != nil {
+			hostErrs = append(hostErrs, &decode.ErrKeyField{Key: host, Err: err})
+		}
+	}
+	if len(hostErrs) != 0 {
+		errs = append(errs, &decode.ErrKeyField{Key: "hosts", Err: errors.Join(hostErrs...)})
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckValues validates the fields of the receiver.
+func (l *Limit) CheckValues() error {
+	if l == nil {
+		return nil
+	}
+
+	var errs []error
+	// Rate.
+	if l.Rate != "" {
+		if _, err := parseRate(l.Rate); err != nil {
+			errs = append(errs, &decode.ErrField{
+				Key:         "rate",
+				Value:       l.Rate,
+				Description: err.Error(),
+			})
+		}
+	}
+	// Burst.
+	if l.Burst < 0 {
+		errs = append(errs, &decode.ErrField{
+			Key:         "burst",
+			Value:       strconv.Itoa(l.Burst),
+			Description: "must be 0 or greater",
+		})
+	}
+	// MaxConcurrent.
+	if l.MaxConcurrent < 0 {
+		errs = append(errs, &decode.ErrField{
+			Key:         "max_concurrent",
+			Value:       strconv.Itoa(l.MaxConcurrent),
+			Description: "must be 0 or greater",
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// parseRate returns the requests per second of a 'N', 'N/s', 'N/m' or 'N/h' rate.
+func parseRate(rate string) (float64, error) {
+	count, unit, _ := strings.Cut(strings.TrimSpace(rate), "/")
+	per := time.Second
+	if unit != "" {
+		var ok bool
+		if per, ok = rateUnits[strings.TrimSpace(unit)]; !ok {
+			return 0, errors.New("use 'N/s', 'N/m' or 'N/h' format")
+		}
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(count), 64)
+	if err != nil {
+		return 0, errors.New("use 'N/s', 'N/m' or 'N/h' format")
+	}
+	if n <= 0 {
+		return 0, errors.New("must be greater than 0")
+	}
+	return n / per.Seconds(), nil
+}
+
+// limiter enforces a Limit with a token bucket and a semaphore.
+type limiter struct {
+	rate  float64       // Tokens added per second (0 = unlimited).
+	burst float64       // Most tokens the bucket holds.
+	slots chan struct{} // Semaphore of in-flight requests (nil = unlimited).
+
+	mu     sync.Mutex // Lock for tokens and last.
+	tokens float64    // Tokens in the bucket, negative when reserved ahead.
+	last   time.Time  // Time tokens was last updated.
+}
+
+// newLimiter returns a limiter for limit, or nil if it doesn't limit anything.
+func newLimiter(limit *Limit) *limiter {
+	if limit == nil {
+		return nil
+	}
+
+	rate, _ := parseRate(limit.Rate)
+	if rate == 0 && limit.MaxConcurrent == 0 {
+		return nil
+	}
+
+	l := &limiter{
+		rate:  rate,
+		burst: float64(max(limit.Burst, 1)),
+	}
+	l.tokens = l.burst
+	if limit.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, limit.MaxConcurrent)
+	}
+	return l
+}
+
+// reserve takes a token, returning how long to wait before sending the request.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	if l.rate == 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// unreserve returns a token taken by reserve that went unused.
+func (l *limiter) unreserve() {
+	if l.rate == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// limiterSet holds the limiters of a Limits.
+type limiterSet struct {
+	limits *Limits  // Limits the limiters enforce.
+	global *limiter // Limiter of all requests.
+
+	mu    sync.Mutex          // Lock for hosts.
+	hosts map[string]*limiter // Limiter of each host that has been requested.
+}
+
+// forHost returns the limiters that apply to requests to host.
+func (s *limiterSet) forHost(host string) []*limiter {
+	s.mu.Lock()
+	hostLimiter, ok := s.hosts[host]
+	if !ok {
+		limit := s.limits.PerHost
+		if hostLimit, ok := s.limits.Hosts[host]; ok {
+			limit = hostLimit
+		}
+		hostLimiter = newLimiter(limit)
+		s.hosts[host] = hostLimiter
+	}
+	s.mu.Unlock()
+
+	limiters := make([]*limiter, 0, 2)
+	if hostLimiter != nil {
+		limiters = append(limiters, hostLimiter)
+	}
+	if s.global != nil {
+		limiters = append(limiters, s.global)
+	}
+	return limiters
+}
+
+// limits holds the limiters of the current Limits (nil when there are none).
+var limits atomic.Pointer[limiterSet]
+
+// SetLimits sets the limits on outbound requests, removing them when nil.
+//
+// Requests already waiting keep to the limits they started with.
+func SetLimits(l *Limits) {
+	if l == nil || (l.Global == nil && l.PerHost == nil && len(l.Hosts) == 0) {
+		limits.Store(nil)
+		return
+	}
+
+	l = l.Copy()
+	hosts := make(map[string]*Limit, len(l.Hosts))
+	for host, limit := range l.Hosts {
+		hosts[strings.ToLower(host)] = limit
+	}
+	l.Hosts = hosts
+	limits.Store(&limiterSet{
+		limits: l,
+		global: newLimiter(l.Global),
+		hosts:  make(map[string]*limiter),
+	})
+}
+
+// acquire waits until a request to host is allowed by the limits,
+// returning the function to call once the request completes.
+func acquire(ctx context.Context, host string) (func(), error) {
+	set := limits.Load()
+	if set == nil {
+		return func() {}, nil
+	}
+	limiters := set.forHost(host)
+	if len(limiters) == 0 {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	metric.HTTPRequestQueueCurrent.WithLabelValues(host).Inc()
+	defer metric.HTTPRequestQueueCurrent.WithLabelValues(host).Dec()
+
+	// Wait for a free slot.
+	var held []*limiter
+	release := func() {
+		for _, l := range held {
+			<-l.slots
+		}
+	}
+	for _, l := range limiters {
+		if l.slots == nil {
+			continue
+		}
+		select {
+		case l.slots <- struct{}{}:
+			held = append(held, l)
+		case <-ctx.Done():
+			release()
+			return nil, fmt.Errorf("waiting for request limit: %w", ctx.Err())
+		}
+	}
+
+	// Wait for a token.
+	for i, l := range limiters {
+		wait := l.reserve(time.Now())
+		if wait == 0 {
+			continue
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			for _, reserved := range limiters[:i+1] {
+				reserved.unreserve()
+			}
+			release()
+			return nil, fmt.Errorf("waiting for request limit: %w", ctx.Err())
+		}
+	}
+
+	metric.HTTPRequestWaitSeconds.WithLabelValues(host).Observe(time.Since(start).Seconds())
+	return sync.OnceFunc(release), nil
+}
+
+// limitedTransport sends requests with its base RoundTripper within the limits (see [SetLimits]).
+type limitedTransport struct {
+	base http.RoundTripper
+}
+
+// Limited returns a RoundTripper that sends requests with base within the limits (see [SetLimits]).
+func Limited(base http.RoundTripper) http.RoundTripper {
+	return &limitedTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+//
+// A concurrency slot is held until the response Body is closed.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := acquire(req.Context(), strings.ToLower(req.URL.Hostname()))
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err //nolint:wrapcheck
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody is a response Body that releases its limits when closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the Body and releases its limits.
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close() //nolint:wrapcheck
+}
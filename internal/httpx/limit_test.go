@@ -0,0 +1,301 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+func TestParseRate(t *testing.T) {
+	// GIVEN: a rate.
+	tests := map[string]struct {
+		rate     string
+		want     float64
+		errRegex string
+	}{
+		"bare number": {
+			rate: "5", want: 5, errRegex: `^$`},
+		"per second": {
+			rate: "2/s", want: 2, errRegex: `^$`},
+		"per minute": {
+			rate: "120/m", want: 2, errRegex: `^$`},
+		"per hour": {
+			rate: "3600/h", want: 1, errRegex: `^$`},
+		"fraction": {
+			rate: "0.5/s", want: 0.5, errRegex: `^$`},
+		"unknown unit": {
+			rate: "5/d", errRegex: `^use 'N/s', 'N/m' or 'N/h' format$`},
+		"not a number": {
+			rate: "five/s", errRegex: `^use 'N/s', 'N/m' or 'N/h' format$`},
+		"zero": {
+			rate: "0/s", errRegex: `^must be greater than 0$`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: parseRate is called.
+			got, err := parseRate(tc.rate)
+
+			// THEN: the rate is parsed as expected.
+			if e := errfmt.FormatError(err); !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf("%s\nparseRate(%q) error mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.rate, e, tc.errRegex)
+			}
+			if got != tc.want {
+				t.Errorf("%s\nparseRate(%q) mismatch\ngot:  %v\nwant: %v",
+					packageName, tc.rate, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLimits_CheckValues(t *testing.T) {
+	// GIVEN: Limits.
+	tests := map[string]struct {
+		limits   *Limits
+		errRegex string
+	}{
+		"nil": {
+			errRegex: `^$`,
+		},
+		"valid": {
+			limits: &Limits{
+				Global:  &Limit{Rate: "50/s", Burst: 100, MaxConcurrent: 32},
+				PerHost: &Limit{Rate: "5/s", MaxConcurrent: 4},
+				Hosts: map[string]*Limit{
+					"api.github.com": {Rate: "60/m"}},
+			},
+			errRegex: `^$`,
+		},
+		"all invalid": {
+			limits: &Limits{
+				Global:  &Limit{Rate: "fast"},
+				PerHost: &Limit{Burst: -1, MaxConcurrent: -2},
+				Hosts: map[string]*Limit{
+					"b.example.com": {Rate: "0"},
+					"a.example.com": {Rate: "1/y"}},
+			},
+			errRegex: test.TrimYAML(`
+				^global:
+					rate: "fast" <invalid> \(use 'N/s', 'N/m' or 'N/h' format\)
+				per_host:
+					burst: "-1" <invalid> \(must be 0 or greater\)
+					max_concurrent: "-2" <invalid> \(must be 0 or greater\)
+				hosts:
+					a.example.com:
+						rate: "1/y" <invalid> \(use 'N/s', 'N/m' or 'N/h' format\)
+					b.example.com:
+						rate: "0" <invalid> \(must be greater than 0\)$`),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				tc.limits.CheckValues,
+			)
+		})
+	}
+}
+
+func TestLimiter_reserve(t *testing.T) {
+	// GIVEN: a limiter of 2 requests per second, with a burst of 2.
+	l := newLimiter(&Limit{Rate: "2/s", Burst: 2})
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// WHEN: requests are made at the same time.
+	var got []time.Duration
+	for range 4 {
+		got = append(got, l.reserve(now))
+	}
+
+	// THEN: the burst goes immediately, and the rest are spaced out at the rate.
+	want := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s\nlimiter.reserve() %d mismatch\ngot:  %s\nwant: %s",
+				packageName, i, got[i], want[i])
+		}
+	}
+
+	// WHEN: an unused reservation is returned, and time passes.
+	l.unreserve()
+	got1 := l.reserve(now.Add(time.Second))
+
+	// THEN: the bucket has refilled.
+	if got1 != 0 {
+		t.Errorf("%s\nlimiter.reserve() after refill mismatch\ngot:  %s\nwant: 0s",
+			packageName, got1)
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	// GIVEN: a Limit.
+	tests := map[string]struct {
+		limit   *Limit
+		wantNil bool
+	}{
+		"nil": {
+			wantNil: true},
+		"empty": {
+			limit: &Limit{}, wantNil: true},
+		"rate": {
+			limit: &Limit{Rate: "1/s"}},
+		"max_concurrent": {
+			limit: &Limit{MaxConcurrent: 1}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: newLimiter is called.
+			got := newLimiter(tc.limit)
+
+			// THEN: a limiter is only returned when it limits something.
+			if (got == nil) != tc.wantNil {
+				t.Errorf("%s\nnewLimiter() mismatch\ngot:  %+v\nwant nil: %t",
+					packageName, got, tc.wantNil)
+			}
+		})
+	}
+}
+
+func TestAcquire__maxConcurrent(t *testing.T) {
+	// GIVEN: a limit of 1 concurrent request to a host.
+	const host = "acquire.example.com"
+	SetLimits(&Limits{
+		Hosts: map[string]*Limit{
+			"Acquire.Example.com": {MaxConcurrent: 1}},
+	})
+	t.Cleanup(func() { SetLimits(nil) })
+
+	// WHEN: a request is in-flight.
+	release, err := acquire(context.Background(), host)
+	if err != nil {
+		t.Fatalf("%s\nacquire() unexpected error: %v", packageName, err)
+	}
+
+	// THEN: another request waits.
+	acquired := make(chan func())
+	go func() {
+		release2, _ := acquire(context.Background(), host)
+		acquired <- release2
+	}()
+	select {
+	case <-acquired:
+		t.Fatalf("%s\nacquire() did not wait for the in-flight request", packageName)
+	case <-time.After(50 * time.Millisecond):
+	}
+	// AND: it is counted in the queue.
+	if got := testutil.ToFloat64(metric.HTTPRequestQueueCurrent.WithLabelValues(host)); got != 1 {
+		t.Errorf("%s\nhttp_request_queue_current mismatch\ngot:  %v\nwant: 1",
+			packageName, got)
+	}
+
+	// WHEN: the in-flight request completes.
+	release()
+	release() // Releasing again is a no-op.
+
+	// THEN: the waiting request is sent.
+	select {
+	case release2 := <-acquired:
+		release2()
+	case <-time.After(time.Second):
+		t.Fatalf("%s\nacquire() still waiting after the in-flight request completed", packageName)
+	}
+	if got := testutil.ToFloat64(metric.HTTPRequestQueueCurrent.WithLabelValues(host)); got != 0 {
+		t.Errorf("%s\nhttp_request_queue_current mismatch\ngot:  %v\nwant: 0",
+			packageName, got)
+	}
+	// AND: the waits are observed.
+	if got := testutil.CollectAndCount(metric.HTTPRequestWaitSeconds); got == 0 {
+		t.Errorf("%s\nhttp_request_wait_seconds not observed", packageName)
+	}
+
+	// WHEN: a request is cancelled while waiting.
+	release, _ = acquire(context.Background(), host)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = acquire(ctx, host)
+	release()
+
+	// THEN: it fails with the context error.
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%s\nacquire() error mismatch\ngot:  %v\nwant: %v",
+			packageName, err, context.DeadlineExceeded)
+	}
+}
+
+func TestLimitedTransport(t *testing.T) {
+	// GIVEN: a server, and a limit of 1 request per minute to it.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	SetLimits(&Limits{PerHost: &Limit{Rate: "1/m"}})
+	t.Cleanup(func() { SetLimits(nil) })
+	client := &http.Client{Transport: Limited(http.DefaultTransport)}
+
+	// WHEN: the first request is made.
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("%s\nfirst request unexpected error: %v", packageName, err)
+	}
+	_ = resp.Body.Close()
+
+	// AND: a second request is made immediately.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err = client.Do(req)
+
+	// THEN: it waits for the rate, and times out.
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%s\nsecond request error mismatch\ngot:  %v\nwant: %v",
+			packageName, err, context.DeadlineExceeded)
+	}
+
+	// WHEN: the limits are removed.
+	SetLimits(nil)
+
+	// THEN: requests are sent immediately.
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("%s\nrequest without limits unexpected error: %v", packageName, err)
+	}
+	_ = resp.Body.Close()
+}
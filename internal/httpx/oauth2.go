@@ -0,0 +1,255 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpx provides a HTTP client.
+package httpx
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util"
+)
+
+const (
+	// tokenExpiryDelta is how long before expiry a cached token is refreshed.
+	tokenExpiryDelta = 30 * time.Second
+	// defaultTokenLifetime is how long a token is cached when the token endpoint gives no expires_in.
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+// OAuth2 holds the OAuth2 client-credentials settings used to obtain a bearer token for requests.
+type OAuth2 struct {
+	TokenURL     string   `json:"token_url,omitzero" yaml:"token_url,omitzero"`         // REQUIRED: URL of the token endpoint.
+	ClientID     string   `json:"client_id,omitzero" yaml:"client_id,omitzero"`         // REQUIRED: client ID.
+	ClientSecret string   `json:"client_secret,omitzero" yaml:"client_secret,omitzero"` // OPTIONAL: client secret.
+	Scopes       []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`             // OPTIONAL: scopes to request.
+	Audience     string   `json:"audience,omitzero" yaml:"audience,omitzero"`           // OPTIONAL: audience to request.
+}
+
+// tokenKey identifies a token in the cache by the (env-evaluated) credentials used to obtain it.
+type tokenKey struct {
+	tokenURL     string
+	clientID     string
+	clientSecret [32]byte // SHA256 hash.
+	scopes       string
+	audience     string
+}
+
+// cachedToken is an access token and when it expires.
+type cachedToken struct {
+	mu          sync.Mutex // Held while fetching, so concurrent callers share one token request.
+	accessToken string
+	expiry      time.Time
+}
+
+// tokens caches the access tokens of every OAuth2 config, so lookups/webhooks
+// sharing credentials also share a token.
+var tokens = struct {
+	mu sync.Mutex
+	m  map[tokenKey]*cachedToken
+}{m: make(map[tokenKey]*cachedToken)}
+
+// tokenResponse is the successful response of a token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Copy returns a deep copy of the receiver.
+func (o *OAuth2) Copy() *OAuth2 {
+	if o == nil {
+		return nil
+	}
+
+	return &OAuth2{
+		TokenURL:     o.TokenURL,
+		ClientID:     o.ClientID,
+		ClientSecret: o.ClientSecret,
+		Scopes:       append([]string(nil), o.Scopes...),
+		Audience:     o.Audience,
+	}
+}
+
+// InheritSecrets copies the ClientSecret from other if the receiver's is the SecretValue.
+func (o *OAuth2) InheritSecrets(other *OAuth2) {
+	if o != nil && other != nil &&
+		o.ClientSecret == util.SecretValue {
+		o.ClientSecret = other.ClientSecret
+	}
+}
+
+// CheckValues validates the fields of the receiver.
+func (o *OAuth2) CheckValues() error {
+	if o == nil {
+		return nil
+	}
+
+	var errs []error
+	// TokenURL.
+	if o.TokenURL == "" {
+		errs = append(errs,
+			&decode.ErrField{
+				Key:         "token_url",
+				Description: "URL of the OAuth2 token endpoint",
+			})
+	} else if _, err := url.ParseRequestURI(util.EvalEnvVars(o.TokenURL)); err != nil {
+		errs = append(errs,
+			&decode.ErrField{
+				Key:         "token_url",
+				Value:       o.TokenURL,
+				Description: "invalid URL",
+			})
+	}
+	// ClientID.
+	if o.ClientID == "" {
+		errs = append(errs,
+			&decode.ErrField{
+				Key:         "client_id",
+				Description: "OAuth2 client ID",
+			})
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// Authorize sets the Authorization header of req to a bearer token for the receiver,
+// requesting a new token with client if there's no unexpired one cached.
+func (o *OAuth2) Authorize(req *http.Request, client *http.Client) error {
+	if o == nil {
+		return nil
+	}
+
+	token, err := o.token(req, client)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// key returns the cache key of the receiver.
+func (o *OAuth2) key() tokenKey {
+	return tokenKey{
+		tokenURL:     util.EvalEnvVars(o.TokenURL),
+		clientID:     util.EvalEnvVars(o.ClientID),
+		clientSecret: sha256.Sum256([]byte(util.EvalEnvVars(o.ClientSecret))),
+		scopes:       strings.Join(o.Scopes, " "),
+		audience:     util.EvalEnvVars(o.Audience),
+	}
+}
+
+// token returns a cached access token for the receiver, fetching a new one if it is missing or near expiry.
+func (o *OAuth2) token(req *http.Request, client *http.Client) (string, error) {
+	key := o.key()
+
+	tokens.mu.Lock()
+	cached := tokens.m[key]
+	if cached == nil {
+		cached = &cachedToken{}
+		tokens.m[key] = cached
+	}
+	tokens.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	if cached.accessToken != "" && time.Now().Add(tokenExpiryDelta).Before(cached.expiry) {
+		return cached.accessToken, nil
+	}
+
+	resp, err := fetchToken(req, client, key, util.EvalEnvVars(o.ClientSecret))
+	if err != nil {
+		return "", err
+	}
+
+	lifetime := defaultTokenLifetime
+	if resp.ExpiresIn > 0 {
+		lifetime = time.Duration(resp.ExpiresIn) * time.Second
+	}
+	cached.accessToken = resp.AccessToken
+	cached.expiry = time.Now().Add(lifetime)
+
+	return cached.accessToken, nil
+}
+
+// fetchToken requests an access token from the token endpoint of key with the client-credentials grant.
+func fetchToken(
+	req *http.Request,
+	client *http.Client,
+	key tokenKey,
+	clientSecret string,
+) (*tokenResponse, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if key.scopes != "" {
+		form.Set("scope", key.scopes)
+	}
+	if key.audience != "" {
+		form.Set("audience", key.audience)
+	}
+
+	tokenReq, err := http.NewRequestWithContext(
+		req.Context(),
+		http.MethodPost, key.tokenURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating oauth2 token request for %q: %w", key.tokenURL, err)
+	}
+	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tokenReq.Header.Set("Accept", "application/json")
+	tokenReq.SetBasicAuth(url.QueryEscape(key.clientID), url.QueryEscape(clientSecret))
+
+	resp, err := client.Do(tokenReq)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2 token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20)) // Limit to 1 MiB.
+	if err != nil {
+		return nil, fmt.Errorf("oauth2 token request failed: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf(
+			"oauth2 token request to %q gave %s: %s",
+			key.tokenURL, resp.Status, util.TruncateMessage(string(body), 200),
+		)
+	}
+
+	var token tokenResponse
+	if err := decode.Unmarshal("json", body, &token); err != nil {
+		return nil, fmt.Errorf("failed parsing oauth2 token response from %q: %w", key.tokenURL, err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2 token response from %q has no access_token", key.tokenURL)
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return nil, fmt.Errorf("oauth2 token response from %q has unsupported token_type %q", key.tokenURL, token.TokenType)
+	}
+
+	return &token, nil
+}
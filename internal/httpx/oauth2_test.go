@@ -0,0 +1,383 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+// testTokenServer returns a token endpoint that responds with body and statusCode,
+// and counts the token requests it receives.
+func testTokenServer(t *testing.T, statusCode int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		clientID, clientSecret, _ := r.BasicAuth()
+		_ = r.ParseForm()
+		if r.Method != http.MethodPost ||
+			r.Form.Get("grant_type") != "client_credentials" ||
+			clientID != "id" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		// Echo the scope/audience so they can be checked.
+		body := strings.ReplaceAll(body, "{scope}", r.Form.Get("scope"))
+		body = strings.ReplaceAll(body, "{audience}", r.Form.Get("audience"))
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestOAuth2_Copy(t *testing.T) {
+	// GIVEN: an OAuth2.
+	tests := []struct {
+		name  string
+		input *OAuth2
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name: "filled",
+			input: &OAuth2{
+				TokenURL:     "https://example.com/token",
+				ClientID:     "id",
+				ClientSecret: "secret",
+				Scopes:       []string{"read", "write"},
+				Audience:     "api",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: Copy is called on it.
+			got := tc.input.Copy()
+
+			prefix := fmt.Sprintf("%s\nOAuth2.Copy()", packageName)
+
+			// THEN: a matching copy is returned.
+			if tc.input == nil {
+				if got != nil {
+					t.Fatalf("%s want nil, got %+v", prefix, got)
+				}
+				return
+			}
+			if fmt.Sprint(*got) != fmt.Sprint(*tc.input) {
+				t.Errorf(
+					"%s mismatch\ngot:  %+v\nwant: %+v",
+					prefix, *got, *tc.input,
+				)
+			}
+
+			// AND: the Scopes don't share memory.
+			got.Scopes[0] = "changed"
+			if tc.input.Scopes[0] == "changed" {
+				t.Errorf("%s Scopes were not deep copied", prefix)
+			}
+		})
+	}
+}
+
+func TestOAuth2_InheritSecrets(t *testing.T) {
+	// GIVEN: an OAuth2 and another to inherit secrets from.
+	tests := []struct {
+		name             string
+		oauth2, other    *OAuth2
+		wantClientSecret string
+	}{
+		{
+			name:   "nil receiver",
+			oauth2: nil,
+			other:  &OAuth2{ClientSecret: "secret"},
+		},
+		{
+			name:             "nil other",
+			oauth2:           &OAuth2{ClientSecret: util.SecretValue},
+			other:            nil,
+			wantClientSecret: util.SecretValue,
+		},
+		{
+			name:             "SecretValue inherited",
+			oauth2:           &OAuth2{ClientSecret: util.SecretValue},
+			other:            &OAuth2{ClientSecret: "secret"},
+			wantClientSecret: "secret",
+		},
+		{
+			name:             "new secret kept",
+			oauth2:           &OAuth2{ClientSecret: "new"},
+			other:            &OAuth2{ClientSecret: "secret"},
+			wantClientSecret: "new",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: InheritSecrets is called.
+			tc.oauth2.InheritSecrets(tc.other)
+
+			// THEN: the ClientSecret is inherited only when it was the SecretValue.
+			if tc.oauth2 == nil {
+				return
+			}
+			if got := tc.oauth2.ClientSecret; got != tc.wantClientSecret {
+				t.Errorf(
+					"%s\nOAuth2.InheritSecrets() ClientSecret mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.wantClientSecret,
+				)
+			}
+		})
+	}
+}
+
+func TestOAuth2_CheckValues(t *testing.T) {
+	// GIVEN: an OAuth2.
+	tests := []struct {
+		name     string
+		input    *OAuth2
+		errRegex string
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			errRegex: `^$`,
+		},
+		{
+			name: "valid",
+			input: &OAuth2{
+				TokenURL: "https://example.com/token",
+				ClientID: "id",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name:  "empty",
+			input: &OAuth2{},
+			errRegex: test.TrimYAML(`
+				^token_url: <required>.*
+				client_id: <required>.*$`),
+		},
+		{
+			name: "invalid token_url",
+			input: &OAuth2{
+				TokenURL: "not a url",
+				ClientID: "id",
+			},
+			errRegex: `^token_url: "not a url" <invalid>.*$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: CheckValues is called on it.
+			err := tc.input.CheckValues()
+
+			// THEN: the expected error is returned.
+			if e := errfmt.FormatError(err); !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s\nOAuth2.CheckValues() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestOAuth2_Authorize(t *testing.T) {
+	// GIVEN: a token endpoint, and an OAuth2 using it.
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		scopes     []string
+		audience   string
+		nilOAuth2  bool
+		wantAuth   string
+		errRegex   string
+	}{
+		{
+			name:      "nil OAuth2 sets nothing",
+			nilOAuth2: true,
+			errRegex:  `^$`,
+		},
+		{
+			name:       "token used",
+			statusCode: http.StatusOK,
+			body:       `{"access_token":"abc","token_type":"Bearer","expires_in":3600}`,
+			wantAuth:   "Bearer abc",
+			errRegex:   `^$`,
+		},
+		{
+			name:       "scopes and audience sent",
+			statusCode: http.StatusOK,
+			body:       `{"access_token":"{scope}|{audience}","token_type":"bearer"}`,
+			scopes:     []string{"read", "write"},
+			audience:   "api",
+			wantAuth:   "Bearer read write|api",
+			errRegex:   `^$`,
+		},
+		{
+			name:       "error status",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"error":"invalid_client"}`,
+			errRegex:   `gave 401 Unauthorized: {"error":"invalid_client"}`,
+		},
+		{
+			name:       "no access_token",
+			statusCode: http.StatusOK,
+			body:       `{"token_type":"Bearer"}`,
+			errRegex:   `has no access_token`,
+		},
+		{
+			name:       "unsupported token_type",
+			statusCode: http.StatusOK,
+			body:       `{"access_token":"abc","token_type":"mac"}`,
+			errRegex:   `unsupported token_type "mac"`,
+		},
+		{
+			name:       "invalid JSON",
+			statusCode: http.StatusOK,
+			body:       `{"access_token":`,
+			errRegex:   `failed parsing oauth2 token response`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _ := testTokenServer(t, tc.statusCode, tc.body)
+			oauth2 := &OAuth2{
+				TokenURL:     server.URL,
+				ClientID:     "id",
+				ClientSecret: "secret",
+				Scopes:       tc.scopes,
+				Audience:     tc.audience,
+			}
+			if tc.nilOAuth2 {
+				oauth2 = nil
+			}
+			req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+
+			// WHEN: Authorize is called on a request.
+			err := oauth2.Authorize(req, Client)
+
+			prefix := fmt.Sprintf("%s\nOAuth2.Authorize()", packageName)
+
+			// THEN: the expected error is returned.
+			if e := errfmt.FormatError(err); !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+
+			// AND: the Authorization header is set to the bearer token.
+			if got := req.Header.Get("Authorization"); got != tc.wantAuth {
+				t.Errorf(
+					"%s Authorization header mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantAuth,
+				)
+			}
+		})
+	}
+}
+
+func TestOAuth2_Authorize__cache(t *testing.T) {
+	// GIVEN: a token endpoint, and an OAuth2 using it.
+	tests := []struct {
+		name         string
+		body         string
+		wantRequests int32
+	}{
+		{
+			name:         "token cached until near expiry",
+			body:         `{"access_token":"abc","expires_in":3600}`,
+			wantRequests: 1,
+		},
+		{
+			name:         "no expires_in, cached for the default lifetime",
+			body:         `{"access_token":"abc"}`,
+			wantRequests: 1,
+		},
+		{
+			name:         "token within the expiry delta is refreshed",
+			body:         `{"access_token":"abc","expires_in":10}`,
+			wantRequests: 5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requests := testTokenServer(t, http.StatusOK, tc.body)
+			oauth2 := &OAuth2{
+				TokenURL:     server.URL,
+				ClientID:     "id",
+				ClientSecret: "secret",
+			}
+
+			// WHEN: Authorize is called on several requests, concurrently.
+			var wg sync.WaitGroup
+			for range 5 {
+				wg.Go(func() {
+					req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+					// Copies share the cached token.
+					if err := oauth2.Copy().Authorize(req, Client); err != nil {
+						t.Errorf("%s\nOAuth2.Authorize() unexpected error: %v",
+							packageName, err)
+					}
+				})
+			}
+			wg.Wait()
+
+			// THEN: the token endpoint is only queried when no unexpired token is cached.
+			if got := requests.Load(); got != tc.wantRequests {
+				t.Errorf(
+					"%s\nOAuth2.Authorize() token request count mismatch\ngot:  %d\nwant: %d",
+					packageName, got, tc.wantRequests,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// MaxBodySize is the maximum number of bytes read from a response body.
+const MaxBodySize = 50 << 20 // 50 MiB.
+
+// SharedTTL is how long a response is shared with identical requests after it was received.
+var SharedTTL = 10 * time.Second
+
+// sharedCall is an in-flight, or recently completed, request.
+type sharedCall struct {
+	done    chan struct{}  // Closed once the request completes.
+	resp    *http.Response // Response, with its Body already read.
+	body    []byte         // Body of the response.
+	err     error          // Error of the request.
+	expires time.Time      // Zero until the request completes successfully.
+}
+
+// shared holds the requests that are in-flight, or were recently completed, by their key.
+var shared = struct {
+	mu    sync.Mutex
+	calls map[string]*sharedCall
+}{calls: make(map[string]*sharedCall)}
+
+// Do sends req with client, and returns the response along with its body (up to [MaxBodySize]).
+//
+// Concurrent identical requests (same client, method, URL and headers - including any credentials)
+// are coalesced into one, and the response is shared with identical requests for [SharedTTL].
+// A request waiting on an identical in-flight request stops waiting once its context is done.
+// Only GET and HEAD requests without a body are shared.
+//
+// The response Body has already been read, and a shared response must not be modified.
+//
+// Requests to a host whose circuit is open fail with an [ErrCircuitOpen] without being sent,
+// and a failed response that says when to retry fails with an [ErrRetryAfter].
+func Do(client *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	if err := allow(req.URL.Host, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	key, ok := sharedKey(client, req)
+	if !ok {
+		return do(client, req)
+	}
+
+	shared.mu.Lock()
+	now := time.Now()
+	if call := shared.calls[key]; call != nil {
+		select {
+		case <-call.done:
+			// Completed recently.
+			if now.Before(call.expires) {
+				shared.mu.Unlock()
+				return call.resp, call.body, nil
+			}
+		default:
+			// In-flight.
+			shared.mu.Unlock()
+			select {
+			case <-call.done:
+				return call.resp, call.body, call.err
+			case <-req.Context().Done():
+				return nil, nil, req.Context().Err()
+			}
+		}
+	}
+	call := &sharedCall{done: make(chan struct{})}
+	shared.calls[key] = call
+	removeExpired(now)
+	shared.mu.Unlock()
+
+	call.resp, call.body, call.err = do(client, req)
+
+	shared.mu.Lock()
+	if call.err != nil {
+		// Don't share failures with later requests.
+		if shared.calls[key] == call {
+			delete(shared.calls, key)
+		}
+	} else {
+		call.expires = time.Now().Add(SharedTTL)
+	}
+	close(call.done)
+	shared.mu.Unlock()
+
+	return call.resp, call.body, call.err
+}
+
+// do sends req with client, and returns the response along with its body (up to [MaxBodySize]).
+func do(client *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	resp, err := client.Do(req)
+	retryErr := record(req.URL.Host, resp, err, time.Now())
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+	defer resp.Body.Close()
+	if retryErr != nil {
+		return nil, nil, retryErr
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxBodySize))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+	resp.Body = http.NoBody
+
+	return resp, body, nil
+}
+
+// sharedKey returns the key identifying requests identical to req,
+// and whether req may be shared.
+func sharedKey(client *http.Client, req *http.Request) (string, bool) {
+	if (req.Method != http.MethodGet && req.Method != http.MethodHead) ||
+		(req.Body != nil && req.Body != http.NoBody) {
+		return "", false
+	}
+
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%p\n%s\n%s\n%s\n", client, req.Method, req.URL.String(), req.Host)
+	headerKeys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		headerKeys = append(headerKeys, key)
+	}
+	slices.Sort(headerKeys)
+	for _, key := range headerKeys {
+		_, _ = fmt.Fprintf(hash, "%s: %q\n", key, req.Header[key])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// removeExpired removes the completed requests that expired before now.
+//
+// shared.mu must be held.
+func removeExpired(now time.Time) {
+	for key, call := range shared.calls {
+		if !call.expires.IsZero() && now.After(call.expires) {
+			delete(shared.calls, key)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingServer returns a server that responds with the number of requests it has received,
+// blocking each response until release is closed.
+func countingServer(t *testing.T, release <-chan struct{}) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hit := hits.Add(1)
+		<-release
+		_, _ = fmt.Fprintf(w, "hit %d", hit)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func TestDo__coalesce(t *testing.T) {
+	// GIVEN: a server that holds its responses.
+	release := make(chan struct{})
+	server, hits := countingServer(t, release)
+	url := server.URL + "/coalesce"
+
+	// WHEN: several identical requests are made concurrently.
+	const requests = 5
+	var wg sync.WaitGroup
+	bodies := make([]string, requests)
+	errs := make([]error, requests)
+	for i := range requests {
+		wg.Go(func() {
+			req, _ := http.NewRequest(http.MethodGet, url, nil)
+			_, body, err := Do(Client, req)
+			bodies[i], errs[i] = string(body), err
+		})
+	}
+	// Wait for the first request to reach the server.
+	for hits.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// THEN: the server only received one request.
+	if got := hits.Load(); got != 1 {
+		t.Errorf("%s\nDo() server hits mismatch\ngot:  %d\nwant: %d",
+			packageName, got, 1)
+	}
+	// AND: every request got the same response.
+	for i := range requests {
+		if errs[i] != nil || bodies[i] != "hit 1" {
+			t.Errorf("%s\nDo() response %d mismatch\ngot:  body=%q, err=%v\nwant: body=%q, err=nil",
+				packageName, i, bodies[i], errs[i], "hit 1")
+		}
+	}
+}
+
+func TestDo__coalesceCancelled(t *testing.T) {
+	// GIVEN: a request in-flight to a server that holds its responses.
+	release := make(chan struct{})
+	server, hits := countingServer(t, release)
+	url := server.URL + "/coalesce-cancelled"
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		_, _, _ = Do(Client, req)
+	})
+	t.Cleanup(func() {
+		close(release)
+		wg.Wait()
+	})
+	for hits.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// WHEN: an identical request is made with a context that is cancelled while waiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	_, _, err := Do(Client, req)
+
+	// THEN: it stops waiting with the error of its context.
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%s\nDo() error mismatch\ngot:  %v\nwant: %v",
+			packageName, err, context.DeadlineExceeded)
+	}
+	// AND: the server only received the in-flight request.
+	if got := hits.Load(); got != 1 {
+		t.Errorf("%s\nDo() server hits mismatch\ngot:  %d\nwant: %d",
+			packageName, got, 1)
+	}
+}
+
+func TestDo__share(t *testing.T) {
+	// GIVEN: a server.
+	release := make(chan struct{})
+	close(release)
+	server, hits := countingServer(t, release)
+
+	type request struct {
+		method, path string
+		header       http.Header
+		body         string
+	}
+	// AND: requests made one after another.
+	tests := []struct {
+		name     string
+		requests []request
+		ttl      time.Duration
+		wantHits int32
+	}{
+		{
+			name: "identical requests share the response",
+			requests: []request{
+				{method: http.MethodGet, path: "/share"},
+				{method: http.MethodGet, path: "/share"},
+			},
+			ttl:      time.Minute,
+			wantHits: 1,
+		},
+		{
+			name: "expired responses are not shared",
+			requests: []request{
+				{method: http.MethodGet, path: "/expire"},
+				{method: http.MethodGet, path: "/expire"},
+			},
+			ttl:      0,
+			wantHits: 2,
+		},
+		{
+			name: "different URLs",
+			requests: []request{
+				{method: http.MethodGet, path: "/url-a"},
+				{method: http.MethodGet, path: "/url-b"},
+			},
+			ttl:      time.Minute,
+			wantHits: 2,
+		},
+		{
+			name: "different credentials",
+			requests: []request{
+				{method: http.MethodGet, path: "/auth", header: http.Header{"Authorization": {"Bearer a"}}},
+				{method: http.MethodGet, path: "/auth", header: http.Header{"Authorization": {"Bearer b"}}},
+				{method: http.MethodGet, path: "/auth", header: http.Header{"Authorization": {"Bearer a"}}},
+			},
+			ttl:      time.Minute,
+			wantHits: 2,
+		},
+		{
+			name: "requests with a body are not shared",
+			requests: []request{
+				{method: http.MethodPost, path: "/post", body: "x"},
+				{method: http.MethodPost, path: "/post", body: "x"},
+			},
+			ttl:      time.Minute,
+			wantHits: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hadTTL := SharedTTL
+			SharedTTL = tc.ttl
+			t.Cleanup(func() { SharedTTL = hadTTL })
+			hadHits := hits.Load()
+
+			// WHEN: the requests are made.
+			for _, r := range tc.requests {
+				req, _ := http.NewRequest(r.method, server.URL+r.path, strings.NewReader(r.body))
+				if r.body == "" {
+					req.Body = http.NoBody
+				}
+				for key, values := range r.header {
+					req.Header[key] = values
+				}
+				if _, _, err := Do(Client, req); err != nil {
+					t.Fatalf("%s\nDo() unexpected error: %v",
+						packageName, err)
+				}
+			}
+
+			// THEN: the server received the expected number of requests.
+			if got := hits.Load() - hadHits; got != tc.wantHits {
+				t.Errorf("%s\nDo() server hits mismatch\ngot:  %d\nwant: %d",
+					packageName, got, tc.wantHits)
+			}
+		})
+	}
+}
+
+func TestDo__errorsNotShared(t *testing.T) {
+	// GIVEN: a server that is no longer running.
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL + "/error"
+	server.Close()
+
+	// WHEN: a request to it fails.
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	_, _, err := Do(Client, req)
+
+	// THEN: the error is returned.
+	if err == nil {
+		t.Fatalf("%s\nDo() expected an error", packageName)
+	}
+	// AND: the failed request is not kept to share.
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	key, _ := sharedKey(Client, req)
+	if _, ok := shared.calls[key]; ok {
+		t.Errorf("%s\nDo() kept a failed request to share", packageName)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util"
+)
+
+// TLS holds the TLS settings used to verify a server, and to authenticate to it.
+type TLS struct {
+	CAFile     string `json:"ca_file,omitzero" yaml:"ca_file,omitzero"`         // OPTIONAL: PEM bundle of CAs to trust on top of the system pool.
+	CertFile   string `json:"cert_file,omitzero" yaml:"cert_file,omitzero"`     // OPTIONAL: PEM client certificate for mTLS.
+	KeyFile    string `json:"key_file,omitzero" yaml:"key_file,omitzero"`       // OPTIONAL: PEM client key for mTLS.
+	ServerName string `json:"server_name,omitzero" yaml:"server_name,omitzero"` // OPTIONAL: server name to verify the certificate against.
+
+	caPEM   string // PEM bundle of CAs to trust in place of the system pool.
+	certPEM string // PEM client certificate for mTLS.
+	keyPEM  string // PEM client key for mTLS.
+}
+
+// TLSFromPEM returns the TLS settings to verify a server with the PEM CA bundle (in place of the system pool),
+// and to authenticate to it with the PEM client certificate and key.
+func TLSFromPEM(ca, cert, key []byte, serverName string) *TLS {
+	return &TLS{
+		ServerName: serverName,
+		caPEM:      string(ca),
+		certPEM:    string(cert),
+		keyPEM:     string(key),
+	}
+}
+
+// MergeTLS returns the TLS settings with each field taken from the first of cfgs to set it,
+// or nil if none set anything.
+func MergeTLS(cfgs ...*TLS) *TLS {
+	var merged TLS
+	for _, cfg := range cfgs {
+		if cfg == nil {
+			continue
+		}
+
+		merged.CAFile = util.FirstNonDefault(merged.CAFile, cfg.CAFile)
+		// Client certificate and key are a pair.
+		if merged.CertFile == "" && merged.KeyFile == "" {
+			merged.CertFile = cfg.CertFile
+			merged.KeyFile = cfg.KeyFile
+		}
+		merged.ServerName = util.FirstNonDefault(merged.ServerName, cfg.ServerName)
+	}
+
+	if merged.IsZero() {
+		return nil
+	}
+	return &merged
+}
+
+// Copy returns a copy of the receiver.
+func (t *TLS) Copy() *TLS {
+	if t == nil {
+		return nil
+	}
+
+	tlsCopy := *t
+	return &tlsCopy
+}
+
+// IsZero reports whether the receiver is nil or has no fields set.
+func (t *TLS) IsZero() bool {
+	return t == nil || *t == TLS{}
+}
+
+// CheckValues validates the fields of the receiver, loading the files it references.
+func (t *TLS) CheckValues() error {
+	if t == nil {
+		return nil
+	}
+
+	var errs []error
+	// CertFile/KeyFile.
+	if t.CertFile != "" && t.KeyFile == "" {
+		errs = append(errs,
+			&decode.ErrField{
+				Key:         "key_file",
+				Description: "required with cert_file",
+			})
+	} else if t.KeyFile != "" && t.CertFile == "" {
+		errs = append(errs,
+			&decode.ErrField{
+				Key:         "cert_file",
+				Description: "required with key_file",
+			})
+	}
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+
+	if _, err := t.evaluated().config(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// evaluated returns a copy of the receiver with any environment variables evaluated.
+func (t *TLS) evaluated() TLS {
+	return TLS{
+		CAFile:     util.EvalEnvVars(t.CAFile),
+		CertFile:   util.EvalEnvVars(t.CertFile),
+		KeyFile:    util.EvalEnvVars(t.KeyFile),
+		ServerName: util.EvalEnvVars(t.ServerName),
+		caPEM:      t.caPEM,
+		certPEM:    t.certPEM,
+		keyPEM:     t.keyPEM,
+	}
+}
+
+// modTimes returns the modification times of the files referenced by the receiver,
+// with 0 for any that are unset or can't be read.
+func (t TLS) modTimes() [3]int64 {
+	var times [3]int64
+	for i, path := range []string{t.CAFile, t.CertFile, t.KeyFile} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			times[i] = info.ModTime().UnixNano()
+		}
+	}
+	return times
+}
+
+// config returns a [tls.Config] for the receiver.
+func (t TLS) config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: t.ServerName,
+	}
+
+	// CAFile.
+	if t.CAFile != "" {
+		pem, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, &decode.ErrField{
+				Key:         "ca_file",
+				Value:       t.CAFile,
+				Description: "failed to read file",
+			}
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, &decode.ErrField{
+				Key:         "ca_file",
+				Value:       t.CAFile,
+				Description: "no PEM certificates found",
+			}
+		}
+		cfg.RootCAs = pool
+	}
+	if t.caPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(t.caPEM)) {
+			return nil, errors.New("no certificates found in the certificate authority")
+		}
+		cfg.RootCAs = pool
+	}
+
+	// CertFile/KeyFile.
+	if t.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed loading client certificate %q with key %q: %w",
+				t.CertFile, t.KeyFile, err,
+			)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if t.certPEM != "" || t.keyPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(t.certPEM), []byte(t.keyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,341 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package httpx
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+// writeCertFiles writes a self-signed certificate and its key to dir,
+// returning their paths.
+func writeCertFiles(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("%s\nfailed generating key: %v", packageName, err)
+	}
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "argus"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("%s\nfailed creating certificate: %v", packageName, err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("%s\nfailed writing certificate: %v", packageName, err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0o600); err != nil {
+		t.Fatalf("%s\nfailed writing key: %v", packageName, err)
+	}
+	return certFile, keyFile
+}
+
+func TestMergeTLS(t *testing.T) {
+	// GIVEN: a list of TLS configs.
+	tests := []struct {
+		name string
+		cfgs []*TLS
+		want *TLS
+	}{
+		{
+			name: "none",
+			cfgs: nil,
+			want: nil,
+		},
+		{
+			name: "all nil/empty",
+			cfgs: []*TLS{nil, {}},
+			want: nil,
+		},
+		{
+			name: "first set field wins",
+			cfgs: []*TLS{
+				{ServerName: "a"},
+				{CAFile: "ca-b", ServerName: "b"},
+				{CAFile: "ca-c"},
+			},
+			want: &TLS{CAFile: "ca-b", ServerName: "a"},
+		},
+		{
+			name: "cert and key taken as a pair",
+			cfgs: []*TLS{
+				{CertFile: "cert-a"},
+				{CertFile: "cert-b", KeyFile: "key-b"},
+			},
+			want: &TLS{CertFile: "cert-a"},
+		},
+		{
+			name: "cert and key from a fallback",
+			cfgs: []*TLS{
+				{CAFile: "ca-a"},
+				nil,
+				{CertFile: "cert-c", KeyFile: "key-c"},
+			},
+			want: &TLS{CAFile: "ca-a", CertFile: "cert-c", KeyFile: "key-c"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: MergeTLS is called.
+			got := MergeTLS(tc.cfgs...)
+
+			// THEN: the fields are merged as expected.
+			if (got == nil) != (tc.want == nil) ||
+				(got != nil && *got != *tc.want) {
+				t.Errorf(
+					"%s\nMergeTLS() mismatch\ngot:  %+v\nwant: %+v",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestTLS_Copy(t *testing.T) {
+	// GIVEN: a TLS.
+	tests := []struct {
+		name  string
+		input *TLS
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name: "filled",
+			input: &TLS{
+				CAFile:     "ca.pem",
+				CertFile:   "cert.pem",
+				KeyFile:    "key.pem",
+				ServerName: "example.com",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: Copy is called.
+			got := tc.input.Copy()
+
+			prefix := packageName + "\nTLS.Copy()"
+
+			// THEN: nil is copied as nil.
+			if tc.input == nil {
+				if got != nil {
+					t.Errorf("%s got %+v, want nil", prefix, got)
+				}
+				return
+			}
+			// AND: the copy matches the original.
+			if *got != *tc.input {
+				t.Errorf(
+					"%s mismatch\ngot:  %+v\nwant: %+v",
+					prefix, got, tc.input,
+				)
+			}
+			// AND: the copy is a different pointer.
+			if got == tc.input {
+				t.Errorf("%s returned the same pointer", prefix)
+			}
+		})
+	}
+}
+
+func TestTLS_IsZero(t *testing.T) {
+	// GIVEN: a TLS.
+	tests := []struct {
+		name  string
+		input *TLS
+		want  bool
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			want:  true,
+		},
+		{
+			name:  "empty",
+			input: &TLS{},
+			want:  true,
+		},
+		{
+			name:  "server_name",
+			input: &TLS{ServerName: "example.com"},
+			want:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: IsZero is called.
+			got := tc.input.IsZero()
+
+			// THEN: the result is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nTLS.IsZero() mismatch\ngot:  %t\nwant: %t",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestTLS_CheckValues(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertFiles(t, dir)
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("foo"), 0o600); err != nil {
+		t.Fatalf("%s\nfailed writing file: %v", packageName, err)
+	}
+	certPEM, _ := os.ReadFile(certFile)
+	keyPEM, _ := os.ReadFile(keyFile)
+
+	// GIVEN: a TLS.
+	tests := []struct {
+		name     string
+		input    *TLS
+		errRegex string
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			errRegex: `^$`,
+		},
+		{
+			name:     "server_name only",
+			input:    &TLS{ServerName: "example.com"},
+			errRegex: `^$`,
+		},
+		{
+			name:     "valid ca_file",
+			input:    &TLS{CAFile: certFile},
+			errRegex: `^$`,
+		},
+		{
+			name:     "missing ca_file",
+			input:    &TLS{CAFile: filepath.Join(dir, "missing.pem")},
+			errRegex: `^ca_file: "[^"]+" <invalid> \(failed to read file\)$`,
+		},
+		{
+			name:     "ca_file without certificates",
+			input:    &TLS{CAFile: notPEM},
+			errRegex: `^ca_file: "[^"]+" <invalid> \(no PEM certificates found\)$`,
+		},
+		{
+			name:     "valid cert_file and key_file",
+			input:    &TLS{CertFile: certFile, KeyFile: keyFile},
+			errRegex: `^$`,
+		},
+		{
+			name:     "cert_file without key_file",
+			input:    &TLS{CertFile: certFile},
+			errRegex: `^key_file: <required> \(required with cert_file\)$`,
+		},
+		{
+			name:     "key_file without cert_file",
+			input:    &TLS{KeyFile: keyFile},
+			errRegex: `^cert_file: <required> \(required with key_file\)$`,
+		},
+		{
+			name:     "invalid key_file",
+			input:    &TLS{CertFile: certFile, KeyFile: notPEM},
+			errRegex: `^failed loading client certificate "[^"]+" with key "[^"]+":\s+tls: .*$`,
+		},
+		{
+			name:     "valid PEM",
+			input:    TLSFromPEM(certPEM, certPEM, keyPEM, "example.com"),
+			errRegex: `^$`,
+		},
+		{
+			name:     "PEM CA without certificates",
+			input:    TLSFromPEM([]byte("foo"), nil, nil, ""),
+			errRegex: `^no certificates found in the certificate authority$`,
+		},
+		{
+			name:     "invalid PEM client certificate",
+			input:    TLSFromPEM(nil, []byte("cert"), []byte("key"), ""),
+			errRegex: `^invalid client certificate:`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: CheckValues is called.
+			err := tc.input.CheckValues()
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s\nTLS.CheckValues() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestTLS_CheckValues__envVars(t *testing.T) {
+	// GIVEN: a TLS with a ca_file from an environment variable.
+	certFile, _ := writeCertFiles(t, t.TempDir())
+	t.Setenv("TEST_HTTPX_TLS_CA_FILE", certFile)
+	cfg := &TLS{CAFile: "${TEST_HTTPX_TLS_CA_FILE}"}
+
+	// WHEN: CheckValues is called.
+	err := cfg.CheckValues()
+
+	// THEN: the environment variable is evaluated.
+	if err != nil {
+		t.Errorf(
+			"%s\nTLS.CheckValues() unexpected error\n%s",
+			packageName, errfmt.FormatError(err),
+		)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scheduler provides a central scheduler that runs jobs at their next-run times
+// on a bounded pool of workers.
+package scheduler
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Job is run by the Scheduler, returning the delay until it should next run.
+//
+// A negative delay stops the job.
+type Job func(ctx context.Context) time.Duration
+
+// Scheduler runs Jobs in order of their next-run times, with at most `workers` running at once.
+type Scheduler struct {
+	mu      sync.Mutex
+	queue   taskQueue        // Tasks waiting to run, ordered by next-run time.
+	tasks   map[string]*task // Tasks by ID, whether queued or running.
+	wake    chan struct{}    // Signals the dispatcher that the queue has changed.
+	work    chan *task       // Tasks due to run, picked up by the workers.
+	workers int
+	start   sync.Once
+	stop    chan struct{}
+	stopped bool
+}
+
+// task is a Job and its scheduling state.
+type task struct {
+	id      string
+	job     Job
+	ctx     context.Context
+	cancel  func() bool // Stops the removal of this task on ctx cancellation.
+	next    time.Time
+	index   int  // Index in the queue, -1 when not queued.
+	running bool // Whether a worker is running this task.
+	rerun   bool // Whether to run again as soon as the current run finishes.
+}
+
+// New returns a Scheduler that runs at most `workers` Jobs at once.
+func New(workers int) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Scheduler{
+		tasks:   make(map[string]*task),
+		wake:    make(chan struct{}, 1),
+		work:    make(chan *task),
+		workers: workers,
+		stop:    make(chan struct{}),
+	}
+}
+
+// Add schedules job to run at `at` under id, replacing any job already scheduled with that id.
+// The job is removed when ctx is cancelled.
+func (s *Scheduler) Add(ctx context.Context, id string, at time.Time, job Job) {
+	s.start.Do(s.run)
+
+	t := &task{id: id, job: job, ctx: ctx, next: at, index: -1}
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.remove(id)
+	s.tasks[id] = t
+	heap.Push(&s.queue, t)
+	t.cancel = context.AfterFunc(ctx, func() { s.removeTask(t) })
+	s.mu.Unlock()
+
+	s.signal()
+}
+
+// Remove stops the job with this id from running again.
+// A run that is in progress is left to finish.
+func (s *Scheduler) Remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(id)
+}
+
+// RunNow moves the next run of the job with this id to now,
+// or queues a rerun for when it finishes if it is currently running.
+//
+// Returns whether a job with this id was found.
+func (s *Scheduler) RunNow(id string) bool {
+	s.mu.Lock()
+	t, ok := s.tasks[id]
+	if ok {
+		if t.running {
+			t.rerun = true
+		} else if t.index != -1 {
+			t.next = time.Now()
+			heap.Fix(&s.queue, t.index)
+		}
+	}
+	s.mu.Unlock()
+
+	if ok {
+		s.signal()
+	}
+	return ok
+}
+
+// Next returns the next-run time of the job with this id,
+// and whether it is scheduled (false if it is unknown or running).
+func (s *Scheduler) Next(id string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tasks[id]
+	if !ok || t.index == -1 {
+		return time.Time{}, false
+	}
+	return t.next, true
+}
+
+// Len returns the number of jobs in the Scheduler.
+func (s *Scheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.tasks)
+}
+
+// Stop removes all jobs and stops the dispatcher and workers.
+// Runs in progress are left to finish.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	for id := range s.tasks {
+		s.remove(id)
+	}
+	close(s.stop)
+}
+
+// remove the task with this id.
+//
+// s.mu must be held.
+func (s *Scheduler) remove(id string) {
+	t, ok := s.tasks[id]
+	if !ok {
+		return
+	}
+	delete(s.tasks, id)
+	if t.index != -1 {
+		heap.Remove(&s.queue, t.index)
+	}
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// removeTask removes t if it is still the task scheduled under its id.
+func (s *Scheduler) removeTask(t *task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tasks[t.id] == t {
+		s.remove(t.id)
+	}
+}
+
+// signal the dispatcher that the queue has changed.
+func (s *Scheduler) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run starts the dispatcher and workers.
+func (s *Scheduler) run() {
+	for range s.workers {
+		go s.worker()
+	}
+	go s.dispatch()
+}
+
+// dispatch hands tasks to the workers as they become due.
+func (s *Scheduler) dispatch() {
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	for {
+		s.mu.Lock()
+		var due *task
+		wait := time.Hour
+		if len(s.queue) != 0 {
+			if wait = time.Until(s.queue[0].next); wait <= 0 {
+				due = heap.Pop(&s.queue).(*task)
+				due.running = true
+			}
+		}
+		s.mu.Unlock()
+
+		// Hand the due task to a worker.
+		if due != nil {
+			select {
+			case s.work <- due:
+				continue
+			case <-s.stop:
+				return
+			}
+		}
+
+		// Wait for the next task to be due, or the queue to change.
+		timer.Reset(wait)
+		select {
+		case <-timer.C:
+		case <-s.wake:
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// worker runs the tasks it is handed, rescheduling them afterwards.
+func (s *Scheduler) worker() {
+	for {
+		select {
+		case t := <-s.work:
+			s.runTask(t)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// runTask runs t and reschedules it at the delay it returns.
+func (s *Scheduler) runTask(t *task) {
+	// Skip if removed or replaced while waiting for a worker.
+	s.mu.Lock()
+	current := s.tasks[t.id] == t
+	s.mu.Unlock()
+
+	delay := time.Duration(-1)
+	if current && t.ctx.Err() == nil {
+		delay = t.job(t.ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t.running = false
+	// Removed or replaced while running.
+	if s.tasks[t.id] != t {
+		return
+	}
+	if t.rerun {
+		t.rerun = false
+		delay = 0
+	}
+	if delay < 0 || t.ctx.Err() != nil {
+		s.remove(t.id)
+		return
+	}
+	t.next = time.Now().Add(delay)
+	heap.Push(&s.queue, t)
+	s.signal()
+}
+
+// taskQueue is a min-heap of tasks ordered by their next-run time.
+type taskQueue []*task
+
+func (q taskQueue) Len() int           { return len(q) }
+func (q taskQueue) Less(i, j int) bool { return q[i].next.Before(q[j].next) }
+func (q taskQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *taskQueue) Push(x any) {
+	t := x.(*task)
+	t.index = len(*q)
+	*q = append(*q, t)
+}
+
+func (q *taskQueue) Pop() any {
+	old := *q
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	t.index = -1
+	*q = old[:n-1]
+	return t
+}
@@ -0,0 +1,293 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitFor polls cond until it is true, failing the test after timeout.
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool, msg string) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s\ntimed out after %s", msg, timeout)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestScheduler_Add__order(t *testing.T) {
+	// GIVEN: a Scheduler with a single worker.
+	s := New(1)
+	t.Cleanup(s.Stop)
+
+	// WHEN: jobs are added out of order.
+	var mu sync.Mutex
+	var order []string
+	now := time.Now()
+	for _, tc := range []struct {
+		id    string
+		delay time.Duration
+	}{
+		{"c", 60 * time.Millisecond},
+		{"a", 20 * time.Millisecond},
+		{"b", 40 * time.Millisecond},
+	} {
+		s.Add(context.Background(), tc.id, now.Add(tc.delay), func(context.Context) time.Duration {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, tc.id)
+			return -1
+		})
+	}
+
+	// THEN: they run in order of their next-run times.
+	waitFor(t, time.Second,
+		func() bool { mu.Lock(); defer mu.Unlock(); return len(order) == 3 },
+		"jobs did not all run")
+	if got := order[0] + order[1] + order[2]; got != "abc" {
+		t.Errorf("%s\nwant: %q\ngot:  %q",
+			t.Name(), "abc", got)
+	}
+	// AND: jobs returning a negative delay are removed.
+	waitFor(t, time.Second, func() bool { return s.Len() == 0 },
+		"jobs were not removed")
+}
+
+func TestScheduler_Add__reschedule(t *testing.T) {
+	// GIVEN: a job that asks to run again after 10ms.
+	s := New(1)
+	t.Cleanup(s.Stop)
+	var runs atomic.Int32
+	s.Add(context.Background(), "job", time.Now(), func(context.Context) time.Duration {
+		runs.Add(1)
+		return 10 * time.Millisecond
+	})
+
+	// WHEN: it is left to run.
+	// THEN: it runs repeatedly.
+	waitFor(t, time.Second, func() bool { return runs.Load() >= 3 },
+		"job was not rescheduled")
+}
+
+func TestScheduler_Add__replace(t *testing.T) {
+	// GIVEN: a job scheduled far in the future.
+	s := New(1)
+	t.Cleanup(s.Stop)
+	var oldRuns, newRuns atomic.Int32
+	s.Add(context.Background(), "job", time.Now().Add(time.Hour), func(context.Context) time.Duration {
+		oldRuns.Add(1)
+		return -1
+	})
+
+	// WHEN: a job with the same id is added to run now.
+	s.Add(context.Background(), "job", time.Now(), func(context.Context) time.Duration {
+		newRuns.Add(1)
+		return time.Hour
+	})
+
+	// THEN: only the new job runs.
+	waitFor(t, time.Second, func() bool { return newRuns.Load() == 1 },
+		"replacement job did not run")
+	if got := oldRuns.Load(); got != 0 {
+		t.Errorf("%s\nreplaced job ran %d times, want 0",
+			t.Name(), got)
+	}
+	// AND: it is the only job scheduled.
+	if got := s.Len(); got != 1 {
+		t.Errorf("%s\nLen() mismatch\nwant: 1\ngot:  %d",
+			t.Name(), got)
+	}
+}
+
+func TestScheduler_Add__cancel(t *testing.T) {
+	// GIVEN: a job scheduled with a context.
+	s := New(1)
+	t.Cleanup(s.Stop)
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int32
+	s.Add(ctx, "job", time.Now().Add(50*time.Millisecond), func(context.Context) time.Duration {
+		runs.Add(1)
+		return time.Millisecond
+	})
+
+	// WHEN: the context is cancelled before it runs.
+	cancel()
+
+	// THEN: it is removed without running.
+	waitFor(t, time.Second, func() bool { return s.Len() == 0 },
+		"cancelled job was not removed")
+	time.Sleep(100 * time.Millisecond)
+	if got := runs.Load(); got != 0 {
+		t.Errorf("%s\ncancelled job ran %d times, want 0",
+			t.Name(), got)
+	}
+}
+
+func TestScheduler_Add__workers(t *testing.T) {
+	// GIVEN: a Scheduler with 2 workers.
+	const workers = 2
+	s := New(workers)
+	t.Cleanup(s.Stop)
+
+	// WHEN: more jobs than workers are due at once.
+	var running, maxRunning, done atomic.Int32
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		s.Add(context.Background(), id, time.Now(), func(context.Context) time.Duration {
+			now := running.Add(1)
+			for {
+				old := maxRunning.Load()
+				if now <= old || maxRunning.CompareAndSwap(old, now) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			running.Add(-1)
+			done.Add(1)
+			return -1
+		})
+	}
+
+	// THEN: they all run.
+	waitFor(t, time.Second, func() bool { return done.Load() == 5 },
+		"jobs did not all run")
+	// AND: no more than `workers` ran at once.
+	if got := maxRunning.Load(); got > workers {
+		t.Errorf("%s\nwant at most %d jobs running at once\ngot:  %d",
+			t.Name(), workers, got)
+	}
+}
+
+func TestScheduler_RunNow(t *testing.T) {
+	tests := map[string]struct {
+		running bool
+	}{
+		"queued job runs now": {
+			running: false},
+		"running job reruns when finished": {
+			running: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// GIVEN: a job that runs now, then waits an hour.
+			s := New(1)
+			t.Cleanup(s.Stop)
+			release := make(chan struct{})
+			var runs atomic.Int32
+			s.Add(context.Background(), "job", time.Now(), func(context.Context) time.Duration {
+				if runs.Add(1) == 1 && tc.running {
+					<-release
+				}
+				return time.Hour
+			})
+			waitFor(t, time.Second, func() bool { return runs.Load() == 1 },
+				"job did not run")
+			if !tc.running {
+				waitFor(t, time.Second, func() bool { _, ok := s.Next("job"); return ok },
+					"job was not rescheduled")
+			}
+
+			// WHEN: RunNow is called.
+			if !s.RunNow("job") {
+				t.Fatalf("%s\nRunNow did not find the job",
+					t.Name())
+			}
+			close(release)
+
+			// THEN: it runs again without waiting the hour.
+			waitFor(t, time.Second, func() bool { return runs.Load() == 2 },
+				"job did not run again")
+		})
+	}
+}
+
+func TestScheduler_RunNow__unknown(t *testing.T) {
+	// GIVEN: an empty Scheduler.
+	s := New(1)
+	t.Cleanup(s.Stop)
+
+	// WHEN: RunNow is called for an unknown job.
+	got := s.RunNow("unknown")
+
+	// THEN: it is not found.
+	if got {
+		t.Errorf("%s\nRunNow found an unknown job",
+			t.Name())
+	}
+}
+
+func TestScheduler_Remove(t *testing.T) {
+	// GIVEN: a scheduled job.
+	s := New(1)
+	t.Cleanup(s.Stop)
+	at := time.Now().Add(time.Hour)
+	s.Add(context.Background(), "job", at, func(context.Context) time.Duration { return -1 })
+	if got, ok := s.Next("job"); !ok || !got.Equal(at) {
+		t.Fatalf("%s\nNext() mismatch\nwant: %s, true\ngot:  %s, %t",
+			t.Name(), at, got, ok)
+	}
+
+	// WHEN: it is removed.
+	s.Remove("job")
+
+	// THEN: it is no longer scheduled.
+	if _, ok := s.Next("job"); ok {
+		t.Errorf("%s\njob still scheduled after Remove",
+			t.Name())
+	}
+	if got := s.Len(); got != 0 {
+		t.Errorf("%s\nLen() mismatch\nwant: 0\ngot:  %d",
+			t.Name(), got)
+	}
+}
+
+func TestScheduler_Stop(t *testing.T) {
+	// GIVEN: a Scheduler with a scheduled job.
+	s := New(1)
+	var runs atomic.Int32
+	s.Add(context.Background(), "job", time.Now().Add(20*time.Millisecond), func(context.Context) time.Duration {
+		runs.Add(1)
+		return -1
+	})
+
+	// WHEN: it is stopped.
+	s.Stop()
+	s.Stop() // Safe to call twice.
+
+	// THEN: the job does not run.
+	time.Sleep(50 * time.Millisecond)
+	if got := runs.Load(); got != 0 {
+		t.Errorf("%s\nstopped job ran %d times, want 0",
+			t.Name(), got)
+	}
+	// AND: jobs added afterwards are ignored.
+	s.Add(context.Background(), "other", time.Now(), func(context.Context) time.Duration { return -1 })
+	if got := s.Len(); got != 0 {
+		t.Errorf("%s\nLen() mismatch\nwant: 0\ngot:  %d",
+			t.Name(), got)
+	}
+}
@@ -17,8 +17,10 @@ package shoutrrr
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	serviceinfo "github.com/release-argus/Argus/service/status/info"
 	"github.com/release-argus/Argus/util"
 )
@@ -94,6 +96,27 @@ func (s *Shoutrrr) GetMaxTries() uint8 {
 	return uint8(tries)
 }
 
+// GetProxy resolves the proxy to send the notification through from the 'proxy' and 'no_proxy' options.
+func (s *Shoutrrr) GetProxy() *httpx.Proxy {
+	return proxyFromOptions(s.GetOption("proxy"), s.GetOption("no_proxy"))
+}
+
+// proxyFromOptions returns the Proxy for the given 'proxy' URL and comma-separated 'no_proxy' list,
+// or nil if no proxy URL is given.
+func proxyFromOptions(proxyURL, noProxy string) *httpx.Proxy {
+	if proxyURL == "" {
+		return nil
+	}
+
+	proxy := &httpx.Proxy{URL: proxyURL}
+	for entry := range strings.SplitSeq(noProxy, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			proxy.NoProxy = append(proxy.NoProxy, entry)
+		}
+	}
+	return proxy
+}
+
 // getOption returns the value for key, or an empty string if not present.
 func (b *Base) getOption(key string) string {
 	return b.Options[key]
@@ -29,6 +29,7 @@ import (
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	serviceinfo "github.com/release-argus/Argus/service/status/info"
 	"github.com/release-argus/Argus/util"
@@ -454,8 +455,16 @@ func (s *Shoutrrr) getSender(
 	// Build the URL.
 	url := s.BuildURL()
 
+	// Send within the request limits, and through the proxy, if set.
+	// Services that don't accept a HTTP client use the global proxy.
+	client, err := httpx.ClientFor(nil, s.GetProxy(), false)
+	if err != nil {
+		return nil, "", nil, "", fmt.Errorf("proxy: %w", err)
+	}
+	opts := types.SenderOptions{HTTPClient: client}
+
 	// Check the URL provides a valid sender.
-	sender, err := shoutrrr.CreateSenderWithOptions(types.SenderOptions{}, url)
+	sender, err := shoutrrr.CreateSenderWithOptions(opts, url)
 	if err != nil {
 		err = fmt.Errorf("failed to create Shoutrrr sender: %w", err)
 		return nil, "", nil, "", err
@@ -524,6 +524,19 @@ func (b *Base) checkValuesOptions() error {
 		}
 	}
 
+	// Options.Proxy.
+	if proxy := proxyFromOptions(b.getOption("proxy"), b.getOption("no_proxy")); proxy != nil {
+		if err := proxy.CheckValues(); err != nil {
+			errs = append(
+				errs,
+				&decode.ErrKeyField{
+					Key: "proxy",
+					Err: err,
+				},
+			)
+		}
+	}
+
 	// Options.Message.
 	optionMessage := b.getOption("message")
 	if !util.CheckTemplate(optionMessage) {
@@ -21,6 +21,7 @@ import (
 	"io"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/notify/shoutrrr"
 	shoutrrr_types "github.com/release-argus/Argus/notify/shoutrrr/types"
@@ -227,6 +228,11 @@ func (s *Service) giveSecrets(oldService *Service, secretRefs secretRefs) {
 		oldService.Options.SemanticVersioning == s.Options.SemanticVersioning {
 		s.Status.SetDeployedVersion(oldService.Status.DeployedVersion(), oldService.Status.DeployedVersionTimestamp(), false)
 	}
+	// Keep the versions behind/lead time if both versions were kept.
+	if s.Status.LatestVersion() == oldService.Status.LatestVersion() &&
+		s.Status.DeployedVersion() == oldService.Status.DeployedVersion() {
+		s.Status.CopyDriftFrom(&oldService.Status)
+	}
 }
 
 // giveSecretsLatestVersion copies secrets from oldLatestVersion into the receiver's LatestVersion.
@@ -272,6 +278,10 @@ func (s *Service) giveSecretsNotify(oldNotifiers shoutrrr.Shoutrrrs, secretRefs
 		util.RestoreMaskedValues(oldNotify.URLFields, notify.URLFields, shoutrrr_types.CensorableURLFields)
 		// params
 		util.RestoreMaskedValues(oldNotify.Params, notify.Params, shoutrrr_types.CensorableParams)
+		// options.proxy
+		if proxy := notify.Options["proxy"]; proxy != "" {
+			notify.Options["proxy"] = httpx.InheritProxyURLSecret(proxy, oldNotify.Options["proxy"])
+		}
 	}
 }
 
@@ -301,6 +311,10 @@ func (s *Service) giveSecretsWebHook(oldWebHooks webhook.WebHooks, secretRefs ma
 		if wh.Secret == util.SecretValue {
 			wh.Secret = oldWebHook.Secret
 		}
+		// oauth2.
+		wh.OAuth2.InheritSecrets(oldWebHook.OAuth2)
+		// proxy.
+		wh.Proxy.InheritSecrets(oldWebHook.Proxy)
 
 		// headers.
 		// Check we have headers in old and new.
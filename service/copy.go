@@ -48,6 +48,9 @@ func (s *Service) Copy(withChannels bool) *Service {
 	}
 	// Notify.
 	svc.Notify = s.Notify.Copy(&svc.Status)
+	if len(s.reminderNotify) != 0 {
+		svc.reminderNotify = s.reminderNotify.Copy(&svc.Status)
+	}
 	// Command.
 	if len(s.Command) != 0 {
 		svc.CommandController = command.NewController(
@@ -818,7 +818,7 @@ func TestDecodeService(t *testing.T) {
 			errRegex: test.TrimYAML(`
 				^"__name__":
 					deployed_version:
-						type: "unsupported" <invalid> .*\['manual', 'url'\].*$`,
+						type: "unsupported" <invalid> .*\['command', 'docker', 'file', 'kubernetes', 'manual', 'prometheus', 'url'\].*$`,
 			),
 		},
 		{
@@ -833,7 +833,7 @@ func TestDecodeService(t *testing.T) {
 			errRegex: test.TrimYAML(`
 				^"__name__":
 					deployed_version:
-						type: <required> .*\['manual', 'url'\].*$`,
+						type: <required> .*\['command', 'docker', 'file', 'kubernetes', 'manual', 'prometheus', 'url'\].*$`,
 			),
 		},
 		{
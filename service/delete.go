@@ -22,6 +22,7 @@ import (
 // PrepDelete removes all channels and sets the deleting flag to prepare a service for deletion.
 func (s *Service) PrepDelete(removeFromDB bool) {
 	s.Status.SetDeleting()
+	s.StopTracking()
 
 	// Set the channels to nil to prevent the service from triggering further events.
 	s.Status.AnnounceChannel = nil
@@ -0,0 +1,64 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestService_PrepDelete(t *testing.T) {
+	// GIVEN: a Service being tracked, that was queried just now.
+	svc := testService(t, t.Name(), "url", "url")
+	lastQueried := time.Now().UTC()
+	svc.Status.SetLastQueried(lastQueried.Format(time.RFC3339))
+	svc.Track()
+	t.Cleanup(svc.StopTracking)
+	jobIDs := []string{
+		svc.deployedVersionJobID(),
+		svc.latestVersionJobID(),
+	}
+
+	prefix := fmt.Sprintf("%s\nService.PrepDelete()", packageName)
+
+	// The first queries wait until the interval has elapsed since it was last queried.
+	wantAfter := lastQueried.Truncate(time.Second).Add(svc.Options.GetIntervalDuration())
+	for _, jobID := range jobIDs {
+		next, scheduled := tracker.Next(jobID)
+		if !scheduled || next.Before(wantAfter) {
+			t.Fatalf(
+				"%s %q not scheduled after the interval\ngot:  %s (scheduled=%t)\nwant: %s or later",
+				prefix, jobID, next, scheduled, wantAfter,
+			)
+		}
+	}
+
+	// WHEN: PrepDelete is called on it.
+	svc.PrepDelete(false)
+
+	// THEN: it is deleting.
+	if !svc.Status.Deleting() {
+		t.Errorf("%s should have set Deleting", prefix)
+	}
+	// AND: its lookups are no longer scheduled.
+	for _, jobID := range jobIDs {
+		if _, scheduled := tracker.Next(jobID); scheduled {
+			t.Errorf("%s %q still scheduled", prefix, jobID)
+		}
+	}
+}
@@ -68,7 +68,6 @@ func (f *mockLookup) Copy(*status.Status) base.Interface          { return f }
 func (f *mockLookup) DecodeSelf(format string, data []byte) error { return nil }
 func (f *mockLookup) GetType() string                             { return "fake" }
 func (f *mockLookup) String(prefix string) string                 { return decode.ToYAMLString(f, prefix) }
-func (f *mockLookup) Track()                                      {}
 
 func testLookup(t *testing.T, typ string, fail bool, version string) (dv Lookup) {
 	dvCfg := plainDefaultsConfig(t)
@@ -0,0 +1,50 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deployedver provides the deployed_version lookup service to for a service.
+package deployedver
+
+import (
+	"errors"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	dvmanual "github.com/release-argus/Argus/service/deployed_version/types/manual"
+	opt "github.com/release-argus/Argus/service/option"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// Push sets the deployed version of a Service to one reported by an external source (e.g. CI/CD),
+// applying it as a `manual` lookup would, so it is validated, persisted and announced the same way.
+func Push(
+	options *opt.Options,
+	svcStatus *status.Status,
+	version string,
+) error {
+	if version == "" {
+		return errors.New("version: <required>")
+	}
+
+	lookup := &dvmanual.Lookup{
+		Lookup: base.Lookup{
+			Type:    dvmanual.Type,
+			Options: options,
+			Status:  svcStatus,
+		},
+		Version: version,
+	}
+	logFrom := logx.LogFrom{Primary: "deployed_version/push", Secondary: lookup.GetServiceID()}
+
+	return lookup.Query(true, logFrom) //nolint:wrapcheck
+}
@@ -0,0 +1,178 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package deployedver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/service/deployed_version/types/manual"
+	"github.com/release-argus/Argus/service/deployed_version/types/web"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestPush(t *testing.T) {
+	// An hour ago, to stay clear of the rate-limit on manual updates.
+	anHourAgo := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	type args struct {
+		lookupType               string
+		version                  string
+		deployedVersion          string
+		deployedVersionTimestamp string
+	}
+	type wants struct {
+		statusVersion string
+		dbMessages    int
+		announces     int
+	}
+
+	// GIVEN: a Service with a deployed_version Lookup, and a version pushed to it.
+	tests := []struct {
+		name     string
+		args     args
+		errRegex string
+		wants    wants
+	}{
+		{
+			name: "manual lookup, version is persisted and announced",
+			args: args{
+				lookupType: manual.Type,
+				version:    "1.2.3",
+			},
+			errRegex: `^$`,
+			wants: wants{
+				statusVersion: "1.2.3",
+				dbMessages:    1,
+				announces:     1,
+			},
+		},
+		{
+			name: "url lookup, version is persisted and announced",
+			args: args{
+				lookupType:               web.Type,
+				version:                  "1.2.3",
+				deployedVersion:          "1.2.2",
+				deployedVersionTimestamp: anHourAgo,
+			},
+			errRegex: `^$`,
+			wants: wants{
+				statusVersion: "1.2.3",
+				dbMessages:    1,
+				announces:     1,
+			},
+		},
+		{
+			name: "unchanged version writes nothing",
+			args: args{
+				lookupType:               manual.Type,
+				version:                  "1.2.3",
+				deployedVersion:          "1.2.3",
+				deployedVersionTimestamp: anHourAgo,
+			},
+			errRegex: `^$`,
+			wants: wants{
+				statusVersion: "1.2.3",
+			},
+		},
+		{
+			name: "no version",
+			args: args{
+				lookupType: manual.Type,
+			},
+			errRegex: `^version: <required>$`,
+		},
+		{
+			name: "non-semantic version is rejected",
+			args: args{
+				lookupType:               manual.Type,
+				version:                  "not-a-version",
+				deployedVersion:          "1.2.3",
+				deployedVersionTimestamp: anHourAgo,
+			},
+			errRegex: `failed to convert "not-a-version" to a semantic version`,
+			wants: wants{
+				statusVersion: "1.2.3",
+			},
+		},
+		{
+			name: "update within the rate-limit is rejected",
+			args: args{
+				lookupType:               manual.Type,
+				version:                  "1.2.4",
+				deployedVersion:          "1.2.3",
+				deployedVersionTimestamp: time.Now().UTC().Add(time.Second).Format(time.RFC3339),
+			},
+			errRegex: `rate-limited`,
+			wants: wants{
+				statusVersion: "1.2.3",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t, tc.args.lookupType, false, "")
+			svcStatus := lookup.GetStatus()
+			if tc.args.deployedVersion != "" {
+				svcStatus.SetDeployedVersion(
+					tc.args.deployedVersion,
+					tc.args.deployedVersionTimestamp,
+					false,
+				)
+			}
+
+			// WHEN: Push is called with that version.
+			err := Push(lookup.GetOptions(), svcStatus, tc.args.version)
+
+			prefix := fmt.Sprintf("%s\nPush()", packageName)
+
+			// THEN: we get an error only when expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf("%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version reaches the Service's Status.
+			if got := svcStatus.DeployedVersion(); got != tc.wants.statusVersion {
+				t.Errorf("%s mismatch on Status.DeployedVersion()\ngot:  %q\nwant: %q",
+					prefix, got, tc.wants.statusVersion,
+				)
+			}
+
+			// AND: it reaches the database.
+			if got := len(svcStatus.DatabaseChannel); got != tc.wants.dbMessages {
+				t.Errorf("%s DatabaseChannel message count mismatch\ngot:  %d\nwant: %d",
+					prefix, got, tc.wants.dbMessages,
+				)
+			}
+
+			// AND: it reaches the WebSocket clients.
+			if got := len(svcStatus.AnnounceChannel); got != tc.wants.announces {
+				t.Errorf("%s AnnounceChannel message count mismatch\ngot:  %d\nwant: %d",
+					prefix, got, tc.wants.announces,
+				)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package deployedver
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -323,6 +325,52 @@ func TestRefresh__previewKeepsLiveStatus(t *testing.T) {
 	}
 }
 
+func TestRefresh__previewURLCommands(t *testing.T) {
+	// GIVEN: a URL Lookup, and a server reporting the version inside a larger string.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"build": "app-1.2.3-linux"}`)
+	}))
+	t.Cleanup(server.Close)
+	lookup := testLookup(t, web.Type, false, "")
+	hadVersion := lookup.GetStatus().DeployedVersion()
+	// AND: overrides that extract it with url_commands after the JSON key.
+	overrides := []byte(`{
+		"url": "` + server.URL + `",
+		"json": "build",
+		"url_commands": [
+			{"type": "split", "text": "-", "index": 1}
+		]
+	}`)
+
+	// WHEN: Refresh previews those overrides.
+	got, err := Refresh(
+		lookup,
+		lookup.GetType(),
+		overrides,
+		nil,
+		nil,
+	)
+
+	prefix := fmt.Sprintf("%s\nRefresh()", packageName)
+
+	// THEN: no error is given.
+	if err != nil {
+		t.Fatalf("%s unexpected error: %v", prefix, err)
+	}
+	// AND: the version is extracted by the url_commands.
+	if want := "1.2.3"; got != want {
+		t.Errorf("%s mismatch on version returned\ngot:  %q\nwant: %q",
+			prefix, got, want,
+		)
+	}
+	// AND: the live Lookup is unchanged.
+	if gotLive := lookup.GetStatus().DeployedVersion(); gotLive != hadVersion {
+		t.Errorf("%s changed the live DeployedVersion\ngot:  %q\nwant: %q",
+			prefix, gotLive, hadVersion,
+		)
+	}
+}
+
 func TestRefresh__overridesRemovingTheLookup(t *testing.T) {
 	// GIVEN: a Lookup, and overrides that remove it.
 	lookup := testLookup(t, manual.Type, false, "")
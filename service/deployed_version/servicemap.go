@@ -16,22 +16,37 @@
 package deployedver
 
 import (
+	dvcommand "github.com/release-argus/Argus/service/deployed_version/types/command"
+	dvdocker "github.com/release-argus/Argus/service/deployed_version/types/docker"
+	dvfile "github.com/release-argus/Argus/service/deployed_version/types/file"
+	dvkubernetes "github.com/release-argus/Argus/service/deployed_version/types/kubernetes"
 	dvmanual "github.com/release-argus/Argus/service/deployed_version/types/manual"
+	dvprometheus "github.com/release-argus/Argus/service/deployed_version/types/prometheus"
 	dvweb "github.com/release-argus/Argus/service/deployed_version/types/web"
 	"github.com/release-argus/Argus/util/polymorphic"
 )
 
 // PossibleTypes for the deployed_version Lookup.
 var PossibleTypes = []string{
+	dvcommand.Type,
+	dvdocker.Type,
+	dvfile.Type,
+	dvkubernetes.Type,
 	dvmanual.Type,
+	dvprometheus.Type,
 	dvweb.Type,
 }
 
 // ServiceMap maps a service type to a Lookup constructor.
 var ServiceMap = map[string]func() Lookup{
-	dvweb.Type:    func() Lookup { return &dvweb.Lookup{} },
-	"web":         func() Lookup { return &dvweb.Lookup{} },
-	dvmanual.Type: func() Lookup { return &dvmanual.Lookup{} },
+	dvweb.Type:        func() Lookup { return &dvweb.Lookup{} },
+	"web":             func() Lookup { return &dvweb.Lookup{} },
+	dvmanual.Type:     func() Lookup { return &dvmanual.Lookup{} },
+	dvcommand.Type:    func() Lookup { return &dvcommand.Lookup{} },
+	dvdocker.Type:     func() Lookup { return &dvdocker.Lookup{} },
+	dvfile.Type:       func() Lookup { return &dvfile.Lookup{} },
+	dvkubernetes.Type: func() Lookup { return &dvkubernetes.Lookup{} },
+	dvprometheus.Type: func() Lookup { return &dvprometheus.Lookup{} },
 }
 
 // ServiceMapInheritable is [ServiceMap] wrapped for polymorphic inheritance decoding.
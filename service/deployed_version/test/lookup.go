@@ -48,7 +48,6 @@ func (f *MockLookup) Copy(*status.Status) base.Interface          { return f }
 func (f *MockLookup) DecodeSelf(format string, data []byte) error { return nil }
 func (f *MockLookup) GetType() string                             { return "fake" }
 func (f *MockLookup) String(prefix string) string                 { return decode.ToYAMLString(f, prefix) }
-func (f *MockLookup) Track()                                      {}
 
 // Lookup decodes and validates a deployed version lookup of the given type for tests.
 func Lookup(t *testing.T, typ string, fail bool, version string) (dv deployedver.Lookup) {
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -98,9 +99,13 @@ func TestMockLookup_Track(t *testing.T) {
 	fake := &MockLookup{}
 	// WHEN: Track is called.
 	start := time.Now()
-	fake.Track()
+	got := fake.Track(context.Background(), func() {})
 	// THEN: no panic occurs and the method completes successfully.
 	if since := time.Since(start); since > time.Second {
 		t.Errorf("MockLookup.Track() didn't complete within 1 second\ntook: %v", since)
 	}
+	// AND: it asks to be polled on the interval.
+	if !got {
+		t.Errorf("MockLookup.Track() mismatch\ngot:  %t\nwant: %t", got, true)
+	}
 }
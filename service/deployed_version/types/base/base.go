@@ -16,6 +16,7 @@
 package base
 
 import (
+	"context"
 	"errors"
 
 	"github.com/release-argus/Argus/internal/logx"
@@ -104,6 +105,11 @@ func (l *Lookup) ApplyConfiguredVersion() {
 	// Nothing to apply.
 }
 
+// Track returns true, leaving the deployed version to be queried on the interval.
+func (l *Lookup) Track(_ context.Context, _ func()) bool {
+	return true
+}
+
 // Query queries the service for the deployed version.
 func (l *Lookup) Query(_ bool, _ logx.LogFrom) error {
 	return errors.New("not implemented")
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/service/deployed_version/types/web/constants"
 	opt "github.com/release-argus/Argus/service/option"
 	"github.com/release-argus/Argus/util/polymorphic"
@@ -39,9 +40,10 @@ type DefaultsConfig struct {
 
 // Defaults are the default values for a Lookup.
 type Defaults struct {
-	Type              string `json:"type,omitzero" yaml:"type,omitzero"`                               // "manual" | "url".
-	AllowInvalidCerts *bool  `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // False = Disallows invalid HTTPS certificates.
-	Method            string `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
+	Type              string     `json:"type,omitzero" yaml:"type,omitzero"`                               // "command" | "docker" | "file" | "kubernetes" | "manual" | "prometheus" | "url".
+	AllowInvalidCerts *bool      `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // False = Disallows invalid HTTPS certificates.
+	TLS               *httpx.TLS `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
+	Method            string     `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
 
 	Options *opt.Defaults `json:"-" yaml:"-"` // Options for the Lookup.
 }
@@ -72,6 +74,7 @@ func DecodeDefaults(format string, data []byte) (*Defaults, error) {
 func (d Defaults) IsZero() bool {
 	return d.Type == "" &&
 		d.AllowInvalidCerts == nil &&
+		d.TLS.IsZero() &&
 		d.Method == ""
 }
 
@@ -111,6 +114,16 @@ func (d *Defaults) CheckValues() error {
 			},
 		)
 	}
+	// TLS.
+	if err := d.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
+	}
 
 	if len(errs) == 0 {
 		return nil
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 )
 
@@ -223,6 +224,7 @@ func TestDefaults_CheckValues(t *testing.T) {
 	tests := []struct {
 		name       string
 		method     string
+		tls        *httpx.TLS
 		errRegex   string
 		wantMethod string
 	}{
@@ -262,13 +264,21 @@ func TestDefaults_CheckValues(t *testing.T) {
 			),
 			wantMethod: "FOO",
 		},
+		{
+			name: "invalid tls",
+			tls:  &httpx.TLS{KeyFile: "key.pem"},
+			errRegex: test.TrimYAML(`
+				^tls:
+					cert_file: <required>.*$`,
+			),
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			input := Defaults{Method: tc.method}
+			input := Defaults{Method: tc.method, TLS: tc.tls}
 
 			_ = test.AssertCheckValuesWithError(
 				t,
@@ -81,4 +81,3 @@ func (l *lookupImpl) Copy(*status.Status) Interface       { return &lookupImpl{}
 func (l *lookupImpl) DecodeSelf(string, []byte) error     { return nil }
 func (l *lookupImpl) GetType() string                     { return "test" }
 func (l *lookupImpl) String(prefix string) string         { return decode.ToYAMLString(l, prefix) }
-func (l *lookupImpl) Track()                              {}
@@ -0,0 +1,35 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package base provides the base struct for deployed_version lookups.
+package base
+
+import "strings"
+
+// SplitImageReference splits an image reference into its repository, tag and digest,
+// e.g. ghcr.io/release-argus/argus:1.2.3@sha256:abc -> ghcr.io/release-argus/argus, 1.2.3, sha256:abc.
+func SplitImageReference(reference string) (string, string, string) {
+	var digest string
+	if i := strings.Index(reference, "@"); i != -1 {
+		reference, digest = reference[:i], reference[i+1:]
+	}
+
+	// A colon after the last slash separates the tag (a colon before it would be a registry port).
+	var tag string
+	if i := strings.LastIndex(reference, ":"); i > strings.LastIndex(reference, "/") {
+		reference, tag = reference[:i], reference[i+1:]
+	}
+
+	return reference, tag, digest
+}
@@ -0,0 +1,78 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package base
+
+import "testing"
+
+func TestSplitImageReference(t *testing.T) {
+	// GIVEN: an image reference.
+	tests := []struct {
+		name                     string
+		reference                string
+		wantRepo, wantTag, wantD string
+	}{
+		{
+			name:      "repository only",
+			reference: "nginx",
+			wantRepo:  "nginx",
+		},
+		{
+			name:      "tag",
+			reference: "nginx:1.25.3",
+			wantRepo:  "nginx", wantTag: "1.25.3",
+		},
+		{
+			name:      "registry with port, no tag",
+			reference: "registry:5000/team/app",
+			wantRepo:  "registry:5000/team/app",
+		},
+		{
+			name:      "registry with port and tag",
+			reference: "registry:5000/team/app:1.2.3",
+			wantRepo:  "registry:5000/team/app", wantTag: "1.2.3",
+		},
+		{
+			name:      "digest",
+			reference: "ghcr.io/release-argus/argus@sha256:abc",
+			wantRepo:  "ghcr.io/release-argus/argus", wantD: "sha256:abc",
+		},
+		{
+			name:      "tag and digest",
+			reference: "ghcr.io/release-argus/argus:1.2.3@sha256:abc",
+			wantRepo:  "ghcr.io/release-argus/argus", wantTag: "1.2.3", wantD: "sha256:abc",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: splitReference is called.
+			repo, tag, digest := SplitImageReference(tc.reference)
+
+			// THEN: the parts are as expected.
+			if repo != tc.wantRepo || tag != tc.wantTag || digest != tc.wantD {
+				t.Errorf(
+					"%s\nSplitImageReference(%q) mismatch\ngot:  %q, %q, %q\nwant: %q, %q, %q",
+					packageName, tc.reference,
+					repo, tag, digest,
+					tc.wantRepo, tc.wantTag, tc.wantD,
+				)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@
 package base
 
 import (
+	"context"
+
 	"github.com/release-argus/Argus/internal/logx"
 	opt "github.com/release-argus/Argus/service/option"
 	"github.com/release-argus/Argus/service/shared"
@@ -66,8 +68,10 @@ type Interface interface {
 
 	// String returns a string representation of the receiver with any given prefix.
 	String(prefix string) string
-	// Track monitors the deployed version of the parent service.
-	Track()
+	// Track starts any monitoring of the deployed version beyond polling Query,
+	// calling trigger to request a query, until ctx is cancelled.
+	// Returns whether Query should be polled on the interval.
+	Track(ctx context.Context, trigger func()) bool
 	// Copy returns a deep copy of the receiver, with the given status.
 	Copy(svcStatus *status.Status) Interface
 }
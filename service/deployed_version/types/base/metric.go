@@ -67,6 +67,13 @@ func (l *Lookup) DeleteMetrics(parentLookup Interface) {
 		lookupType,
 		metric.ActionResultFail,
 	)
+	metric.DeletePrometheusCounter(
+		metric.DeployedVersionQueryResultTotal,
+		l.GetServiceID(),
+		"",
+		lookupType,
+		metric.ActionResultNotModified,
+	)
 }
 
 // QueryMetrics sets the Prometheus metrics for the DeployedVersion query.
@@ -100,3 +107,24 @@ func (l *Lookup) QueryMetrics(parentLookup Interface, err error) {
 		result,
 	)
 }
+
+// NotModifiedMetrics sets the Prometheus metrics for a DeployedVersion query that returned a 304 Not Modified.
+func (l *Lookup) NotModifiedMetrics(parentLookup Interface) {
+	serviceID := l.GetServiceID()
+	serviceType := parentLookup.GetType()
+
+	// Set liveness.
+	metric.SetPrometheusGauge(
+		metric.DeployedVersionQueryResultLast,
+		serviceID, serviceType,
+		float64(metric.DeployedVersionQueryResultSuccess),
+	)
+	// Increase not modified count.
+	metric.IncPrometheusCounter(
+		metric.DeployedVersionQueryResultTotal,
+		serviceID,
+		"",
+		serviceType,
+		metric.ActionResultNotModified,
+	)
+}
@@ -228,3 +228,66 @@ func TestLookup_QueryMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestLookup_NotModifiedMetrics(t *testing.T) {
+	// GIVEN: a Lookup with its metrics initialised.
+	lookup := lookupImpl{
+		Lookup: Lookup{
+			Type: "test",
+		},
+	}
+	serviceID := "TestLookup_NotModifiedMetrics"
+	lookup.Status = &status.Status{
+		ServiceInfo: serviceinfo.ServiceInfo{
+			ID: serviceID,
+		},
+	}
+	lookup.InitMetrics(&lookup)
+	hadC := testutil.CollectAndCount(metric.DeployedVersionQueryResultTotal)
+
+	// WHEN: NotModifiedMetrics is called.
+	lookup.NotModifiedMetrics(&lookup)
+
+	// THEN: the not modified counter is incremented.
+	counterNotModified := testutil.ToFloat64(
+		metric.DeployedVersionQueryResultTotal.WithLabelValues(
+			serviceID, lookup.GetType(), metric.ActionResultNotModified,
+		),
+	)
+	counterSuccess := testutil.ToFloat64(
+		metric.DeployedVersionQueryResultTotal.WithLabelValues(
+			serviceID, lookup.GetType(), metric.ActionResultSuccess,
+		),
+	)
+	if counterNotModified != 1 || counterSuccess != 0 {
+		t.Errorf(
+			"%s\nLookup.NotModifiedMetrics() counter mismatch\n"+
+				"got:  not_modified_count=%f, success_count=%f\n"+
+				"want: not_modified_count=1, success_count=0",
+			packageName, counterNotModified, counterSuccess,
+		)
+	}
+	// AND: the liveness is success.
+	gauge := testutil.ToFloat64(
+		metric.DeployedVersionQueryResultLast.WithLabelValues(
+			serviceID, lookup.GetType(),
+		),
+	)
+	if want := float64(metric.DeployedVersionQueryResultSuccess); gauge != want {
+		t.Errorf(
+			"%s\nLookup.NotModifiedMetrics() gauge mismatch\ngot:  %f\nwant: %f",
+			packageName, gauge, want,
+		)
+	}
+
+	// WHEN: the metrics are deleted.
+	lookup.DeleteMetrics(&lookup)
+
+	// THEN: the not modified counter is removed too.
+	if gotC := testutil.CollectAndCount(metric.DeployedVersionQueryResultTotal); gotC != hadC-2 {
+		t.Errorf(
+			"%s\nLookup.DeleteMetrics() Counter metrics mismatch\ngot:  %d\nwant: %d",
+			packageName, gotC, hadC-2,
+		)
+	}
+}
@@ -33,7 +33,7 @@ func (l *Lookup) HandleNewVersion(
 	}
 
 	// Set the new Deployed version.
-	l.Status.SetDeployedVersion(version, "", writeToDB)
+	l.Status.SetDeployedVersion(version, releaseDate, writeToDB)
 
 	logx.Info(
 		fmt.Sprintf("Updated to %q", version),
@@ -0,0 +1,159 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package base provides the base struct for deployed_version lookups.
+package base
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/latest_version/filter"
+	"github.com/release-argus/Argus/util"
+)
+
+// Extraction holds the options used to extract a version from queried content.
+type Extraction struct {
+	JSON          string             // JSON key to use e.g. version_current.
+	Regex         string             // RegEx for the version.
+	RegexTemplate string             // Template to apply to the RegEx match.
+	URLCommands   filter.URLCommands // Commands to filter the version from the extracted text.
+}
+
+// CheckValues validates the JSON key, RegEx and URLCommands of the receiver.
+func (e *Extraction) CheckValues() []error {
+	var errs []error
+
+	// JSON.
+	if e.JSON != "" {
+		if _, err := decode.ParseKeys(e.JSON); err != nil {
+			errs = append(
+				errs,
+				&decode.ErrField{
+					Key:         "json",
+					Value:       e.JSON,
+					Description: "JSON path to the version in the response",
+				},
+			)
+		}
+	}
+
+	// RegEx.
+	if e.Regex != "" {
+		if _, err := regexp.Compile(e.Regex); err != nil {
+			errs = append(
+				errs,
+				&decode.ErrField{
+					Key:         "regex",
+					Value:       e.Regex,
+					Description: "RegEx to extract the version from the response",
+				},
+			)
+		}
+	}
+
+	// URLCommands.
+	if err := e.URLCommands.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "url_commands",
+				Err: err,
+			},
+		)
+	}
+
+	return errs
+}
+
+// GetVersion returns the version from content that matches the JSON, RegEx and URLCommands of extraction,
+// verifying it follows semantic versioning if enabled.
+// source identifies where content came from (e.g. a URL) in any errors.
+func (l *Lookup) GetVersion(
+	content []byte,
+	extraction Extraction,
+	source string,
+	logFrom logx.LogFrom,
+) (string, error) {
+	var version string
+	// If JSON is provided, use it to extract the version.
+	if extraction.JSON != "" {
+		var err error
+		version, err = decode.GetValueByKey(content, extraction.JSON, source)
+		if err != nil {
+			logx.Error(err, logFrom, true)
+			//nolint:wrapcheck
+			return "", err
+		}
+	} else {
+		// Use all the content if not parsing as JSON.
+		version = string(content)
+	}
+
+	if version == "" {
+		err := fmt.Errorf(
+			"no version found in %q",
+			util.TruncateMessage(version, 100),
+		)
+		logx.Warn(err, logFrom, true)
+		return "", err
+	}
+
+	// If a regex is provided, use it to extract the version.
+	if extraction.Regex != "" {
+		re := regexp.MustCompile(extraction.Regex)
+		texts := re.FindAllStringSubmatch(version, 1)
+
+		if len(texts) == 0 {
+			err := fmt.Errorf(
+				"regex %q didn't return any matches on %q",
+				extraction.Regex, util.TruncateMessage(version, 100),
+			)
+			logx.Warn(err, logFrom, true)
+			return "", err
+		}
+
+		regexMatches := texts[0]
+		version = util.RegexTemplate(regexMatches, extraction.RegexTemplate)
+	}
+
+	// If URLCommands are provided, use the first version they produce.
+	if len(extraction.URLCommands) != 0 {
+		versions, err := extraction.URLCommands.Run(version, logFrom)
+		if err != nil {
+			return "", err //nolint:wrapcheck
+		}
+		if len(versions) == 0 || versions[0] == "" {
+			err := fmt.Errorf(
+				"url_commands didn't return a version from %q",
+				util.TruncateMessage(version, 100),
+			)
+			logx.Warn(err, logFrom, true)
+			return "", err
+		}
+		version = versions[0]
+	}
+
+	// If semantic versioning is enabled, check the version is in the correct format.
+	if l.Options.GetSemanticVersioning() {
+		if _, err := l.Options.VerifySemanticVersioning(version, logFrom); err != nil {
+			logx.Warn(err, logFrom, true)
+			return "", err //nolint:wrapcheck
+		}
+	}
+
+	return version, nil
+}
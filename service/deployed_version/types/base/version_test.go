@@ -0,0 +1,268 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+// Package base provides the base struct for deployed_version lookups.
+package base
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/service/latest_version/filter"
+	opt "github.com/release-argus/Argus/service/option"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestExtraction_CheckValues(t *testing.T) {
+	// GIVEN: an Extraction.
+	tests := []struct {
+		name       string
+		extraction Extraction
+		errRegex   string
+	}{
+		{
+			name:     "empty",
+			errRegex: `^$`,
+		},
+		{
+			name: "valid",
+			extraction: Extraction{
+				JSON:          "foo.bar[0]",
+				Regex:         `v([0-9.]+)`,
+				RegexTemplate: "$1",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "JSON/invalid",
+			extraction: Extraction{
+				JSON: "foo[bar]",
+			},
+			errRegex: `^json: "foo\[bar\]" <invalid>.*$`,
+		},
+		{
+			name: "regex/invalid",
+			extraction: Extraction{
+				Regex: "[0-",
+			},
+			errRegex: `^regex: "\[0-" <invalid>.*$`,
+		},
+		{
+			name: "url_commands/invalid",
+			extraction: Extraction{
+				URLCommands: filter.URLCommands{
+					{Type: "split"},
+				},
+			},
+			errRegex: test.TrimYAML(`
+				^url_commands:
+					- item_0:
+						type: split
+						text: <required>.*$`,
+			),
+		},
+		{
+			name: "all invalid",
+			extraction: Extraction{
+				JSON:  "foo[bar]",
+				Regex: "[0-",
+			},
+			errRegex: `^json: .*\nregex: .*$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: CheckValues is called on it.
+			errs := tc.extraction.CheckValues()
+
+			// THEN: the errors are as expected.
+			var e string
+			for i, err := range errs {
+				if i != 0 {
+					e += "\n"
+				}
+				e += errfmt.FormatError(err)
+			}
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s\nExtraction.CheckValues() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_GetVersion(t *testing.T) {
+	// GIVEN: a Lookup and content to extract a version from.
+	tests := []struct {
+		name               string
+		content            string
+		extraction         Extraction
+		semanticVersioning bool
+		want               string
+		errRegex           string
+	}{
+		{
+			name:     "plain content",
+			content:  "1.2.3",
+			want:     "1.2.3",
+			errRegex: `^$`,
+		},
+		{
+			name:     "empty content",
+			content:  "",
+			errRegex: `no version found`,
+		},
+		{
+			name:    "JSON",
+			content: `{"foo": {"version": "1.2.3"}}`,
+			extraction: Extraction{
+				JSON: "foo.version",
+			},
+			want:     "1.2.3",
+			errRegex: `^$`,
+		},
+		{
+			name:    "JSON/missing key",
+			content: `{"foo": {"version": "1.2.3"}}`,
+			extraction: Extraction{
+				JSON: "foo.bar",
+			},
+			errRegex: `failed to find value for "foo.bar"`,
+		},
+		{
+			name:    "regex",
+			content: "nginx version: nginx/1.25.3",
+			extraction: Extraction{
+				Regex: `nginx/([0-9.]+)`,
+			},
+			want:     "1.25.3",
+			errRegex: `^$`,
+		},
+		{
+			name:    "regex with template",
+			content: "v1_2_3",
+			extraction: Extraction{
+				Regex:         `v(\d+)_(\d+)_(\d+)`,
+				RegexTemplate: "$1.$2.$3",
+			},
+			want:     "1.2.3",
+			errRegex: `^$`,
+		},
+		{
+			name:    "regex/no match",
+			content: "foo",
+			extraction: Extraction{
+				Regex: `[0-9]+`,
+			},
+			errRegex: `regex "\[0-9\]\+" didn't return any matches on "foo"`,
+		},
+		{
+			name:    "url_commands",
+			content: "app: foo-1.2.3-linux",
+			extraction: Extraction{
+				URLCommands: filter.URLCommands{
+					{Type: "split", Text: "-", Index: new(1)},
+				},
+			},
+			want:     "1.2.3",
+			errRegex: `^$`,
+		},
+		{
+			name:    "url_commands after JSON and regex",
+			content: `{"build": "release_v1_2_3-linux"}`,
+			extraction: Extraction{
+				JSON:  "build",
+				Regex: `v([0-9_]+)`,
+				URLCommands: filter.URLCommands{
+					{Type: "replace", Old: "_", New: "."},
+				},
+			},
+			want:     "1.2.3",
+			errRegex: `^$`,
+		},
+		{
+			name:    "url_commands/fail",
+			content: "1.2.3",
+			extraction: Extraction{
+				URLCommands: filter.URLCommands{
+					{Type: "regex", Regex: `v([0-9.]+)`},
+				},
+			},
+			errRegex: `regex "v\(\[0-9\.\]\+\)" didn't return any matches`,
+		},
+		{
+			name:               "semantic versioning/fail",
+			content:            "1_2_3",
+			semanticVersioning: true,
+			errRegex:           `failed to convert "1_2_3" to a semantic version`,
+		},
+		{
+			name:               "semantic versioning/pass",
+			content:            "1.2.3",
+			semanticVersioning: true,
+			want:               "1.2.3",
+			errRegex:           `^$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := Lookup{
+				Options: &opt.Options{
+					Base: opt.Base{
+						SemanticVersioning: &tc.semanticVersioning,
+					},
+					Defaults:     &opt.Defaults{},
+					HardDefaults: &opt.Defaults{},
+				},
+			}
+
+			// WHEN: GetVersion is called on it.
+			got, err := lookup.GetVersion([]byte(tc.content), tc.extraction, "https://example.com", logx.LogFrom{})
+
+			prefix := fmt.Sprintf(
+				"%s\nLookup.GetVersion(content=%q, extraction=%+v)",
+				packageName, tc.content, tc.extraction,
+			)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+			// AND: the version is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s version mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.want,
+				)
+			}
+		})
+	}
+}
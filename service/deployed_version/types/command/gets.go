@@ -0,0 +1,48 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package command provides a command-based lookup type.
+package command
+
+import (
+	"time"
+
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/util"
+)
+
+// GetType returns the type of the receiver.
+func (l *Lookup) GetType() string {
+	return Type
+}
+
+// timeout returns the maximum duration the command may run for.
+func (l *Lookup) timeout() time.Duration {
+	timeout, _ := time.ParseDuration(util.ValueOr(l.Timeout, DefaultTimeout))
+	return timeout
+}
+
+// output returns the command output to read the version from.
+func (l *Lookup) output() string {
+	return util.ValueOr(l.Output, OutputStdout)
+}
+
+// extraction returns the options to extract the version from the command output.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		JSON:          l.JSON,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
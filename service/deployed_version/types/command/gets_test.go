@@ -0,0 +1,118 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package command
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookup_GetType(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+
+	// WHEN: GetType is called on it.
+	got := lookup.GetType()
+
+	// THEN: the type is returned.
+	if got != Type {
+		t.Errorf(
+			"%s\nLookup.GetType() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, Type,
+		)
+	}
+}
+
+func TestLookup_Timeout(t *testing.T) {
+	// GIVEN: a Lookup with a Timeout.
+	tests := []struct {
+		name    string
+		timeout string
+		want    time.Duration
+	}{
+		{
+			name:    "default",
+			timeout: "",
+			want:    10 * time.Second,
+		},
+		{
+			name:    "set",
+			timeout: "1m",
+			want:    time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.Timeout = tc.timeout
+
+			// WHEN: timeout is called on it.
+			got := lookup.timeout()
+
+			// THEN: the duration is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.timeout() mismatch\ngot:  %s\nwant: %s",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Output(t *testing.T) {
+	// GIVEN: a Lookup with an Output.
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{
+			name:   "default",
+			output: "",
+			want:   OutputStdout,
+		},
+		{
+			name:   "set",
+			output: OutputCombined,
+			want:   OutputCombined,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.Output = tc.output
+
+			// WHEN: output is called on it.
+			got := lookup.output()
+
+			// THEN: the output is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.output() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
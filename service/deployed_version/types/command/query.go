@@ -0,0 +1,82 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package command provides a command-based lookup type.
+package command
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/util"
+)
+
+// Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
+func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
+	err := l.query(metrics, logFrom)
+
+	if metrics {
+		l.QueryMetrics(l, err)
+	}
+
+	return err
+}
+
+// query runs the command and updates DeployedVersion if changed.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
+	output, err := l.exec(logFrom)
+	if err != nil {
+		return err
+	}
+
+	version, err := l.GetVersion(
+		bytes.TrimSpace(output),
+		l.extraction(),
+		l.Command.String(),
+		logFrom,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Set the deployed version if it has changed.
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+
+	return nil
+}
+
+// exec runs the templated command and returns the configured output.
+func (l *Lookup) exec(logFrom logx.LogFrom) ([]byte, error) {
+	command := l.Command.ApplyTemplate(l.Status.GetServiceInfo())
+
+	stdout, stderr, err := command.Output(l.timeout())
+	if err != nil {
+		err = fmt.Errorf(
+			"command %q failed: %w (stderr: %q)",
+			command.String(), err, util.TruncateMessage(string(bytes.TrimSpace(stderr)), 100),
+		)
+		logx.Error(err, logFrom, true)
+		return nil, err
+	}
+
+	switch l.output() {
+	case OutputStderr:
+		return stderr, nil
+	case OutputCombined:
+		return append(stdout, stderr...), nil
+	default:
+		return stdout, nil
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package command
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestLookup_Query(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name                        string
+		overrides, optionsOverrides string
+		errRegex                    string
+		wantVersion                 string
+	}{
+		{
+			name: "stdout/plain",
+			overrides: test.TrimYAML(`
+				command: [echo, 1.2.3]
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "stdout/JSON",
+			overrides: test.TrimYAML(`
+				command: [echo, '{"foo": {"version": "1.2.3"}}']
+				json: foo.version
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "stdout/JSON, key not found",
+			overrides: test.TrimYAML(`
+				command: [echo, '{"version": "1.2.3"}']
+				json: something
+			`),
+			errRegex: `failed to find value for "something" in `,
+		},
+		{
+			name: "stdout/regex",
+			overrides: test.TrimYAML(`
+				command: [echo, 'app version v1.2.3 (build 4)']
+				regex: 'v([0-9.]+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "stdout/regex with template",
+			overrides: test.TrimYAML(`
+				command: [echo, 'app 1_2_3']
+				regex: '(\d+)_(\d+)_(\d+)'
+				regex_template: '$1.$2.$3'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "stdout/regex, no match",
+			overrides: test.TrimYAML(`
+				command: [echo, 'app version']
+				regex: 'v([0-9.]+)'
+			`),
+			errRegex: `regex .* didn't return any matches on`,
+		},
+		{
+			name: "stdout/empty",
+			overrides: test.TrimYAML(`
+				command: ['true']
+			`),
+			errRegex: `^no version found in ""$`,
+		},
+		{
+			name: "stderr",
+			overrides: test.TrimYAML(`
+				command: [sh, -c, 'echo 1.2.3 >&2']
+				output: stderr
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "combined",
+			overrides: test.TrimYAML(`
+				command: [sh, -c, 'echo app; echo v1.2.3 >&2']
+				output: combined
+				regex: 'v([0-9.]+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "want semantic versioning but get non-semantic version",
+			overrides: test.TrimYAML(`
+				command: [echo, 'ver1']
+			`),
+			optionsOverrides: `semantic_versioning: true`,
+			errRegex:         `failed to convert "ver1" to a semantic version`,
+		},
+		{
+			name: "allow non-semantic version",
+			overrides: test.TrimYAML(`
+				command: [echo, 'ver1']
+			`),
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^ver1$`,
+			errRegex:         `^$`,
+		},
+		{
+			name: "templated command",
+			overrides: test.TrimYAML(`
+				command: [echo, '{{ service_id }} 1.2.3']
+				regex: '([0-9.]+)$'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "non-zero exit code",
+			overrides: test.TrimYAML(`
+				command: [sh, -c, 'echo oops >&2; exit 3']
+			`),
+			errRegex: `^command "sh -c .*" failed: exit status 3 \(stderr: "oops"\)\s+exit status 3$`,
+		},
+		{
+			name: "timeout",
+			overrides: test.TrimYAML(`
+				command: [sleep, '5']
+				timeout: 100ms
+			`),
+			errRegex: `^command "sleep 5" failed: "sleep 5" timed out after 100ms \(stderr: ""\)`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dvl := testLookup(t)
+			dvl.JSON = ""
+			if err := dvl.ApplyOverrides("yaml", []byte(tc.overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+			if tc.optionsOverrides != "" {
+				if err := decode.Unmarshal("yaml", []byte(tc.optionsOverrides), dvl.Options); err != nil {
+					t.Fatalf(
+						"%s\nfailed to unmarshal Lookup.Options overrides: %s",
+						packageName, err,
+					)
+				}
+			}
+
+			// WHEN: Query is called on it.
+			err := dvl.Query(true, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version matches the expected regex.
+			if tc.wantVersion != "" {
+				if version := dvl.Status.DeployedVersion(); !util.RegexCheck(tc.wantVersion, version) {
+					t.Errorf(
+						"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant %q",
+						packageName, version, tc.wantVersion,
+					)
+				}
+			}
+		})
+	}
+}
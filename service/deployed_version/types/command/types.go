@@ -0,0 +1,95 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package command provides a command-based lookup type.
+package command
+
+import (
+	cmd "github.com/release-argus/Argus/command"
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// #############
+// # CONSTANTS #
+// #############
+
+// Type is the lookup type identifier for command deployed version lookups.
+var Type = "command"
+
+// DefaultTimeout is the maximum duration a command may run for when no timeout is given.
+const DefaultTimeout = "10s"
+
+// Output* are the command outputs that the version can be read from.
+const (
+	OutputStdout   = "stdout"
+	OutputStderr   = "stderr"
+	OutputCombined = "combined"
+)
+
+// SupportedOutputs lists the outputs allowed for command deployed version lookups.
+var SupportedOutputs = []string{OutputStdout, OutputStderr, OutputCombined}
+
+// #########
+// # TYPES #
+// #########
+
+// Lookup is a command-based lookup type.
+type Lookup struct {
+	base.Lookup `json:",inline" yaml:",inline"`
+
+	Command       cmd.Command `json:"command,omitempty" yaml:"command,omitempty"`             // REQUIRED: command to run.
+	Timeout       string      `json:"timeout,omitzero" yaml:"timeout,omitzero"`               // OPTIONAL: maximum duration the command may run for.
+	Output        string      `json:"output,omitzero" yaml:"output,omitzero"`                 // OPTIONAL: output to read the version from (stdout|stderr|combined).
+	JSON          string      `json:"json,omitzero" yaml:"json,omitzero"`                     // OPTIONAL: JSON key to use e.g. version_current.
+	Regex         string      `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
+	RegexTemplate string      `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+}
+
+// #############
+// # STRINGIFY #
+// #############
+
+// String returns a string representation of the receiver.
+func (l *Lookup) String(prefix string) string {
+	return decode.ToYAMLString(l, prefix)
+}
+
+// #########
+// # STATE #
+// #########
+
+// Copy returns a deep copy of the receiver.
+func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
+	if l == nil {
+		return nil
+	}
+
+	return &Lookup{
+		Lookup:        *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		Command:       l.Command.Copy(),
+		Timeout:       l.Timeout,
+		Output:        l.Output,
+		JSON:          l.JSON,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
+
+// InheritSecrets is a no-op as a command lookup holds no secrets.
+func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
+	// Nothing to inherit.
+}
@@ -0,0 +1,90 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package command provides a command-based lookup type.
+package command
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util/polymorphic"
+)
+
+// CheckValues validates the fields of the receiver.
+func (l *Lookup) CheckValues() error {
+	var errs []error
+
+	// Command.
+	if len(l.Command) == 0 {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "command",
+				Description: "command to get the deployed_version from",
+			},
+		)
+	} else if err := l.Command.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "command",
+				Err: err,
+			},
+		)
+	}
+
+	// Timeout.
+	if l.Timeout != "" {
+		if timeout, err := time.ParseDuration(l.Timeout); err != nil || timeout <= 0 {
+			errs = append(
+				errs,
+				&decode.ErrField{
+					Key:         "timeout",
+					Value:       l.Timeout,
+					Description: "maximum duration the command may run for, e.g. 10s",
+				},
+			)
+		}
+	}
+
+	// Output.
+	l.Output = strings.ToLower(l.Output)
+	if l.Output != "" && !slices.Contains(SupportedOutputs, l.Output) {
+		errs = append(
+			errs,
+			polymorphic.ErrInvalidType{
+				Key:     "output",
+				Value:   l.Output,
+				Allowed: SupportedOutputs,
+			},
+		)
+	}
+
+	// JSON/RegEx.
+	extraction := l.extraction()
+	errs = append(errs, extraction.CheckValues()...)
+	// Remove the RegExTemplate if no RegEx.
+	if l.Regex == "" {
+		l.RegexTemplate = ""
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
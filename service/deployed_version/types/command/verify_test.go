@@ -0,0 +1,158 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package command
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_CheckValues(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name       string
+		data       string
+		wantOutput string
+		errRegex   string
+	}{
+		{
+			name: "valid",
+			data: test.TrimYAML(`
+				command: [nginx, -v]
+				timeout: 5s
+				output: stderr
+				regex: 'nginx/([0-9.]+)'
+			`),
+			wantOutput: OutputStderr,
+			errRegex:   `^$`,
+		},
+		{
+			name: "command/empty",
+			data: test.TrimYAML(`
+				command: []
+			`),
+			errRegex: `^command: <required>.*$`,
+		},
+		{
+			name: "command/invalid template",
+			data: test.TrimYAML(`
+				command: [echo, '{{ version }']
+			`),
+			errRegex: `^command:\s+"echo \{\{ version }" .*<invalid>.*$`,
+		},
+		{
+			name: "timeout/invalid",
+			data: test.TrimYAML(`
+				command: [app, --version]
+				timeout: foo
+			`),
+			errRegex: `^timeout: "foo" <invalid>.*$`,
+		},
+		{
+			name: "timeout/negative",
+			data: test.TrimYAML(`
+				command: [app, --version]
+				timeout: -1s
+			`),
+			errRegex: `^timeout: "-1s" <invalid>.*$`,
+		},
+		{
+			name: "output/case insensitive",
+			data: test.TrimYAML(`
+				command: [app, --version]
+				output: Combined
+			`),
+			wantOutput: OutputCombined,
+			errRegex:   `^$`,
+		},
+		{
+			name: "output/invalid",
+			data: test.TrimYAML(`
+				command: [app, --version]
+				output: foo
+			`),
+			wantOutput: "foo",
+			errRegex:   `^output: "foo" <invalid>.*$`,
+		},
+		{
+			name: "regex_template, with no regex",
+			data: test.TrimYAML(`
+				command: [app, --version]
+				regex_template: $1.$2.$3
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "all invalid",
+			data: test.TrimYAML(`
+				command: []
+				timeout: foo
+				output: bar
+				json: 'foo[bar]'
+				regex: '[0-'
+			`),
+			wantOutput: "bar",
+			errRegex: test.TrimYAML(`
+				^command: <required>.*
+				timeout: "foo" <invalid>.*
+				output: "bar" <invalid>.*
+				json: "[^"]+" <invalid>.*
+				regex: "[^"]+" <invalid>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testLookup(t)
+			input.JSON = ""
+			// Apply the YAML.
+			if err := input.ApplyOverrides("yaml", []byte(tc.data)); err != nil {
+				t.Fatalf(
+					"%s\nLookup.ApplyOverrides(%q) failed before Lookup.CheckValues(): %v",
+					packageName, tc.data,
+					err,
+				)
+			}
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				input.CheckValues,
+			)
+
+			// AND: RegexTemplate is empty when Regex is empty.
+			if input.RegexTemplate != "" && input.Regex == "" {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .RegexTemplate should be empty when Regex is empty",
+					packageName,
+				)
+			}
+			// AND: Output is lowercased.
+			if input.Output != tc.wantOutput {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Output mismatch\ngot:  %q\nwant: %q",
+					packageName, input.Output, tc.wantOutput,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docker provides a Docker Engine-based lookup type.
+package docker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/util"
+)
+
+// clients caches an *http.Client per Docker Engine API endpoint.
+var clients sync.Map
+
+// container is the part of the Docker Engine API container inspect response that is used.
+type container struct {
+	Image  string `json:"Image"` // ID of the image.
+	Config struct {
+		Image  string            `json:"Image"`  // Image reference the container was created from.
+		Labels map[string]string `json:"Labels"` // Labels of the container, including those of the image.
+	} `json:"Config"`
+}
+
+// image is the part of the Docker Engine API image inspect response that is used.
+type image struct {
+	RepoDigests []string `json:"RepoDigests"` // Repository digests of the image, e.g. nginx@sha256:...
+}
+
+// engineError is the error body returned by the Docker Engine API.
+type engineError struct {
+	Message string `json:"message"`
+}
+
+// engineEndpoint returns the base URL and dialer to use for the Docker Engine API at host.
+func engineEndpoint(host string) (string, func(ctx context.Context, network, addr string) (net.Conn, error), error) {
+	parsed, err := url.Parse(host)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid docker host %q: %w", host, err)
+	}
+
+	switch parsed.Scheme {
+	case "unix":
+		socket := parsed.Path
+		dialer := &net.Dialer{Timeout: 5 * time.Second}
+		return "http://docker",
+			func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+			nil
+	case "tcp":
+		return "http://" + parsed.Host, nil, nil
+	case "http", "https":
+		return strings.TrimSuffix(host, "/"), nil, nil
+	default:
+		return "", nil, fmt.Errorf(
+			"invalid docker host %q: unsupported scheme %q (unix|tcp|http|https)",
+			host, parsed.Scheme,
+		)
+	}
+}
+
+// engineClient returns the base URL and client to use for the Docker Engine API at host.
+func engineClient(host string) (string, *http.Client, error) {
+	baseURL, dial, err := engineEndpoint(host)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if client, ok := clients.Load(host); ok {
+		return baseURL, client.(*http.Client), nil //nolint:forcetypeassert
+	}
+
+	client := httpx.Client
+	if dial != nil {
+		transport := httpx.Transport.Clone()
+		transport.Proxy = nil
+		transport.DialContext = dial
+		client = &http.Client{
+			Timeout:   httpx.Client.Timeout,
+			Transport: httpx.Limited(transport),
+		}
+	}
+	actual, _ := clients.LoadOrStore(host, client)
+
+	return baseURL, actual.(*http.Client), nil //nolint:forcetypeassert
+}
+
+// get decodes the JSON response of a GET request to path on the Docker Engine API into v.
+func get(host, path string, v any) error {
+	baseURL, client, err := engineClient(host)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, body, err := httpx.Do(client, req)
+	if err != nil {
+		return fmt.Errorf("docker engine request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var engineErr engineError
+		_ = decode.Unmarshal("json", body, &engineErr)
+		message := util.ValueOr(engineErr.Message, util.TruncateMessage(string(body), 100))
+		return fmt.Errorf(
+			"non-2XX response code: %d (%s)",
+			resp.StatusCode, message,
+		)
+	}
+
+	if err := decode.Unmarshal("json", body, v); err != nil {
+		return fmt.Errorf("failed to unmarshal docker engine response: %w", err)
+	}
+	return nil
+}
+
+// inspectContainer returns the details of the named container.
+func inspectContainer(host, name string) (*container, error) {
+	var c container
+	if err := get(host, "/containers/"+url.PathEscape(name)+"/json", &c); err != nil {
+		return nil, fmt.Errorf("failed to inspect container %q: %w", name, err)
+	}
+	return &c, nil
+}
+
+// inspectImage returns the details of the image with the given ID.
+func inspectImage(host, id string) (*image, error) {
+	var i image
+	if err := get(host, "/images/"+url.PathEscape(id)+"/json", &i); err != nil {
+		return nil, fmt.Errorf("failed to inspect image %q: %w", id, err)
+	}
+	return &i, nil
+}
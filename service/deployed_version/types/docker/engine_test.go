@@ -0,0 +1,97 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestEngineEndpoint(t *testing.T) {
+	// GIVEN: a Docker host.
+	tests := []struct {
+		name        string
+		host        string
+		wantBaseURL string
+		wantDialer  bool
+		errRegex    string
+	}{
+		{
+			name:        "unix socket",
+			host:        "unix:///var/run/docker.sock",
+			wantBaseURL: "http://docker",
+			wantDialer:  true,
+			errRegex:    `^$`,
+		},
+		{
+			name:        "tcp",
+			host:        "tcp://docker:2375",
+			wantBaseURL: "http://docker:2375",
+			errRegex:    `^$`,
+		},
+		{
+			name:        "https, trailing slash",
+			host:        "https://docker.example.com/",
+			wantBaseURL: "https://docker.example.com",
+			errRegex:    `^$`,
+		},
+		{
+			name:     "unsupported scheme",
+			host:     "ssh://user@docker",
+			errRegex: `^invalid docker host "ssh://user@docker": unsupported scheme "ssh"`,
+		},
+		{
+			name:     "invalid url",
+			host:     "tcp://docker:port",
+			errRegex: `^invalid docker host "tcp://docker:port":`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: engineEndpoint is called.
+			baseURL, dial, err := engineEndpoint(tc.host)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nengineEndpoint(%q) error mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.host, e, tc.errRegex,
+				)
+			}
+			// AND: the base URL is as expected.
+			if baseURL != tc.wantBaseURL {
+				t.Errorf(
+					"%s\nengineEndpoint(%q) base URL mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.host, baseURL, tc.wantBaseURL,
+				)
+			}
+			// AND: a dialer is only returned for unix sockets.
+			if gotDialer := dial != nil; gotDialer != tc.wantDialer {
+				t.Errorf(
+					"%s\nengineEndpoint(%q) dialer mismatch\ngot:  %t\nwant: %t",
+					packageName, tc.host, gotDialer, tc.wantDialer,
+				)
+			}
+		})
+	}
+}
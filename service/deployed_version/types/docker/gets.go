@@ -0,0 +1,60 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docker provides a Docker Engine-based lookup type.
+package docker
+
+import (
+	"os"
+
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/util"
+)
+
+// GetType returns the type of the receiver.
+func (l *Lookup) GetType() string {
+	return Type
+}
+
+// host returns the Docker Engine API endpoint, falling back to DOCKER_HOST, then [DefaultHost].
+func (l *Lookup) host() string {
+	return util.FirstNonDefault(
+		util.EvalEnvVars(l.Host),
+		os.Getenv("DOCKER_HOST"),
+		DefaultHost,
+	)
+}
+
+// container returns the name or ID of the container, with environment variables evaluated.
+func (l *Lookup) container() string {
+	return util.EvalEnvVars(l.Container)
+}
+
+// source returns the part of the container to read the version from.
+func (l *Lookup) source() string {
+	return util.ValueOr(l.Source, SourceTag)
+}
+
+// label returns the label to read the version from.
+func (l *Lookup) label() string {
+	return util.ValueOr(l.Label, DefaultLabel)
+}
+
+// extraction returns the options to extract the version from the container.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
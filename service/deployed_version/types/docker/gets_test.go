@@ -0,0 +1,142 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_GetType(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+
+	// WHEN: GetType is called on it.
+	got := lookup.GetType()
+
+	// THEN: the type is returned.
+	if got != Type {
+		t.Errorf(
+			"%s\nLookup.GetType() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, Type,
+		)
+	}
+}
+
+func TestLookup_Host(t *testing.T) {
+	// GIVEN: a Lookup with a Host, and possibly DOCKER_HOST set.
+	tests := []struct {
+		name string
+		env  map[string]string
+		host string
+		want string
+	}{
+		{
+			name: "default",
+			want: DefaultHost,
+		},
+		{
+			name: "set",
+			host: "tcp://docker:2375",
+			want: "tcp://docker:2375",
+		},
+		{
+			name: "from env var",
+			env: map[string]string{
+				"TEST_LOOKUP__DV_DOCKER_HOST_ONE": "docker",
+			},
+			host: "tcp://${TEST_LOOKUP__DV_DOCKER_HOST_ONE}:2375",
+			want: "tcp://docker:2375",
+		},
+		{
+			name: "DOCKER_HOST",
+			env: map[string]string{
+				"DOCKER_HOST": "tcp://docker-host:2375",
+			},
+			want: "tcp://docker-host:2375",
+		},
+		{
+			name: "set over DOCKER_HOST",
+			env: map[string]string{
+				"DOCKER_HOST": "tcp://docker-host:2375",
+			},
+			host: "tcp://docker:2375",
+			want: "tcp://docker:2375",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're using DOCKER_HOST.
+
+			test.SetEnv(t, tc.env)
+			lookup := testLookup(t)
+			lookup.Host = tc.host
+
+			// WHEN: host is called on it.
+			got := lookup.host()
+
+			// THEN: the host is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.host() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Label(t *testing.T) {
+	// GIVEN: a Lookup with a Label.
+	tests := []struct {
+		name  string
+		label string
+		want  string
+	}{
+		{
+			name: "default",
+			want: DefaultLabel,
+		},
+		{
+			name:  "set",
+			label: "com.example.version",
+			want:  "com.example.version",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.Label = tc.label
+
+			// WHEN: label is called on it.
+			got := lookup.label()
+
+			// THEN: the label is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.label() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
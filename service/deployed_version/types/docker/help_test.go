@@ -0,0 +1,142 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit || integration
+
+package docker
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	logtest "github.com/release-argus/Argus/internal/test/log"
+	"github.com/release-argus/Argus/service/dashboard"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	opt "github.com/release-argus/Argus/service/option"
+	opttest "github.com/release-argus/Argus/service/option/test"
+	"github.com/release-argus/Argus/service/status"
+)
+
+var packageName = "deployedver_docker"
+
+func TestMain(m *testing.M) {
+	// Log.
+	logtest.InitLog()
+
+	// Run other tests.
+	exitCode := m.Run()
+
+	if len(logx.ExitCodeChannel()) > 0 {
+		fmt.Printf("%s\nexit code channel not empty", packageName)
+		exitCode = 1
+	}
+
+	// Exit.
+	os.Exit(exitCode)
+}
+
+func testLookup(t *testing.T) *Lookup {
+	t.Helper()
+
+	// Defaults.
+	dvCfg := plainDefaultsConfig(t)
+	// Options.
+	optCfg := opttest.PlainDefaultsConfig(t)
+	options, _ := opt.Decode(
+		"yaml", []byte("semantic_versioning: true"),
+		optCfg,
+	)
+	// Status.
+	announceChannel := make(chan []byte, 24)
+	saveChannel := make(chan bool, 5)
+	databaseChannel := make(chan dbtype.Message, 5)
+	svcDashboard := &dashboard.Options{}
+	svcStatus := status.New(
+		announceChannel, databaseChannel, saveChannel,
+		"",
+		"", "",
+		"", "",
+		"",
+		svcDashboard,
+	)
+	svcStatus.Init(
+		0, 0, 0,
+		status.ServiceInfo{
+			ID: "docker-testLookup",
+		},
+		svcDashboard,
+	)
+
+	lookup, _ := Decode(
+		"yaml", []byte(test.TrimYAML(`
+			type: docker
+			host: tcp://localhost:2375
+			container: argus
+		`)),
+		options,
+		svcStatus,
+		dvCfg,
+	)
+
+	return lookup
+}
+
+// plainDefaultsConfig returns plain defaults and hardDefaults for testing.
+func plainDefaultsConfig(t *testing.T) base.DefaultsConfig {
+	t.Helper()
+
+	optDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults.Default()
+
+	defaults, _ := base.DecodeDefaults("yaml", nil)
+	defaults.Options = optDefaults
+	hardDefaults, _ := base.DecodeDefaults("yaml", nil)
+	hardDefaults.Default()
+	hardDefaults.Options = optHardDefaults
+
+	return base.DefaultsConfig{
+		Soft: defaults,
+		Hard: hardDefaults,
+	}
+}
+
+// testEngine returns a fake Docker Engine API serving the given containers and images, keyed by name/ID.
+func testEngine(t *testing.T, containers map[string]string, images map[string]string) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		var ok bool
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/containers/"):
+			body, ok = containers[strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/containers/"), "/json")]
+		case strings.HasPrefix(r.URL.Path, "/images/"):
+			body, ok = images[strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/images/"), "/json")]
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "No such object"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	})
+}
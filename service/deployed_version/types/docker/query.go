@@ -0,0 +1,115 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docker provides a Docker Engine-based lookup type.
+package docker
+
+import (
+	"fmt"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+)
+
+// Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
+func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
+	err := l.query(metrics, logFrom)
+
+	if metrics {
+		l.QueryMetrics(l, err)
+	}
+
+	return err
+}
+
+// query inspects the container and updates DeployedVersion if changed.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
+	value, err := l.read()
+	if err != nil {
+		logx.Error(err, logFrom, true)
+		return err
+	}
+
+	version, err := l.GetVersion(
+		[]byte(value),
+		l.extraction(),
+		l.container(),
+		logFrom,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Set the deployed version if it has changed.
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+
+	return nil
+}
+
+// read returns the tag, label or digest of the container.
+func (l *Lookup) read() (string, error) {
+	host, name := l.host(), l.container()
+	c, err := inspectContainer(host, name)
+	if err != nil {
+		return "", err
+	}
+
+	switch l.source() {
+	case SourceLabel:
+		label := l.label()
+		value := c.Config.Labels[label]
+		if value == "" {
+			return "", fmt.Errorf("label %q not found on container %q", label, name)
+		}
+		return value, nil
+	case SourceDigest:
+		return imageDigest(host, c)
+	default:
+		_, tag, digest := base.SplitImageReference(c.Config.Image)
+		if tag == "" {
+			// Pulled by digest alone.
+			if digest != "" {
+				return "", fmt.Errorf("image %q of container %q has no tag", c.Config.Image, name)
+			}
+			tag = "latest"
+		}
+		return tag, nil
+	}
+}
+
+// imageDigest returns the repository digest of the image the container is running.
+func imageDigest(host string, c *container) (string, error) {
+	repository, _, digest := base.SplitImageReference(c.Config.Image)
+	// Created from a digest.
+	if digest != "" {
+		return digest, nil
+	}
+
+	img, err := inspectImage(host, c.Image)
+	if err != nil {
+		return "", err
+	}
+	if len(img.RepoDigests) == 0 {
+		return "", fmt.Errorf("image %q has no repository digest", c.Config.Image)
+	}
+
+	// Prefer the digest of the repository the container was created from.
+	for _, repoDigest := range img.RepoDigests {
+		if repo, _, digest := base.SplitImageReference(repoDigest); repo == repository {
+			return digest, nil
+		}
+	}
+	_, _, digest = base.SplitImageReference(img.RepoDigests[0])
+	return digest, nil
+}
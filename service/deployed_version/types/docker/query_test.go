@@ -0,0 +1,275 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package docker
+
+import (
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestLookup_Query(t *testing.T) {
+	containers := map[string]string{
+		"tagged": `{
+			"Image": "sha256:1111",
+			"Config": {
+				"Image": "ghcr.io/release-argus/argus:1.2.3",
+				"Labels": {
+					"org.opencontainers.image.version": "v1.2.3",
+					"com.example.build": "build-1.2.4"
+				}
+			}
+		}`,
+		"untagged": `{
+			"Image": "sha256:2222",
+			"Config": {"Image": "registry:5000/app"}
+		}`,
+		"by-digest": `{
+			"Image": "sha256:3333",
+			"Config": {"Image": "ghcr.io/release-argus/argus@sha256:cccc"}
+		}`,
+		"local-build": `{
+			"Image": "sha256:4444",
+			"Config": {"Image": "app:dev"}
+		}`,
+	}
+	images := map[string]string{
+		"sha256:1111": `{"RepoDigests": ["release-argus/argus@sha256:bbbb", "ghcr.io/release-argus/argus@sha256:aaaa"]}`,
+		"sha256:2222": `{"RepoDigests": ["other/app@sha256:dddd"]}`,
+		"sha256:4444": `{"RepoDigests": []}`,
+	}
+
+	// GIVEN: a Lookup on a Docker Engine.
+	tests := []struct {
+		name                        string
+		unixSocket                  bool
+		overrides, optionsOverrides string
+		errRegex                    string
+		wantVersion                 string
+	}{
+		{
+			name:        "tag",
+			overrides:   `container: tagged`,
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:        "tag/over unix socket",
+			unixSocket:  true,
+			overrides:   `container: tagged`,
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "tag/implicit latest",
+			overrides: test.TrimYAML(`
+				container: untagged
+			`),
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^latest$`,
+			errRegex:         `^$`,
+		},
+		{
+			name:      "tag/pulled by digest",
+			overrides: `container: by-digest`,
+			errRegex:  `^image "[^"]+@sha256:cccc" of container "by-digest" has no tag$`,
+		},
+		{
+			name: "label/default",
+			overrides: test.TrimYAML(`
+				container: tagged
+				source: label
+				regex: 'v(.+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "label/chosen",
+			overrides: test.TrimYAML(`
+				container: tagged
+				source: label
+				label: com.example.build
+				regex: 'build-(.+)'
+			`),
+			wantVersion: `^1\.2\.4$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "label/not found",
+			overrides: test.TrimYAML(`
+				container: untagged
+				source: label
+			`),
+			errRegex: `^label "org\.opencontainers\.image\.version" not found on container "untagged"$`,
+		},
+		{
+			name: "digest/matching repository",
+			overrides: test.TrimYAML(`
+				container: tagged
+				source: digest
+			`),
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^sha256:aaaa$`,
+			errRegex:         `^$`,
+		},
+		{
+			name: "digest/first repository",
+			overrides: test.TrimYAML(`
+				container: untagged
+				source: digest
+			`),
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^sha256:dddd$`,
+			errRegex:         `^$`,
+		},
+		{
+			name: "digest/from reference",
+			overrides: test.TrimYAML(`
+				container: by-digest
+				source: digest
+			`),
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^sha256:cccc$`,
+			errRegex:         `^$`,
+		},
+		{
+			name: "digest/none",
+			overrides: test.TrimYAML(`
+				container: local-build
+				source: digest
+			`),
+			errRegex: `^image "app:dev" has no repository digest$`,
+		},
+		{
+			name: "want semantic versioning but get non-semantic version",
+			overrides: test.TrimYAML(`
+				container: tagged
+				source: label
+				label: com.example.build
+			`),
+			optionsOverrides: `semantic_versioning: true`,
+			errRegex:         `failed to convert "build-1\.2\.4" to a semantic version`,
+		},
+		{
+			name:      "container not found",
+			overrides: `container: missing`,
+			errRegex:  `^failed to inspect container "missing":\s+non-2XX response code: 404 \(No such object\)$`,
+		},
+		{
+			name: "engine unreachable",
+			overrides: test.TrimYAML(`
+				container: tagged
+				host: unix:///does/not/exist.sock
+			`),
+			errRegex: `^failed to inspect container "tagged":\s+docker engine request failed:`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewUnstartedServer(testEngine(t, containers, images))
+			host := "tcp://" + server.Listener.Addr().String()
+			if tc.unixSocket {
+				socket := filepath.Join(t.TempDir(), "docker.sock")
+				listener, err := net.Listen("unix", socket)
+				if err != nil {
+					t.Fatalf("%s\nfailed to listen on %q: %v",
+						packageName, socket, err)
+				}
+				_ = server.Listener.Close()
+				server.Listener = listener
+				host = "unix://" + socket
+			}
+			server.Start()
+			t.Cleanup(server.Close)
+
+			dvl := testLookup(t)
+			dvl.Host = host
+			if err := dvl.ApplyOverrides("yaml", []byte(tc.overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+			if tc.optionsOverrides != "" {
+				if err := decode.Unmarshal("yaml", []byte(tc.optionsOverrides), dvl.Options); err != nil {
+					t.Fatalf(
+						"%s\nfailed to unmarshal Lookup.Options overrides: %s",
+						packageName, err,
+					)
+				}
+			}
+
+			// WHEN: Query is called on it.
+			err := dvl.Query(true, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version matches the expected regex.
+			if tc.wantVersion != "" {
+				if version := dvl.Status.DeployedVersion(); !util.RegexCheck(tc.wantVersion, version) {
+					t.Errorf(
+						"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant %q",
+						packageName, version, tc.wantVersion,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestEngineClient_Cached(t *testing.T) {
+	// GIVEN: a Docker host.
+	host := "unix:///var/run/test-cached.sock"
+
+	// WHEN: engineClient is called twice for it.
+	_, first, _ := engineClient(host)
+	_, second, _ := engineClient(host)
+
+	// THEN: the same client is returned.
+	if first != second {
+		t.Errorf(
+			"%s\nengineClient(%q) returned a new client on the second call",
+			packageName, host,
+		)
+	}
+	// AND: it is not the shared HTTP client.
+	if first == httpx.Client {
+		t.Errorf(
+			"%s\nengineClient(%q) returned the default client for a unix socket",
+			packageName, host,
+		)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docker provides a Docker Engine-based lookup type.
+package docker
+
+import (
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// #############
+// # CONSTANTS #
+// #############
+
+// Type is the lookup type identifier for Docker deployed version lookups.
+var Type = "docker"
+
+// DefaultHost is the Docker Engine API endpoint used when neither host nor DOCKER_HOST is set.
+const DefaultHost = "unix:///var/run/docker.sock"
+
+// DefaultLabel is the image label read when source is label and no label is given.
+const DefaultLabel = "org.opencontainers.image.version"
+
+// Source* are the parts of the container that the version can be read from.
+const (
+	SourceTag    = "tag"
+	SourceLabel  = "label"
+	SourceDigest = "digest"
+)
+
+// SupportedSources lists the sources allowed for Docker deployed version lookups.
+var SupportedSources = []string{SourceTag, SourceLabel, SourceDigest}
+
+// #########
+// # TYPES #
+// #########
+
+// Lookup is a Docker Engine-based lookup type.
+type Lookup struct {
+	base.Lookup `json:",inline" yaml:",inline"`
+
+	Host          string `json:"host,omitzero" yaml:"host,omitzero"`                     // OPTIONAL: Docker Engine API endpoint, e.g. unix:///var/run/docker.sock or tcp://docker:2375.
+	Container     string `json:"container,omitzero" yaml:"container,omitzero"`           // REQUIRED: name or ID of the container.
+	Source        string `json:"source,omitzero" yaml:"source,omitzero"`                 // OPTIONAL: part of the container to read the version from (tag|label|digest).
+	Label         string `json:"label,omitzero" yaml:"label,omitzero"`                   // OPTIONAL: label to read the version from when source is label.
+	Regex         string `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
+	RegexTemplate string `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+}
+
+// #############
+// # STRINGIFY #
+// #############
+
+// String returns a string representation of the receiver.
+func (l *Lookup) String(prefix string) string {
+	return decode.ToYAMLString(l, prefix)
+}
+
+// #########
+// # STATE #
+// #########
+
+// Copy returns a deep copy of the receiver.
+func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
+	if l == nil {
+		return nil
+	}
+
+	return &Lookup{
+		Lookup:        *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		Host:          l.Host,
+		Container:     l.Container,
+		Source:        l.Source,
+		Label:         l.Label,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
+
+// InheritSecrets is a no-op as a Docker lookup holds no secrets.
+func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
+	// Nothing to inherit.
+}
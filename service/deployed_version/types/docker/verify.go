@@ -0,0 +1,85 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docker provides a Docker Engine-based lookup type.
+package docker
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util/polymorphic"
+)
+
+// CheckValues validates the fields of the receiver.
+func (l *Lookup) CheckValues() error {
+	var errs []error
+
+	// Host.
+	if l.Host != "" {
+		if _, _, err := engineEndpoint(l.host()); err != nil {
+			errs = append(
+				errs,
+				&decode.ErrField{
+					Key:         "host",
+					Value:       l.Host,
+					Description: "Docker Engine API endpoint, e.g. unix:///var/run/docker.sock or tcp://docker:2375",
+				},
+			)
+		}
+	}
+
+	// Container.
+	if l.Container == "" {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "container",
+				Description: "name of the container to get the deployed_version from",
+			},
+		)
+	}
+
+	// Source.
+	l.Source = strings.ToLower(l.Source)
+	if l.Source != "" && !slices.Contains(SupportedSources, l.Source) {
+		errs = append(
+			errs,
+			polymorphic.ErrInvalidType{
+				Key:     "source",
+				Value:   l.Source,
+				Allowed: SupportedSources,
+			},
+		)
+	}
+	// Remove the Label if not reading a label.
+	if l.source() != SourceLabel {
+		l.Label = ""
+	}
+
+	// RegEx.
+	extraction := l.extraction()
+	errs = append(errs, extraction.CheckValues()...)
+	// Remove the RegExTemplate if no RegEx.
+	if l.Regex == "" {
+		l.RegexTemplate = ""
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
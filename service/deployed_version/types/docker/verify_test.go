@@ -0,0 +1,143 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_CheckValues(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name       string
+		data       string
+		wantSource string
+		wantLabel  string
+		errRegex   string
+	}{
+		{
+			name: "valid",
+			data: test.TrimYAML(`
+				host: unix:///var/run/docker.sock
+				container: argus
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "host/invalid",
+			data: test.TrimYAML(`
+				host: ssh://docker
+				container: argus
+			`),
+			errRegex: `^host: "ssh://docker" <invalid>.*$`,
+		},
+		{
+			name: "container/empty",
+			data: test.TrimYAML(`
+				container: ''
+			`),
+			errRegex: `^container: <required>.*$`,
+		},
+		{
+			name: "source/case insensitive, label kept",
+			data: test.TrimYAML(`
+				container: argus
+				source: LABEL
+				label: com.example.version
+			`),
+			wantSource: SourceLabel,
+			wantLabel:  "com.example.version",
+			errRegex:   `^$`,
+		},
+		{
+			name: "source/label removed when not reading a label",
+			data: test.TrimYAML(`
+				container: argus
+				source: digest
+				label: com.example.version
+			`),
+			wantSource: SourceDigest,
+			errRegex:   `^$`,
+		},
+		{
+			name: "source/invalid",
+			data: test.TrimYAML(`
+				container: argus
+				source: env
+			`),
+			wantSource: "env",
+			errRegex:   `^source: "env" <invalid>.*$`,
+		},
+		{
+			name: "all invalid",
+			data: test.TrimYAML(`
+				host: ftp://docker
+				container: ''
+				source: foo
+				regex: '[0-'
+			`),
+			wantSource: "foo",
+			errRegex: test.TrimYAML(`
+				^host: "ftp://docker" <invalid>.*
+				container: <required>.*
+				source: "foo" <invalid>.*
+				regex: "[^"]+" <invalid>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testLookup(t)
+			input.Host = ""
+			// Apply the YAML.
+			if err := input.ApplyOverrides("yaml", []byte(tc.data)); err != nil {
+				t.Fatalf(
+					"%s\nLookup.ApplyOverrides(%q) failed before Lookup.CheckValues(): %v",
+					packageName, tc.data,
+					err,
+				)
+			}
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				input.CheckValues,
+			)
+
+			// AND: Source is lowercased.
+			if input.Source != tc.wantSource {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Source mismatch\ngot:  %q\nwant: %q",
+					packageName, input.Source, tc.wantSource,
+				)
+			}
+			// AND: Label is only kept when reading a label.
+			if input.Label != tc.wantLabel {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Label mismatch\ngot:  %q\nwant: %q",
+					packageName, input.Label, tc.wantLabel,
+				)
+			}
+		})
+	}
+}
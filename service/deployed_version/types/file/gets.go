@@ -0,0 +1,61 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a file-based lookup type.
+package file
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/util"
+)
+
+// GetType returns the type of the receiver.
+func (l *Lookup) GetType() string {
+	return Type
+}
+
+// path returns the path of the file, with environment variables evaluated.
+func (l *Lookup) path() string {
+	return filepath.Clean(util.EvalEnvVars(l.Path))
+}
+
+// format returns the format to parse the file as, inferring it from the file extension if not set.
+func (l *Lookup) format() string {
+	if l.Format != "" {
+		return l.Format
+	}
+
+	switch strings.ToLower(filepath.Ext(l.path())) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".toml":
+		return FormatTOML
+	default:
+		return FormatText
+	}
+}
+
+// extraction returns the options to extract the version from the file.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		JSON:          l.Key,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package file
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_GetType(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+
+	// WHEN: GetType is called on it.
+	got := lookup.GetType()
+
+	// THEN: the type is returned.
+	if got != Type {
+		t.Errorf(
+			"%s\nLookup.GetType() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, Type,
+		)
+	}
+}
+
+func TestLookup_Path(t *testing.T) {
+	// GIVEN: a Lookup with a Path.
+	tests := []struct {
+		name string
+		env  map[string]string
+		path string
+		want string
+	}{
+		{
+			name: "plain",
+			path: "/app/VERSION",
+			want: "/app/VERSION",
+		},
+		{
+			name: "cleaned",
+			path: "/app/../srv//VERSION",
+			want: "/srv/VERSION",
+		},
+		{
+			name: "env var",
+			env: map[string]string{
+				"TEST_LOOKUP__DV_FILE_PATH_ONE": "/srv",
+			},
+			path: "${TEST_LOOKUP__DV_FILE_PATH_ONE}/VERSION",
+			want: "/srv/VERSION",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.SetEnv(t, tc.env)
+			lookup := testLookup(t)
+			lookup.Path = tc.path
+
+			// WHEN: path is called on it.
+			got := lookup.path()
+
+			// THEN: the evaluated path is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.path() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Format(t *testing.T) {
+	// GIVEN: a Lookup with a Path and Format.
+	tests := []struct {
+		name         string
+		env          map[string]string
+		path, format string
+		want         string
+	}{
+		{
+			name: "json extension",
+			path: "/app/package.json",
+			want: FormatJSON,
+		},
+		{
+			name: "yaml extension",
+			path: "/app/Chart.yaml",
+			want: FormatYAML,
+		},
+		{
+			name: "yml extension",
+			path: "/app/docker-compose.YML",
+			want: FormatYAML,
+		},
+		{
+			name: "toml extension",
+			path: "/app/Cargo.toml",
+			want: FormatTOML,
+		},
+		{
+			name: "no extension",
+			path: "/app/VERSION",
+			want: FormatText,
+		},
+		{
+			name: "extension from env var",
+			env: map[string]string{
+				"TEST_LOOKUP__DV_FILE_FORMAT_ONE": "package.json",
+			},
+			path: "/app/${TEST_LOOKUP__DV_FILE_FORMAT_ONE}",
+			want: FormatJSON,
+		},
+		{
+			name:   "format overrides extension",
+			path:   "/app/package.json",
+			format: FormatText,
+			want:   FormatText,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.SetEnv(t, tc.env)
+			lookup := testLookup(t)
+			lookup.Path = tc.path
+			lookup.Format = tc.format
+
+			// WHEN: format is called on it.
+			got := lookup.format()
+
+			// THEN: the format is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.format() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit || integration
+
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	logtest "github.com/release-argus/Argus/internal/test/log"
+	"github.com/release-argus/Argus/service/dashboard"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	opt "github.com/release-argus/Argus/service/option"
+	opttest "github.com/release-argus/Argus/service/option/test"
+	"github.com/release-argus/Argus/service/status"
+)
+
+var packageName = "deployedver_file"
+
+func TestMain(m *testing.M) {
+	// Log.
+	logtest.InitLog()
+
+	// Run other tests.
+	exitCode := m.Run()
+
+	if len(logx.ExitCodeChannel()) > 0 {
+		fmt.Printf("%s\nexit code channel not empty", packageName)
+		exitCode = 1
+	}
+
+	// Exit.
+	os.Exit(exitCode)
+}
+
+func testLookup(t *testing.T) *Lookup {
+	t.Helper()
+
+	// Defaults.
+	dvCfg := plainDefaultsConfig(t)
+	// Options.
+	optCfg := opttest.PlainDefaultsConfig(t)
+	options, _ := opt.Decode(
+		"yaml", []byte("semantic_versioning: true"),
+		optCfg,
+	)
+	// Status.
+	announceChannel := make(chan []byte, 24)
+	saveChannel := make(chan bool, 5)
+	databaseChannel := make(chan dbtype.Message, 5)
+	svcDashboard := &dashboard.Options{}
+	svcStatus := status.New(
+		announceChannel, databaseChannel, saveChannel,
+		"",
+		"", "",
+		"", "",
+		"",
+		svcDashboard,
+	)
+	svcStatus.Init(
+		0, 0, 0,
+		status.ServiceInfo{
+			ID: "file-testLookup",
+		},
+		svcDashboard,
+	)
+
+	path := writeFile(t, "package.json", `{"version": "1.2.3"}`)
+	lookup, _ := Decode(
+		"yaml", []byte(test.TrimYAML(`
+			type: file
+			path: `+path+`
+			key: version
+		`)),
+		options,
+		svcStatus,
+		dvCfg,
+	)
+
+	return lookup
+}
+
+// writeFile writes content to a file with the given name in a temporary directory,
+// returning its path.
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("%s\nfailed to write %q: %v",
+			packageName, path, err)
+	}
+
+	return path
+}
+
+// plainDefaultsConfig returns plain defaults and hardDefaults for testing.
+func plainDefaultsConfig(t *testing.T) base.DefaultsConfig {
+	t.Helper()
+
+	optDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults.Default()
+
+	defaults, _ := base.DecodeDefaults("yaml", nil)
+	defaults.Options = optDefaults
+	hardDefaults, _ := base.DecodeDefaults("yaml", nil)
+	hardDefaults.Default()
+	hardDefaults.Options = optHardDefaults
+
+	return base.DefaultsConfig{
+		Soft: defaults,
+		Hard: hardDefaults,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a file-based lookup type.
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// Track watches the file for changes where supported, calling trigger to re-read it
+// whenever it changes, until ctx is cancelled.
+// The file is also re-read on the interval, so this always returns true.
+func (l *Lookup) Track(ctx context.Context, trigger func()) bool {
+	logFrom := logx.LogFrom{Primary: l.GetServiceID()}
+
+	if watcher := l.watch(logFrom); watcher != nil {
+		go func() {
+			defer watcher.Close()
+			l.notify(ctx, watcher, trigger, logFrom)
+		}()
+	}
+
+	return true
+}
+
+// Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
+func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
+	err := l.query(metrics, logFrom)
+
+	if metrics {
+		l.QueryMetrics(l, err)
+	}
+
+	return err
+}
+
+// query reads the file and updates DeployedVersion if changed.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
+	content, err := l.read(logFrom)
+	if err != nil {
+		return err
+	}
+
+	version, err := l.GetVersion(
+		bytes.TrimSpace(content),
+		l.extraction(),
+		l.path(),
+		logFrom,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Set the deployed version if it has changed.
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+
+	return nil
+}
+
+// read returns the content of the file,
+// converted to JSON when a key is to be read from a YAML/TOML file.
+func (l *Lookup) read(logFrom logx.LogFrom) ([]byte, error) {
+	path := l.path()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		err = fmt.Errorf("failed to read %q: %w", path, err)
+		logx.Error(err, logFrom, true)
+		return nil, err
+	}
+
+	// No conversion needed without a key, or if already JSON.
+	format := l.format()
+	if l.Key == "" || format == FormatJSON {
+		return content, nil
+	}
+
+	var data any
+	switch format {
+	case FormatYAML:
+		err = decode.Unmarshal("yaml", content, &data)
+	case FormatTOML:
+		err = toml.Unmarshal(content, &data)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to parse %q as %s: %w", path, format, err)
+		logx.Error(err, logFrom, true)
+		return nil, err
+	}
+
+	//nolint:wrapcheck
+	return decode.Marshal("json", data)
+}
@@ -0,0 +1,280 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package file
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestLookup_Query(t *testing.T) {
+	// GIVEN: a Lookup on a file.
+	tests := []struct {
+		name                        string
+		env                         map[string]string
+		fileName, content           string
+		overrides, optionsOverrides string
+		errRegex                    string
+		wantVersion                 string
+	}{
+		{
+			name:        "text/plain",
+			fileName:    "VERSION",
+			content:     "1.2.3\n",
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "text/regex",
+			fileName: "VERSION",
+			content:  "app v1.2.3 (build 4)",
+			overrides: test.TrimYAML(`
+				regex: 'v([0-9.]+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "text/regex with template",
+			fileName: "VERSION",
+			content:  "1_2_3",
+			overrides: test.TrimYAML(`
+				regex: '(\d+)_(\d+)_(\d+)'
+				regex_template: '$1.$2.$3'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "text/regex, no match",
+			fileName: "VERSION",
+			content:  "app",
+			overrides: test.TrimYAML(`
+				regex: 'v([0-9.]+)'
+			`),
+			errRegex: `regex .* didn't return any matches on`,
+		},
+		{
+			name:     "text/empty",
+			fileName: "VERSION",
+			content:  "",
+			errRegex: `^no version found in ""$`,
+		},
+		{
+			name:     "json/key",
+			fileName: "package.json",
+			content:  `{"name": "app", "version": "1.2.3"}`,
+			overrides: test.TrimYAML(`
+				key: version
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "json/key not found",
+			fileName: "package.json",
+			content:  `{"name": "app"}`,
+			overrides: test.TrimYAML(`
+				key: version
+			`),
+			errRegex: `failed to find value for "version" in `,
+		},
+		{
+			name:     "json/invalid",
+			fileName: "package.json",
+			content:  `{"name": `,
+			overrides: test.TrimYAML(`
+				key: version
+			`),
+			errRegex: `failed to unmarshal`,
+		},
+		{
+			name:     "yaml/key",
+			fileName: "Chart.yaml",
+			content: test.TrimYAML(`
+				apiVersion: v2
+				name: app
+				appVersion: 1.2.3
+			`),
+			overrides: test.TrimYAML(`
+				key: appVersion
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "yaml/nested key",
+			fileName: "docker-compose.yml",
+			content: test.TrimYAML(`
+				services:
+					app:
+						image: ghcr.io/release-argus/argus:1.2.3
+			`),
+			overrides: test.TrimYAML(`
+				key: services.app.image
+				regex: ':([0-9.]+)$'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "yaml/regex on whole file without key",
+			fileName: "Chart.yaml",
+			content: test.TrimYAML(`
+				name: app
+				appVersion: 1.2.3
+			`),
+			overrides: test.TrimYAML(`
+				regex: 'appVersion: ([0-9.]+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "yaml/invalid",
+			fileName: "Chart.yaml",
+			content:  "foo: [",
+			overrides: test.TrimYAML(`
+				key: foo
+			`),
+			errRegex: `^failed to parse ".*Chart\.yaml" as yaml:\s+`,
+		},
+		{
+			name:     "toml/key",
+			fileName: "Cargo.toml",
+			content: test.TrimYAML(`
+				[package]
+				name = "app"
+				version = "1.2.3"
+			`),
+			overrides: test.TrimYAML(`
+				key: package.version
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "toml/invalid",
+			fileName: "Cargo.toml",
+			content:  "[package",
+			overrides: test.TrimYAML(`
+				key: package.version
+			`),
+			errRegex: `^failed to parse ".*Cargo\.toml" as toml:\s+toml: `,
+		},
+		{
+			name:     "format overrides extension",
+			fileName: "version.txt",
+			content:  "version = \"1.2.3\"",
+			overrides: test.TrimYAML(`
+				format: toml
+				key: version
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:             "want semantic versioning but get non-semantic version",
+			fileName:         "VERSION",
+			content:          "ver1",
+			optionsOverrides: `semantic_versioning: true`,
+			errRegex:         `failed to convert "ver1" to a semantic version`,
+		},
+		{
+			name:             "allow non-semantic version",
+			fileName:         "VERSION",
+			content:          "ver1",
+			optionsOverrides: `semantic_versioning: false`,
+			wantVersion:      `^ver1$`,
+			errRegex:         `^$`,
+		},
+		{
+			name: "path from env",
+			env: map[string]string{
+				"TEST_LOOKUP__DV_FILE_QUERY_ONE": "VERSION",
+			},
+			fileName:    "VERSION",
+			content:     "1.2.3",
+			overrides:   `path: '{dir}/${TEST_LOOKUP__DV_FILE_QUERY_ONE}'`,
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:      "file does not exist",
+			fileName:  "VERSION",
+			content:   "1.2.3",
+			overrides: `path: '{dir}/missing'`,
+			errRegex:  `^failed to read ".*missing":\s+open .*missing:\s+no such file or directory$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.SetEnv(t, tc.env)
+			path := writeFile(t, tc.fileName, tc.content)
+			dvl := testLookup(t)
+			dvl.Key = ""
+			dvl.Path = path
+			overrides := strings.ReplaceAll(tc.overrides, "{dir}", filepath.Dir(path))
+			if err := dvl.ApplyOverrides("yaml", []byte(overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+			if tc.optionsOverrides != "" {
+				if err := decode.Unmarshal("yaml", []byte(tc.optionsOverrides), dvl.Options); err != nil {
+					t.Fatalf(
+						"%s\nfailed to unmarshal Lookup.Options overrides: %s",
+						packageName, err,
+					)
+				}
+			}
+
+			// WHEN: Query is called on it.
+			err := dvl.Query(true, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version matches the expected regex.
+			if tc.wantVersion != "" {
+				if version := dvl.Status.DeployedVersion(); !util.RegexCheck(tc.wantVersion, version) {
+					t.Errorf(
+						"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant %q",
+						packageName, version, tc.wantVersion,
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a file-based lookup type.
+package file
+
+import (
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// #############
+// # CONSTANTS #
+// #############
+
+// Type is the lookup type identifier for file deployed version lookups.
+var Type = "file"
+
+// Format* are the formats a file can be parsed as.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+	FormatText = "text"
+)
+
+// SupportedFormats lists the formats allowed for file deployed version lookups.
+var SupportedFormats = []string{FormatJSON, FormatYAML, FormatTOML, FormatText}
+
+// #########
+// # TYPES #
+// #########
+
+// Lookup is a file-based lookup type.
+type Lookup struct {
+	base.Lookup `json:",inline" yaml:",inline"`
+
+	Path          string `json:"path,omitzero" yaml:"path,omitzero"`                     // REQUIRED: path of the file to read.
+	Format        string `json:"format,omitzero" yaml:"format,omitzero"`                 // OPTIONAL: format of the file (json|yaml|toml|text), defaults to the file extension.
+	Key           string `json:"key,omitzero" yaml:"key,omitzero"`                       // OPTIONAL: key to use e.g. version_current.
+	Regex         string `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
+	RegexTemplate string `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+}
+
+// #############
+// # STRINGIFY #
+// #############
+
+// String returns a string representation of the receiver.
+func (l *Lookup) String(prefix string) string {
+	return decode.ToYAMLString(l, prefix)
+}
+
+// #########
+// # STATE #
+// #########
+
+// Copy returns a deep copy of the receiver.
+func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
+	if l == nil {
+		return nil
+	}
+
+	return &Lookup{
+		Lookup:        *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		Path:          l.Path,
+		Format:        l.Format,
+		Key:           l.Key,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
+
+// InheritSecrets is a no-op as a file lookup holds no secrets.
+func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
+	// Nothing to inherit.
+}
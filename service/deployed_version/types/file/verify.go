@@ -0,0 +1,82 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a file-based lookup type.
+package file
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util/polymorphic"
+)
+
+// CheckValues validates the fields of the receiver.
+func (l *Lookup) CheckValues() error {
+	var errs []error
+
+	// Path.
+	if l.Path == "" {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "path",
+				Description: "path of the file to get the deployed_version from",
+			},
+		)
+	}
+
+	// Format.
+	l.Format = strings.ToLower(l.Format)
+	if l.Format != "" && !slices.Contains(SupportedFormats, l.Format) {
+		errs = append(
+			errs,
+			polymorphic.ErrInvalidType{
+				Key:     "format",
+				Value:   l.Format,
+				Allowed: SupportedFormats,
+			},
+		)
+	}
+
+	// Key.
+	if l.Key != "" {
+		if _, err := decode.ParseKeys(l.Key); err != nil || l.format() == FormatText {
+			errs = append(
+				errs,
+				&decode.ErrField{
+					Key:         "key",
+					Value:       l.Key,
+					Description: "path to the version in a json/yaml/toml file",
+				},
+			)
+		}
+	}
+
+	// RegEx.
+	extraction := l.extraction()
+	extraction.JSON = ""
+	errs = append(errs, extraction.CheckValues()...)
+	// Remove the RegExTemplate if no RegEx.
+	if l.Regex == "" {
+		l.RegexTemplate = ""
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
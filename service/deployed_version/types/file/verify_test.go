@@ -0,0 +1,148 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package file
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_CheckValues(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name       string
+		data       string
+		wantFormat string
+		errRegex   string
+	}{
+		{
+			name: "valid",
+			data: test.TrimYAML(`
+				path: /app/Chart.yaml
+				key: appVersion
+				regex: '([0-9.]+)'
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "path/empty",
+			data: test.TrimYAML(`
+				path: ''
+			`),
+			errRegex: `^path: <required>.*$`,
+		},
+		{
+			name: "format/case insensitive",
+			data: test.TrimYAML(`
+				path: /app/version
+				format: TOML
+				key: version
+			`),
+			wantFormat: FormatTOML,
+			errRegex:   `^$`,
+		},
+		{
+			name: "format/invalid",
+			data: test.TrimYAML(`
+				path: /app/version
+				format: xml
+			`),
+			wantFormat: "xml",
+			errRegex:   `^format: "xml" <invalid>.*$`,
+		},
+		{
+			name: "key/invalid",
+			data: test.TrimYAML(`
+				path: /app/package.json
+				key: 'foo[bar]'
+			`),
+			errRegex: `^key: "foo\[bar\]" <invalid>.*$`,
+		},
+		{
+			name: "key/text file",
+			data: test.TrimYAML(`
+				path: /app/VERSION
+				key: version
+			`),
+			errRegex: `^key: "version" <invalid>.*$`,
+		},
+		{
+			name: "regex_template, with no regex",
+			data: test.TrimYAML(`
+				path: /app/VERSION
+				regex_template: $1.$2.$3
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "all invalid",
+			data: test.TrimYAML(`
+				path: ''
+				format: bar
+				key: 'foo[bar]'
+				regex: '[0-'
+			`),
+			wantFormat: "bar",
+			errRegex: test.TrimYAML(`
+				^path: <required>.*
+				format: "bar" <invalid>.*
+				key: "[^"]+" <invalid>.*
+				regex: "[^"]+" <invalid>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testLookup(t)
+			input.Key = ""
+			// Apply the YAML.
+			if err := input.ApplyOverrides("yaml", []byte(tc.data)); err != nil {
+				t.Fatalf(
+					"%s\nLookup.ApplyOverrides(%q) failed before Lookup.CheckValues(): %v",
+					packageName, tc.data,
+					err,
+				)
+			}
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				input.CheckValues,
+			)
+
+			// AND: RegexTemplate is empty when Regex is empty.
+			if input.RegexTemplate != "" && input.Regex == "" {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .RegexTemplate should be empty when Regex is empty",
+					packageName,
+				)
+			}
+			// AND: Format is lowercased.
+			if input.Format != tc.wantFormat {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Format mismatch\ngot:  %q\nwant: %q",
+					packageName, input.Format, tc.wantFormat,
+				)
+			}
+		})
+	}
+}
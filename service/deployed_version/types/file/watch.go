@@ -0,0 +1,105 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a file-based lookup type.
+package file
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// watchDebounce is how long to wait after a change to the file before reading it,
+// allowing a writer to finish.
+var watchDebounce = 100 * time.Millisecond
+
+// watch returns a watcher on the directory of the file,
+// or nil if filesystem notifications are unavailable.
+//
+// The directory is watched rather than the file so that
+// files replaced atomically (rename over the original) are still seen.
+func (l *Lookup) watch(logFrom logx.LogFrom) *fsnotify.Watcher {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logx.Verbose(
+			"filesystem notifications unavailable, falling back to polling: "+err.Error(),
+			logFrom, true,
+		)
+		return nil
+	}
+
+	dir := filepath.Dir(l.path())
+	if err := watcher.Add(dir); err != nil {
+		logx.Warn(
+			"failed to watch "+dir+", falling back to polling: "+err.Error(),
+			logFrom, true,
+		)
+		_ = watcher.Close()
+		return nil
+	}
+
+	return watcher
+}
+
+// notify calls trigger whenever the watcher reports a change to the file,
+// until ctx is cancelled.
+func (l *Lookup) notify(ctx context.Context, watcher *fsnotify.Watcher, trigger func(), logFrom logx.LogFrom) {
+	path := l.path()
+	events, errs := watcher.Events, watcher.Errors
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			// Only changes to this file.
+			if filepath.Clean(event.Name) != path ||
+				!event.Has(fsnotify.Create|fsnotify.Write) {
+				continue
+			}
+
+			// Let the writer finish, then skip the events it caused.
+			time.Sleep(watchDebounce)
+			drain(events)
+			trigger()
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			logx.Warn(err, logFrom, true)
+		}
+	}
+}
+
+// drain discards any events currently queued on the channel.
+func drain(events <-chan fsnotify.Event) {
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+func TestLookup_Track(t *testing.T) {
+	// GIVEN: a Lookup on a file.
+	tests := []struct {
+		name        string
+		write       string // name of the file to write in the watched directory.
+		wantTrigger bool
+	}{
+		{
+			name:        "change to the file",
+			write:       "VERSION",
+			wantTrigger: true,
+		},
+		{
+			name:        "change to another file in the directory",
+			write:       "OTHER",
+			wantTrigger: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.Path = writeFile(t, "VERSION", "1.2.3")
+			if watcher := lookup.watch(logx.LogFrom{Primary: tc.name}); watcher == nil {
+				t.Skip("filesystem notifications unavailable")
+			} else {
+				_ = watcher.Close()
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			triggered := make(chan struct{}, 1)
+
+			// WHEN: Track is called, and the directory is written to.
+			poll := lookup.Track(ctx, func() {
+				select {
+				case triggered <- struct{}{}:
+				default:
+				}
+			})
+			time.Sleep(100 * time.Millisecond)
+			_ = os.WriteFile(
+				filepath.Join(filepath.Dir(lookup.Path), tc.write),
+				[]byte("1.2.4"), 0o600,
+			)
+
+			// THEN: the file is still polled on the interval.
+			if !poll {
+				t.Errorf(
+					"%s\nLookup.Track() mismatch\ngot:  %t\nwant: %t",
+					packageName, poll, true,
+				)
+			}
+			// AND: a query is triggered only when the file changed.
+			var gotTrigger bool
+			select {
+			case <-triggered:
+				gotTrigger = true
+			case <-time.After(time.Second):
+			}
+			if gotTrigger != tc.wantTrigger {
+				t.Errorf(
+					"%s\nLookup.Track() triggered=%t, want %t",
+					packageName, gotTrigger, tc.wantTrigger,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a Kubernetes-based lookup type.
+package kubernetes
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/util"
+)
+
+// serviceAccountDir is where the in-cluster service account credentials are mounted.
+var serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// cluster holds the endpoint and credentials of a Kubernetes API server.
+type cluster struct {
+	server     string // URL of the API server.
+	namespace  string // Namespace of the credentials.
+	token      string // Bearer token.
+	username   string // Basic auth username.
+	password   string // Basic auth password.
+	caData     []byte // PEM CA bundle to verify the API server with.
+	certData   []byte // PEM client certificate.
+	keyData    []byte // PEM client key.
+	insecure   bool   // Skip verification of the API server certificate.
+	serverName string // Server name to verify the API server certificate against.
+}
+
+// kubeconfig is the part of a kubeconfig file that is used.
+type kubeconfig struct {
+	CurrentContext string              `yaml:"current-context"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	Users          []kubeconfigUser    `yaml:"users"`
+}
+
+// kubeconfigCluster is a named cluster in a kubeconfig.
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthority     string `yaml:"certificate-authority"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		TLSServerName            string `yaml:"tls-server-name"`
+	} `yaml:"cluster"`
+}
+
+// kubeconfigContext is a named context in a kubeconfig.
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster   string `yaml:"cluster"`
+		User      string `yaml:"user"`
+		Namespace string `yaml:"namespace"`
+	} `yaml:"context"`
+}
+
+// kubeconfigUser is a named user in a kubeconfig.
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Token                 string `yaml:"token"`
+		TokenFile             string `yaml:"tokenFile"`
+		Username              string `yaml:"username"`
+		Password              string `yaml:"password"`
+		ClientCertificate     string `yaml:"client-certificate"`
+		ClientCertificateData string `yaml:"client-certificate-data"`
+		ClientKey             string `yaml:"client-key"`
+		ClientKeyData         string `yaml:"client-key-data"`
+		Exec                  any    `yaml:"exec"`
+		AuthProvider          any    `yaml:"auth-provider"`
+	} `yaml:"user"`
+}
+
+// loadCluster returns the cluster to query, from the kubeconfig at path,
+// or the in-cluster service account if path is empty and running in a cluster.
+func loadCluster(path, context string) (*cluster, error) {
+	if path == "" {
+		if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+			return inCluster()
+		}
+		path = defaultKubeconfig()
+	}
+
+	return fromKubeconfig(path, context)
+}
+
+// defaultKubeconfig returns the first path in KUBECONFIG, or ~/.kube/config.
+func defaultKubeconfig() string {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) != 0 && paths[0] != "" {
+		return paths[0]
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".kube", "config")
+}
+
+// inCluster returns the cluster using the mounted service account credentials.
+func inCluster() (*cluster, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a Kubernetes cluster (KUBERNETES_SERVICE_HOST/KUBERNETES_SERVICE_PORT unset)")
+	}
+
+	token, err := os.ReadFile(filepath.Join(serviceAccountDir, "token"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account token: %w", err)
+	}
+	caData, err := os.ReadFile(filepath.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account CA: %w", err)
+	}
+	namespace, _ := os.ReadFile(filepath.Join(serviceAccountDir, "namespace"))
+
+	return &cluster{
+		server:    "https://" + net.JoinHostPort(host, port),
+		namespace: strings.TrimSpace(string(namespace)),
+		token:     strings.TrimSpace(string(token)),
+		caData:    caData,
+	}, nil
+}
+
+// fromKubeconfig returns the cluster of the context (or current-context) in the kubeconfig at path.
+func fromKubeconfig(path, context string) (*cluster, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+	var config kubeconfig
+	if err := decode.Unmarshal("yaml", content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig %q: %w", path, err)
+	}
+
+	context = util.ValueOr(context, config.CurrentContext)
+	contextIndex := slices.IndexFunc(config.Contexts, func(c kubeconfigContext) bool { return c.Name == context })
+	if contextIndex == -1 {
+		return nil, fmt.Errorf("context %q not found in kubeconfig %q", context, path)
+	}
+	ctx := config.Contexts[contextIndex].Context
+
+	clusterIndex := slices.IndexFunc(config.Clusters, func(c kubeconfigCluster) bool { return c.Name == ctx.Cluster })
+	if clusterIndex == -1 {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig %q", ctx.Cluster, path)
+	}
+	clusterCfg := config.Clusters[clusterIndex].Cluster
+
+	c := &cluster{
+		server:     strings.TrimSuffix(clusterCfg.Server, "/"),
+		namespace:  ctx.Namespace,
+		insecure:   clusterCfg.InsecureSkipTLSVerify,
+		serverName: clusterCfg.TLSServerName,
+	}
+	dir := filepath.Dir(path)
+	if c.caData, err = dataOrFile(clusterCfg.CertificateAuthorityData, clusterCfg.CertificateAuthority, dir); err != nil {
+		return nil, fmt.Errorf("certificate-authority: %w", err)
+	}
+
+	// User is optional.
+	userIndex := slices.IndexFunc(config.Users, func(u kubeconfigUser) bool { return u.Name == ctx.User })
+	if userIndex == -1 {
+		return c, nil
+	}
+	user := config.Users[userIndex].User
+	if user.Exec != nil || user.AuthProvider != nil {
+		return nil, fmt.Errorf("user %q: exec and auth-provider credentials are not supported", ctx.User)
+	}
+	c.token, c.username, c.password = user.Token, user.Username, user.Password
+	if c.token == "" && user.TokenFile != "" {
+		token, err := os.ReadFile(resolvePath(user.TokenFile, dir))
+		if err != nil {
+			return nil, fmt.Errorf("tokenFile: %w", err)
+		}
+		c.token = strings.TrimSpace(string(token))
+	}
+	if c.certData, err = dataOrFile(user.ClientCertificateData, user.ClientCertificate, dir); err != nil {
+		return nil, fmt.Errorf("client-certificate: %w", err)
+	}
+	if c.keyData, err = dataOrFile(user.ClientKeyData, user.ClientKey, dir); err != nil {
+		return nil, fmt.Errorf("client-key: %w", err)
+	}
+
+	return c, nil
+}
+
+// dataOrFile returns the base64-decoded data, or the content of file (relative to dir) if no data.
+func dataOrFile(data, file, dir string) ([]byte, error) {
+	if data != "" {
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64: %w", err)
+		}
+		return decoded, nil
+	}
+	if file == "" {
+		return nil, nil
+	}
+
+	//nolint:wrapcheck
+	return os.ReadFile(resolvePath(file, dir))
+}
+
+// resolvePath returns path relative to dir, unless it is absolute.
+func resolvePath(path, dir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// client returns the *http.Client to use for the cluster.
+func (c *cluster) client() (*http.Client, error) {
+	return httpx.ClientFor( //nolint:wrapcheck
+		httpx.TLSFromPEM(c.caData, c.certData, c.keyData, c.serverName),
+		nil,
+		c.insecure,
+	)
+}
+
+// get decodes the JSON response of a GET request to path on the API server into v.
+func (c *cluster) get(path string, v any) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.server+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, body, err := httpx.Do(client, req)
+	if err != nil {
+		return fmt.Errorf("kubernetes API request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var status struct {
+			Message string `json:"message"`
+		}
+		_ = decode.Unmarshal("json", body, &status)
+		return fmt.Errorf(
+			"non-2XX response code: %d (%s)",
+			resp.StatusCode, util.ValueOr(status.Message, util.TruncateMessage(string(body), 100)),
+		)
+	}
+
+	if err := decode.Unmarshal("json", body, v); err != nil {
+		return fmt.Errorf("failed to unmarshal kubernetes API response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,504 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestFromKubeconfig(t *testing.T) {
+	caData := "-----BEGIN CERTIFICATE-----\nca\n-----END CERTIFICATE-----\n"
+
+	// GIVEN: a kubeconfig.
+	tests := []struct {
+		name     string
+		config   string
+		files    map[string]string
+		context  string
+		want     *cluster
+		errRegex string
+	}{
+		{
+			name: "current-context, token",
+			config: test.TrimYAML(`
+				current-context: b
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+					- name: b
+						cluster:
+							server: https://b.example.com/
+							insecure-skip-tls-verify: true
+							tls-server-name: kubernetes
+				contexts:
+					- name: a
+						context: {cluster: a, user: a}
+					- name: b
+						context: {cluster: b, user: b, namespace: apps}
+				users:
+					- name: a
+						user: {token: token-a}
+					- name: b
+						user: {token: token-b}
+			`),
+			want: &cluster{
+				server:     "https://b.example.com",
+				namespace:  "apps",
+				token:      "token-b",
+				insecure:   true,
+				serverName: "kubernetes",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "chosen context, basic auth",
+			config: test.TrimYAML(`
+				current-context: b
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+				contexts:
+					- name: a
+						context: {cluster: a, user: a}
+				users:
+					- name: a
+						user: {username: admin, password: secret}
+			`),
+			context: "a",
+			want: &cluster{
+				server:   "https://a.example.com",
+				username: "admin",
+				password: "secret",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "data fields",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+							certificate-authority-data: ` + base64.StdEncoding.EncodeToString([]byte(caData)) + `
+				contexts:
+					- name: a
+						context: {cluster: a, user: a}
+				users:
+					- name: a
+						user:
+							client-certificate-data: ` + base64.StdEncoding.EncodeToString([]byte("cert")) + `
+							client-key-data: ` + base64.StdEncoding.EncodeToString([]byte("key")) + `
+			`),
+			want: &cluster{
+				server:   "https://a.example.com",
+				caData:   []byte(caData),
+				certData: []byte("cert"),
+				keyData:  []byte("key"),
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "files relative to the kubeconfig",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+							certificate-authority: ca.crt
+				contexts:
+					- name: a
+						context: {cluster: a, user: a}
+				users:
+					- name: a
+						user:
+							tokenFile: token
+							client-certificate: client.crt
+							client-key: client.key
+			`),
+			files: map[string]string{
+				"ca.crt":     caData,
+				"token":      "token-file\n",
+				"client.crt": "cert",
+				"client.key": "key",
+			},
+			want: &cluster{
+				server:   "https://a.example.com",
+				token:    "token-file",
+				caData:   []byte(caData),
+				certData: []byte("cert"),
+				keyData:  []byte("key"),
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "no user",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+				contexts:
+					- name: a
+						context: {cluster: a}
+			`),
+			want: &cluster{
+				server: "https://a.example.com",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "context not found",
+			config: test.TrimYAML(`
+				current-context: missing
+			`),
+			errRegex: `^context "missing" not found in kubeconfig ".*"$`,
+		},
+		{
+			name: "cluster not found",
+			config: test.TrimYAML(`
+				current-context: a
+				contexts:
+					- name: a
+						context: {cluster: a}
+			`),
+			errRegex: `^cluster "a" not found in kubeconfig ".*"$`,
+		},
+		{
+			name: "exec credentials",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+				contexts:
+					- name: a
+						context: {cluster: a, user: a}
+				users:
+					- name: a
+						user:
+							exec:
+								command: aws
+			`),
+			errRegex: `^user "a": exec and auth-provider credentials are not supported$`,
+		},
+		{
+			name: "missing certificate-authority file",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+							certificate-authority: missing.crt
+				contexts:
+					- name: a
+						context: {cluster: a}
+			`),
+			errRegex: `^certificate-authority:\s+open .*missing\.crt:\s+no such file or directory$`,
+		},
+		{
+			name: "invalid base64",
+			config: test.TrimYAML(`
+				current-context: a
+				clusters:
+					- name: a
+						cluster:
+							server: https://a.example.com
+							certificate-authority-data: '!!'
+				contexts:
+					- name: a
+						context: {cluster: a}
+			`),
+			errRegex: `^certificate-authority:\s+invalid base64:`,
+		},
+		{
+			name:     "invalid yaml",
+			config:   "clusters: [",
+			errRegex: `^failed to parse kubeconfig ".*":`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := filepath.Join(dir, "config")
+			files := map[string]string{"config": tc.config}
+			for name, content := range tc.files {
+				files[name] = content
+			}
+			for name, content := range files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+					t.Fatalf("%s\nfailed to write %q: %v",
+						packageName, name, err)
+				}
+			}
+
+			// WHEN: fromKubeconfig is called.
+			got, err := fromKubeconfig(path, tc.context)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nfromKubeconfig() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			// AND: the cluster is as expected.
+			if tc.want == nil {
+				return
+			}
+			if got.server != tc.want.server ||
+				got.namespace != tc.want.namespace ||
+				got.token != tc.want.token ||
+				got.username != tc.want.username ||
+				got.password != tc.want.password ||
+				string(got.caData) != string(tc.want.caData) ||
+				string(got.certData) != string(tc.want.certData) ||
+				string(got.keyData) != string(tc.want.keyData) ||
+				got.insecure != tc.want.insecure ||
+				got.serverName != tc.want.serverName {
+				t.Errorf(
+					"%s\nfromKubeconfig() mismatch\ngot:  %+v\nwant: %+v",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLoadCluster_InCluster(t *testing.T) {
+	// GIVEN: mounted service account credentials.
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"token":     "sa-token\n",
+		"ca.crt":    "ca",
+		"namespace": "argus\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("%s\nfailed to write %q: %v",
+				packageName, name, err)
+		}
+	}
+	originalDir := serviceAccountDir
+	serviceAccountDir = dir
+	t.Cleanup(func() { serviceAccountDir = originalDir })
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		kubeconfig string
+		wantServer string
+		errRegex   string
+	}{
+		{
+			name: "in-cluster",
+			env: map[string]string{
+				"KUBERNETES_SERVICE_HOST": "10.0.0.1",
+				"KUBERNETES_SERVICE_PORT": "443",
+			},
+			wantServer: "https://10.0.0.1:443",
+			errRegex:   `^$`,
+		},
+		{
+			name: "in-cluster, IPv6",
+			env: map[string]string{
+				"KUBERNETES_SERVICE_HOST": "fd00::1",
+				"KUBERNETES_SERVICE_PORT": "6443",
+			},
+			wantServer: "https://[fd00::1]:6443",
+			errRegex:   `^$`,
+		},
+		{
+			name: "kubeconfig over in-cluster",
+			env: map[string]string{
+				"KUBERNETES_SERVICE_HOST": "10.0.0.1",
+				"KUBERNETES_SERVICE_PORT": "443",
+			},
+			kubeconfig: "/does/not/exist",
+			errRegex:   `^failed to read kubeconfig:`,
+		},
+		{
+			name: "not in-cluster, KUBECONFIG",
+			env: map[string]string{
+				"KUBERNETES_SERVICE_HOST": "",
+				"KUBECONFIG":              "/does/not/exist" + string(os.PathListSeparator) + "/also/missing",
+			},
+			errRegex: `^failed to read kubeconfig:\s+open /does/not/exist:`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// t.Parallel() - Cannot run in parallel since we're using environment variables.
+
+			test.SetEnv(t, tc.env)
+
+			// WHEN: loadCluster is called.
+			got, err := loadCluster(tc.kubeconfig, "")
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nloadCluster() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			if tc.wantServer == "" {
+				return
+			}
+			// AND: the service account credentials are used.
+			if got.server != tc.wantServer || got.token != "sa-token" || got.namespace != "argus" || string(got.caData) != "ca" {
+				t.Errorf(
+					"%s\nloadCluster() mismatch\ngot:  %+v\nwant: server=%q, token=%q, namespace=%q, caData=%q",
+					packageName, got, tc.wantServer, "sa-token", "argus", "ca",
+				)
+			}
+		})
+	}
+}
+
+func TestCluster_Get(t *testing.T) {
+	// GIVEN: a cluster and an API server.
+	server := testAPIServer(t, map[string]string{
+		"/api/v1/namespaces/default": `{"metadata": {"name": "default"}}`,
+	})
+	tests := []struct {
+		name     string
+		token    string
+		path     string
+		errRegex string
+	}{
+		{
+			name:     "success",
+			token:    testToken,
+			path:     "/api/v1/namespaces/default",
+			errRegex: `^$`,
+		},
+		{
+			name:     "unauthorized",
+			token:    "wrong",
+			path:     "/api/v1/namespaces/default",
+			errRegex: `^non-2XX response code: 401 \(Unauthorized\)$`,
+		},
+		{
+			name:     "not found",
+			token:    testToken,
+			path:     "/api/v1/namespaces/missing",
+			errRegex: `^non-2XX response code: 404 \(not found\)$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &cluster{server: server.URL, token: tc.token}
+			var got struct {
+				Metadata struct {
+					Name string `json:"name"`
+				} `json:"metadata"`
+			}
+
+			// WHEN: get is called.
+			err := c.get(tc.path, &got)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\ncluster.get() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			// AND: the response is decoded on success.
+			if err == nil && got.Metadata.Name != "default" {
+				t.Errorf(
+					"%s\ncluster.get() decoded mismatch\ngot:  %q\nwant: %q",
+					packageName, got.Metadata.Name, "default",
+				)
+			}
+		})
+	}
+}
+
+func TestCluster_Client(t *testing.T) {
+	// GIVEN: clusters with different TLS configurations.
+	tests := []struct {
+		name     string
+		cluster  *cluster
+		errRegex string
+	}{
+		{
+			name:     "plain",
+			cluster:  &cluster{server: "https://a.example.com"},
+			errRegex: `^$`,
+		},
+		{
+			name:     "invalid CA",
+			cluster:  &cluster{server: "https://b.example.com", caData: []byte("not a cert")},
+			errRegex: `^no certificates found in the certificate authority$`,
+		},
+		{
+			name:     "invalid client certificate",
+			cluster:  &cluster{server: "https://c.example.com", certData: []byte("cert"), keyData: []byte("key")},
+			errRegex: `^invalid client certificate:`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: client is called twice.
+			first, err := tc.cluster.client()
+			second, _ := tc.cluster.client()
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\ncluster.client() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			// AND: the client is cached.
+			if first != second {
+				t.Errorf(
+					"%s\ncluster.client() returned a new client on the second call",
+					packageName,
+				)
+			}
+		})
+	}
+}
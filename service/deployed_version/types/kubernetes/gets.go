@@ -0,0 +1,64 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a Kubernetes-based lookup type.
+package kubernetes
+
+import (
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/util"
+)
+
+// GetType returns the type of the receiver.
+func (l *Lookup) GetType() string {
+	return Type
+}
+
+// kubeconfig returns the path to the kubeconfig, with environment variables evaluated.
+func (l *Lookup) kubeconfig() string {
+	return util.EvalEnvVars(l.Kubeconfig)
+}
+
+// namespace returns the namespace of the workload,
+// falling back to that of the credentials, then "default".
+func (l *Lookup) namespace(c *cluster) string {
+	return util.FirstNonDefault(
+		util.EvalEnvVars(l.Namespace),
+		c.namespace,
+		"default",
+	)
+}
+
+// kind returns the kind of the workload.
+func (l *Lookup) kind() string {
+	return util.ValueOr(l.Kind, KindDeployment)
+}
+
+// source returns the part of the workload to read the version from.
+func (l *Lookup) source() string {
+	return util.ValueOr(l.Source, SourceTag)
+}
+
+// label returns the label to read the version from.
+func (l *Lookup) label() string {
+	return util.ValueOr(l.Label, DefaultLabel)
+}
+
+// extraction returns the options to extract the version from the workload.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
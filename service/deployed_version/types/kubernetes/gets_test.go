@@ -0,0 +1,94 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_GetType(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+
+	// WHEN: GetType is called on it.
+	got := lookup.GetType()
+
+	// THEN: the type is returned.
+	if got != Type {
+		t.Errorf(
+			"%s\nLookup.GetType() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, Type,
+		)
+	}
+}
+
+func TestLookup_Namespace(t *testing.T) {
+	// GIVEN: a Lookup with a Namespace, and credentials with a namespace.
+	tests := []struct {
+		name                 string
+		env                  map[string]string
+		namespace, clusterNS string
+		want                 string
+	}{
+		{
+			name: "default",
+			want: "default",
+		},
+		{
+			name:      "from credentials",
+			clusterNS: "argus",
+			want:      "argus",
+		},
+		{
+			name:      "set over credentials",
+			namespace: "apps",
+			clusterNS: "argus",
+			want:      "apps",
+		},
+		{
+			name: "from env var",
+			env: map[string]string{
+				"TEST_LOOKUP__DV_KUBERNETES_NAMESPACE_ONE": "env",
+			},
+			namespace: "${TEST_LOOKUP__DV_KUBERNETES_NAMESPACE_ONE}",
+			want:      "env",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.SetEnv(t, tc.env)
+			lookup := testLookup(t)
+			lookup.Namespace = tc.namespace
+
+			// WHEN: namespace is called on it.
+			got := lookup.namespace(&cluster{namespace: tc.clusterNS})
+
+			// THEN: the namespace is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.namespace() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit || integration
+
+package kubernetes
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	logtest "github.com/release-argus/Argus/internal/test/log"
+	"github.com/release-argus/Argus/service/dashboard"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	opt "github.com/release-argus/Argus/service/option"
+	opttest "github.com/release-argus/Argus/service/option/test"
+	"github.com/release-argus/Argus/service/status"
+)
+
+var packageName = "deployedver_kubernetes"
+
+func TestMain(m *testing.M) {
+	// Log.
+	logtest.InitLog()
+
+	// Run other tests.
+	exitCode := m.Run()
+
+	if len(logx.ExitCodeChannel()) > 0 {
+		fmt.Printf("%s\nexit code channel not empty", packageName)
+		exitCode = 1
+	}
+
+	// Exit.
+	os.Exit(exitCode)
+}
+
+func testLookup(t *testing.T) *Lookup {
+	t.Helper()
+
+	// Defaults.
+	dvCfg := plainDefaultsConfig(t)
+	// Options.
+	optCfg := opttest.PlainDefaultsConfig(t)
+	options, _ := opt.Decode(
+		"yaml", []byte("semantic_versioning: true"),
+		optCfg,
+	)
+	// Status.
+	announceChannel := make(chan []byte, 24)
+	saveChannel := make(chan bool, 5)
+	databaseChannel := make(chan dbtype.Message, 5)
+	svcDashboard := &dashboard.Options{}
+	svcStatus := status.New(
+		announceChannel, databaseChannel, saveChannel,
+		"",
+		"", "",
+		"", "",
+		"",
+		svcDashboard,
+	)
+	svcStatus.Init(
+		0, 0, 0,
+		status.ServiceInfo{
+			ID: "kubernetes-testLookup",
+		},
+		svcDashboard,
+	)
+
+	lookup, _ := Decode(
+		"yaml", []byte(test.TrimYAML(`
+			type: kubernetes
+			kubeconfig: /etc/kube/config
+			name: argus
+		`)),
+		options,
+		svcStatus,
+		dvCfg,
+	)
+
+	return lookup
+}
+
+// plainDefaultsConfig returns plain defaults and hardDefaults for testing.
+func plainDefaultsConfig(t *testing.T) base.DefaultsConfig {
+	t.Helper()
+
+	optDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults.Default()
+
+	defaults, _ := base.DecodeDefaults("yaml", nil)
+	defaults.Options = optDefaults
+	hardDefaults, _ := base.DecodeDefaults("yaml", nil)
+	hardDefaults.Default()
+	hardDefaults.Options = optHardDefaults
+
+	return base.DefaultsConfig{
+		Soft: defaults,
+		Hard: hardDefaults,
+	}
+}
+
+// testKubeconfig writes a kubeconfig for server (with a token user) to a temporary directory,
+// returning its path.
+func testKubeconfig(t *testing.T, server string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config")
+	content := test.TrimYAML(`
+		apiVersion: v1
+		kind: Config
+		current-context: test
+		clusters:
+			- name: test
+				cluster:
+					server: ` + server + `
+		contexts:
+			- name: test
+				context:
+					cluster: test
+					user: test
+					namespace: apps
+		users:
+			- name: test
+				user:
+					token: ` + testToken + `
+	`)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("%s\nfailed to write %q: %v",
+			packageName, path, err)
+	}
+
+	return path
+}
+
+// testToken is the bearer token the fake API server of [testAPIServer] accepts.
+var testToken = "argus-token"
+
+// testAPIServer returns a fake Kubernetes API server serving the given objects, keyed by path.
+func testAPIServer(t *testing.T, objects map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind": "Status", "message": "Unauthorized"}`))
+			return
+		}
+
+		body, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"kind": "Status", "message": "not found"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
@@ -0,0 +1,127 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a Kubernetes-based lookup type.
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
+)
+
+// Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
+func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
+	err := l.query(metrics, logFrom)
+
+	if metrics {
+		l.QueryMetrics(l, err)
+	}
+
+	return err
+}
+
+// query gets the workload and updates DeployedVersion if changed and its rollout is complete.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
+	w, err := l.get()
+	if err != nil {
+		logx.Error(err, logFrom, true)
+		return err
+	}
+
+	value, err := l.read(w)
+	if err != nil {
+		logx.Error(err, logFrom, true)
+		return err
+	}
+
+	version, err := l.GetVersion(
+		[]byte(value),
+		l.extraction(),
+		l.kind()+"/"+l.Name,
+		logFrom,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Only count the version as deployed once the rollout has completed.
+	if complete, progress := w.rolloutStatus(l.kind()); !complete {
+		if l.Status.SetDeployedVersionRollout(status.Rollout{Version: version, Progress: progress}) {
+			logx.Info(
+				fmt.Sprintf("Rollout of %q in progress (%s)", version, progress),
+				logFrom,
+				true,
+			)
+			l.Status.AnnounceUpdate()
+		}
+		return nil
+	}
+	rolloutCompleted := l.Status.SetDeployedVersionRollout(status.Rollout{})
+
+	// Set the deployed version if it has changed.
+	previousVersion := l.Status.DeployedVersion()
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+	// Announce a completed rollout that didn't change the deployed version.
+	if rolloutCompleted && l.Status.DeployedVersion() == previousVersion {
+		l.Status.AnnounceUpdate()
+	}
+
+	return nil
+}
+
+// get returns the workload from the API server.
+func (l *Lookup) get() (*workload, error) {
+	c, err := loadCluster(l.kubeconfig(), util.EvalEnvVars(l.Context))
+	if err != nil {
+		return nil, err
+	}
+
+	kind, name := l.kind(), util.EvalEnvVars(l.Name)
+	var w workload
+	if err := c.get(workloadPath(kind, l.namespace(c), name), &w); err != nil {
+		return nil, fmt.Errorf("failed to get %s %q: %w", kind, name, err)
+	}
+
+	return &w, nil
+}
+
+// read returns the image tag or label of the workload.
+func (l *Lookup) read(w *workload) (string, error) {
+	if l.source() == SourceLabel {
+		label := l.label()
+		value := w.label(label)
+		if value == "" {
+			return "", fmt.Errorf("label %q not found on %s %q", label, l.kind(), l.Name)
+		}
+		return value, nil
+	}
+
+	image, err := w.image(l.Container)
+	if err != nil {
+		return "", fmt.Errorf("%s %q: %w", l.kind(), l.Name, err)
+	}
+	_, tag, digest := base.SplitImageReference(image)
+	if tag == "" {
+		// Referenced by digest alone.
+		if digest != "" {
+			return "", fmt.Errorf("image %q of %s %q has no tag", image, l.kind(), l.Name)
+		}
+		tag = "latest"
+	}
+	return tag, nil
+}
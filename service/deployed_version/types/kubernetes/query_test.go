@@ -0,0 +1,255 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestLookup_Query(t *testing.T) {
+	objects := map[string]string{
+		"/apis/apps/v1/namespaces/apps/deployments/argus": `{
+			"metadata": {"generation": 2, "labels": {"app.kubernetes.io/version": "v1.2.3"}},
+			"spec": {
+				"replicas": 2,
+				"template": {
+					"metadata": {"labels": {"build": "build-1.2.4"}},
+					"spec": {"containers": [
+						{"name": "argus", "image": "ghcr.io/release-argus/argus:1.2.3"},
+						{"name": "sidecar", "image": "registry:5000/sidecar:0.1.0"}
+					]}
+				}
+			},
+			"status": {"observedGeneration": 2, "replicas": 2, "updatedReplicas": 2, "availableReplicas": 2}
+		}`,
+		"/apis/apps/v1/namespaces/apps/deployments/rolling": `{
+			"metadata": {"generation": 3},
+			"spec": {
+				"replicas": 3,
+				"template": {"spec": {"containers": [{"name": "app", "image": "app:2.0.0"}]}}
+			},
+			"status": {"observedGeneration": 3, "replicas": 4, "updatedReplicas": 1, "availableReplicas": 3}
+		}`,
+		"/apis/apps/v1/namespaces/other/statefulsets/db": `{
+			"metadata": {"generation": 1},
+			"spec": {
+				"replicas": 1,
+				"template": {"spec": {"containers": [{"name": "db", "image": "postgres@sha256:abc"}]}}
+			},
+			"status": {"observedGeneration": 1, "replicas": 1, "updatedReplicas": 1, "readyReplicas": 1}
+		}`,
+		"/apis/apps/v1/namespaces/apps/daemonsets/agent": `{
+			"metadata": {"generation": 1},
+			"spec": {
+				"template": {"spec": {"containers": [{"name": "agent", "image": "agent:3.1.0"}]}}
+			},
+			"status": {"observedGeneration": 1, "desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberAvailable": 3}
+		}`,
+	}
+
+	// GIVEN: a Lookup on a Kubernetes workload.
+	tests := []struct {
+		name                        string
+		overrides, optionsOverrides string
+		startDeployedVersion        string
+		startRollout                status.Rollout
+		errRegex                    string
+		wantVersion                 string
+		wantRollout                 status.Rollout
+	}{
+		{
+			name:        "deployment/first container tag",
+			overrides:   `name: argus`,
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "deployment/named container tag",
+			overrides: test.TrimYAML(`
+				name: argus
+				container: sidecar
+			`),
+			wantVersion: `^0\.1\.0$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "deployment/container not found",
+			overrides: test.TrimYAML(`
+				name: argus
+				container: missing
+			`),
+			errRegex: `^deployment "argus":\s+container "missing" not found$`,
+		},
+		{
+			name: "deployment/workload label",
+			overrides: test.TrimYAML(`
+				name: argus
+				source: label
+				regex: 'v(.+)'
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "deployment/pod template label",
+			overrides: test.TrimYAML(`
+				name: argus
+				source: label
+				label: build
+				regex: 'build-(.+)'
+			`),
+			wantVersion: `^1\.2\.4$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "deployment/label not found",
+			overrides: test.TrimYAML(`
+				name: argus
+				source: label
+				label: missing
+			`),
+			errRegex: `^label "missing" not found on deployment "argus"$`,
+		},
+		{
+			name:                 "deployment/rollout in progress",
+			overrides:            `name: rolling`,
+			startDeployedVersion: "1.0.0",
+			wantVersion:          `^1\.0\.0$`,
+			wantRollout: status.Rollout{
+				Version:  "2.0.0",
+				Progress: "1 of 3 replicas updated",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name:                 "deployment/rollout completed",
+			overrides:            `name: argus`,
+			startDeployedVersion: "1.0.0",
+			startRollout: status.Rollout{
+				Version:  "1.2.3",
+				Progress: "1 of 2 replicas updated",
+			},
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "statefulset/namespace override, pinned by digest",
+			overrides: test.TrimYAML(`
+				namespace: other
+				kind: statefulset
+				name: db
+			`),
+			errRegex: `^image "postgres@sha256:abc" of statefulset "db" has no tag$`,
+		},
+		{
+			name: "daemonset",
+			overrides: test.TrimYAML(`
+				kind: daemonset
+				name: agent
+			`),
+			wantVersion: `^3\.1\.0$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:      "not found",
+			overrides: `name: missing`,
+			errRegex:  `^failed to get deployment "missing":\s+non-2XX response code: 404 \(not found\)$`,
+		},
+		{
+			name: "unknown context",
+			overrides: test.TrimYAML(`
+				name: argus
+				context: missing
+			`),
+			errRegex: `^context "missing" not found in kubeconfig ".*"$`,
+		},
+		{
+			name: "want semantic versioning but get non-semantic version",
+			overrides: test.TrimYAML(`
+				name: argus
+				source: label
+				label: build
+			`),
+			optionsOverrides: `semantic_versioning: true`,
+			errRegex:         `failed to convert "build-1\.2\.4" to a semantic version`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testAPIServer(t, objects)
+			dvl := testLookup(t)
+			dvl.Kubeconfig = testKubeconfig(t, server.URL)
+			if err := dvl.ApplyOverrides("yaml", []byte(tc.overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+			if tc.optionsOverrides != "" {
+				if err := decode.Unmarshal("yaml", []byte(tc.optionsOverrides), dvl.Options); err != nil {
+					t.Fatalf(
+						"%s\nfailed to unmarshal Lookup.Options overrides: %s",
+						packageName, err,
+					)
+				}
+			}
+			if tc.startDeployedVersion != "" {
+				dvl.Status.SetDeployedVersion(tc.startDeployedVersion, "", false)
+			}
+			dvl.Status.SetDeployedVersionRollout(tc.startRollout)
+
+			// WHEN: Query is called on it.
+			err := dvl.Query(true, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version matches the expected regex.
+			if tc.wantVersion != "" {
+				if version := dvl.Status.DeployedVersion(); !util.RegexCheck(tc.wantVersion, version) {
+					t.Errorf(
+						"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant %q",
+						packageName, version, tc.wantVersion,
+					)
+				}
+			}
+			// AND: the rollout is as expected.
+			if rollout := dvl.Status.DeployedVersionRollout(); rollout != tc.wantRollout {
+				t.Errorf(
+					"%s\nLookup.Query() .DeployedVersionRollout() mismatch\ngot:  %+v\nwant: %+v",
+					packageName, rollout, tc.wantRollout,
+				)
+			}
+		})
+	}
+}
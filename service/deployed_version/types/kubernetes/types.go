@@ -0,0 +1,111 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a Kubernetes-based lookup type.
+package kubernetes
+
+import (
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// #############
+// # CONSTANTS #
+// #############
+
+// Type is the lookup type identifier for Kubernetes deployed version lookups.
+var Type = "kubernetes"
+
+// DefaultLabel is the workload label read when source is label and no label is given.
+const DefaultLabel = "app.kubernetes.io/version"
+
+// Kind* are the workload kinds that can be looked up.
+const (
+	KindDeployment  = "deployment"
+	KindStatefulSet = "statefulset"
+	KindDaemonSet   = "daemonset"
+)
+
+// SupportedKinds lists the workload kinds allowed for Kubernetes deployed version lookups.
+var SupportedKinds = []string{KindDeployment, KindStatefulSet, KindDaemonSet}
+
+// Source* are the parts of the workload that the version can be read from.
+const (
+	SourceTag   = "tag"
+	SourceLabel = "label"
+)
+
+// SupportedSources lists the sources allowed for Kubernetes deployed version lookups.
+var SupportedSources = []string{SourceTag, SourceLabel}
+
+// #########
+// # TYPES #
+// #########
+
+// Lookup is a Kubernetes-based lookup type.
+type Lookup struct {
+	base.Lookup `json:",inline" yaml:",inline"`
+
+	Kubeconfig    string `json:"kubeconfig,omitzero" yaml:"kubeconfig,omitzero"`         // OPTIONAL: path to a kubeconfig, in-cluster credentials are used if empty.
+	Context       string `json:"context,omitzero" yaml:"context,omitzero"`               // OPTIONAL: kubeconfig context to use, defaults to current-context.
+	Namespace     string `json:"namespace,omitzero" yaml:"namespace,omitzero"`           // OPTIONAL: namespace of the workload.
+	Kind          string `json:"kind,omitzero" yaml:"kind,omitzero"`                     // OPTIONAL: kind of the workload (deployment|statefulset|daemonset).
+	Name          string `json:"name,omitzero" yaml:"name,omitzero"`                     // REQUIRED: name of the workload.
+	Container     string `json:"container,omitzero" yaml:"container,omitzero"`           // OPTIONAL: name of the container, defaults to the first.
+	Source        string `json:"source,omitzero" yaml:"source,omitzero"`                 // OPTIONAL: part of the workload to read the version from (tag|label).
+	Label         string `json:"label,omitzero" yaml:"label,omitzero"`                   // OPTIONAL: label to read the version from when source is label.
+	Regex         string `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
+	RegexTemplate string `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+}
+
+// #############
+// # STRINGIFY #
+// #############
+
+// String returns a string representation of the receiver.
+func (l *Lookup) String(prefix string) string {
+	return decode.ToYAMLString(l, prefix)
+}
+
+// #########
+// # STATE #
+// #########
+
+// Copy returns a deep copy of the receiver.
+func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
+	if l == nil {
+		return nil
+	}
+
+	return &Lookup{
+		Lookup:        *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		Kubeconfig:    l.Kubeconfig,
+		Context:       l.Context,
+		Namespace:     l.Namespace,
+		Kind:          l.Kind,
+		Name:          l.Name,
+		Container:     l.Container,
+		Source:        l.Source,
+		Label:         l.Label,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
+
+// InheritSecrets is a no-op as the credentials of a Kubernetes lookup are read from files.
+func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
+	// Nothing to inherit.
+}
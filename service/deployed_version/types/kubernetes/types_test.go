@@ -0,0 +1,86 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_String(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+	lookup.Kind = KindDaemonSet
+	lookup.Source = SourceLabel
+	lookup.Label = "app.kubernetes.io/version"
+
+	// WHEN: String is called on it.
+	got := lookup.String("")
+
+	// THEN: it is stringified as expected.
+	want := test.TrimYAML(`
+		type: kubernetes
+		kubeconfig: /etc/kube/config
+		kind: daemonset
+		name: argus
+		source: label
+		label: app.kubernetes.io/version
+	`)
+	if got != want {
+		t.Errorf(
+			"%s\nLookup.String() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, want,
+		)
+	}
+}
+
+func TestLookup_Copy(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+	lookup.Namespace = "apps"
+	lookup.Container = "argus"
+	lookup.Regex = "([0-9.]+)"
+	lookup.RegexTemplate = "v$1"
+
+	// WHEN: Copy is called on it.
+	got, ok := lookup.Copy(lookup.Status).(*Lookup)
+	if !ok {
+		t.Fatalf(
+			"%s\nLookup.Copy() returned %T, want *Lookup",
+			packageName, got,
+		)
+	}
+
+	// THEN: the copy matches the original.
+	if gotStr, wantStr := got.String(""), lookup.String(""); gotStr != wantStr {
+		t.Errorf(
+			"%s\nLookup.Copy() mismatch\ngot:  %q\nwant: %q",
+			packageName, gotStr, wantStr,
+		)
+	}
+
+	// WHEN: Copy is called on a nil Lookup.
+	var nilLookup *Lookup
+	// THEN: nil is returned.
+	if got := nilLookup.Copy(nil); got != nil {
+		t.Errorf(
+			"%s\nLookup.Copy() on nil mismatch\ngot:  %v\nwant: nil",
+			packageName, got,
+		)
+	}
+}
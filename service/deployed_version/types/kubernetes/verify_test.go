@@ -0,0 +1,150 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_CheckValues(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name              string
+		data              string
+		wantKind, wantSrc string
+		wantLabel         string
+		errRegex          string
+	}{
+		{
+			name: "valid",
+			data: test.TrimYAML(`
+				name: argus
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "kind/case insensitive",
+			data: test.TrimYAML(`
+				kind: StatefulSet
+				name: argus
+			`),
+			wantKind: KindStatefulSet,
+			errRegex: `^$`,
+		},
+		{
+			name: "kind/invalid",
+			data: test.TrimYAML(`
+				kind: cronjob
+				name: argus
+			`),
+			wantKind: "cronjob",
+			errRegex: `^kind: "cronjob" <invalid>.*$`,
+		},
+		{
+			name: "name/empty",
+			data: test.TrimYAML(`
+				name: ''
+			`),
+			errRegex: `^name: <required>.*$`,
+		},
+		{
+			name: "source/label kept",
+			data: test.TrimYAML(`
+				name: argus
+				source: Label
+				label: app.kubernetes.io/version
+			`),
+			wantSrc:   SourceLabel,
+			wantLabel: "app.kubernetes.io/version",
+			errRegex:  `^$`,
+		},
+		{
+			name: "source/label removed when reading the tag",
+			data: test.TrimYAML(`
+				name: argus
+				label: app.kubernetes.io/version
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "source/invalid",
+			data: test.TrimYAML(`
+				name: argus
+				source: digest
+			`),
+			wantSrc:  "digest",
+			errRegex: `^source: "digest" <invalid>.*$`,
+		},
+		{
+			name: "all invalid",
+			data: test.TrimYAML(`
+				kind: job
+				name: ''
+				source: foo
+				regex: '[0-'
+			`),
+			wantKind: "job",
+			wantSrc:  "foo",
+			errRegex: test.TrimYAML(`
+				^kind: "job" <invalid>.*
+				name: <required>.*
+				source: "foo" <invalid>.*
+				regex: "[^"]+" <invalid>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testLookup(t)
+			// Apply the YAML.
+			if err := input.ApplyOverrides("yaml", []byte(tc.data)); err != nil {
+				t.Fatalf(
+					"%s\nLookup.ApplyOverrides(%q) failed before Lookup.CheckValues(): %v",
+					packageName, tc.data,
+					err,
+				)
+			}
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				input.CheckValues,
+			)
+
+			// AND: Kind/Source are lowercased.
+			if input.Kind != tc.wantKind || input.Source != tc.wantSrc {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Kind/.Source mismatch\ngot:  %q/%q\nwant: %q/%q",
+					packageName, input.Kind, input.Source, tc.wantKind, tc.wantSrc,
+				)
+			}
+			// AND: Label is only kept when reading a label.
+			if input.Label != tc.wantLabel {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .Label mismatch\ngot:  %q\nwant: %q",
+					packageName, input.Label, tc.wantLabel,
+				)
+			}
+		})
+	}
+}
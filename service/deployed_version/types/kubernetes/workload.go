@@ -0,0 +1,153 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a Kubernetes-based lookup type.
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// resources maps a workload kind to its apps/v1 resource name.
+var resources = map[string]string{
+	KindDeployment:  "deployments",
+	KindStatefulSet: "statefulsets",
+	KindDaemonSet:   "daemonsets",
+}
+
+// workload is the part of an apps/v1 Deployment, StatefulSet or DaemonSet that is used.
+type workload struct {
+	Metadata struct {
+		Generation int64             `json:"generation"`
+		Labels     map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas *int32 `json:"replicas"`
+		Template struct {
+			Metadata struct {
+				Labels map[string]string `json:"labels"`
+			} `json:"metadata"`
+			Spec struct {
+				Containers []workloadContainer `json:"containers"`
+			} `json:"spec"`
+		} `json:"template"`
+	} `json:"spec"`
+	Status struct {
+		ObservedGeneration int64 `json:"observedGeneration"`
+		// Deployment/StatefulSet.
+		Replicas          int32  `json:"replicas"`
+		UpdatedReplicas   int32  `json:"updatedReplicas"`
+		ReadyReplicas     int32  `json:"readyReplicas"`
+		AvailableReplicas int32  `json:"availableReplicas"`
+		CurrentRevision   string `json:"currentRevision"`
+		UpdateRevision    string `json:"updateRevision"`
+		// DaemonSet.
+		DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+		UpdatedNumberScheduled int32 `json:"updatedNumberScheduled"`
+		NumberAvailable        int32 `json:"numberAvailable"`
+	} `json:"status"`
+}
+
+// workloadContainer is a container in the pod template of a workload.
+type workloadContainer struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+// workloadPath returns the API path of the workload.
+func workloadPath(kind, namespace, name string) string {
+	return fmt.Sprintf(
+		"/apis/apps/v1/namespaces/%s/%s/%s",
+		url.PathEscape(namespace), resources[kind], url.PathEscape(name),
+	)
+}
+
+// replicas returns the desired number of replicas.
+func (w *workload) replicas() int32 {
+	if w.Spec.Replicas == nil {
+		return 1
+	}
+	return *w.Spec.Replicas
+}
+
+// rolloutStatus returns whether the latest rollout of the workload is complete,
+// and a description of its progress if not.
+func (w *workload) rolloutStatus(kind string) (bool, string) {
+	if w.Status.ObservedGeneration < w.Metadata.Generation {
+		return false, "waiting for the rollout to be observed"
+	}
+
+	switch kind {
+	case KindDaemonSet:
+		desired := w.Status.DesiredNumberScheduled
+		if w.Status.UpdatedNumberScheduled < desired {
+			return false, fmt.Sprintf("%d of %d pods updated", w.Status.UpdatedNumberScheduled, desired)
+		}
+		if w.Status.NumberAvailable < desired {
+			return false, fmt.Sprintf("%d of %d updated pods available", w.Status.NumberAvailable, desired)
+		}
+	case KindStatefulSet:
+		replicas := w.replicas()
+		if w.Status.UpdatedReplicas < replicas {
+			return false, fmt.Sprintf("%d of %d pods updated", w.Status.UpdatedReplicas, replicas)
+		}
+		if w.Status.ReadyReplicas < replicas {
+			return false, fmt.Sprintf("%d of %d pods ready", w.Status.ReadyReplicas, replicas)
+		}
+		if w.Status.UpdateRevision != w.Status.CurrentRevision {
+			return false, fmt.Sprintf("waiting for revision %q", w.Status.UpdateRevision)
+		}
+	default:
+		replicas := w.replicas()
+		if w.Status.UpdatedReplicas < replicas {
+			return false, fmt.Sprintf("%d of %d replicas updated", w.Status.UpdatedReplicas, replicas)
+		}
+		if w.Status.Replicas > w.Status.UpdatedReplicas {
+			return false, fmt.Sprintf("%d old replicas pending termination", w.Status.Replicas-w.Status.UpdatedReplicas)
+		}
+		if w.Status.AvailableReplicas < w.Status.UpdatedReplicas {
+			return false, fmt.Sprintf("%d of %d updated replicas available", w.Status.AvailableReplicas, w.Status.UpdatedReplicas)
+		}
+	}
+
+	return true, ""
+}
+
+// image returns the image of the named container, or the first container if name is empty.
+func (w *workload) image(name string) (string, error) {
+	containers := w.Spec.Template.Spec.Containers
+	if name == "" {
+		if len(containers) == 0 {
+			return "", errors.New("no containers found")
+		}
+		return containers[0].Image, nil
+	}
+
+	for _, container := range containers {
+		if container.Name == name {
+			return container.Image, nil
+		}
+	}
+	return "", fmt.Errorf("container %q not found", name)
+}
+
+// label returns the value of the label on the workload, falling back to its pod template.
+func (w *workload) label(key string) string {
+	if value := w.Metadata.Labels[key]; value != "" {
+		return value
+	}
+	return w.Spec.Template.Metadata.Labels[key]
+}
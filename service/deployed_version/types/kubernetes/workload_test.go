@@ -0,0 +1,175 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestWorkload_RolloutStatus(t *testing.T) {
+	// GIVEN: a workload.
+	tests := []struct {
+		name         string
+		kind         string
+		workload     string
+		wantComplete bool
+		wantProgress string
+	}{
+		{
+			name: "not yet observed",
+			kind: KindDeployment,
+			workload: `{
+				"metadata": {"generation": 2},
+				"status": {"observedGeneration": 1}
+			}`,
+			wantProgress: `^waiting for the rollout to be observed$`,
+		},
+		{
+			name: "deployment/complete",
+			kind: KindDeployment,
+			workload: `{
+				"metadata": {"generation": 1},
+				"spec": {"replicas": 2},
+				"status": {"observedGeneration": 1, "replicas": 2, "updatedReplicas": 2, "availableReplicas": 2}
+			}`,
+			wantComplete: true,
+			wantProgress: `^$`,
+		},
+		{
+			name: "deployment/default of one replica",
+			kind: KindDeployment,
+			workload: `{
+				"status": {"replicas": 1, "updatedReplicas": 1, "availableReplicas": 1}
+			}`,
+			wantComplete: true,
+			wantProgress: `^$`,
+		},
+		{
+			name: "deployment/scaled to zero",
+			kind: KindDeployment,
+			workload: `{
+				"spec": {"replicas": 0}
+			}`,
+			wantComplete: true,
+			wantProgress: `^$`,
+		},
+		{
+			name: "deployment/updating",
+			kind: KindDeployment,
+			workload: `{
+				"spec": {"replicas": 3},
+				"status": {"replicas": 3, "updatedReplicas": 1, "availableReplicas": 3}
+			}`,
+			wantProgress: `^1 of 3 replicas updated$`,
+		},
+		{
+			name: "deployment/old replicas",
+			kind: KindDeployment,
+			workload: `{
+				"spec": {"replicas": 2},
+				"status": {"replicas": 3, "updatedReplicas": 2, "availableReplicas": 2}
+			}`,
+			wantProgress: `^1 old replicas pending termination$`,
+		},
+		{
+			name: "deployment/unavailable",
+			kind: KindDeployment,
+			workload: `{
+				"spec": {"replicas": 2},
+				"status": {"replicas": 2, "updatedReplicas": 2, "availableReplicas": 1}
+			}`,
+			wantProgress: `^1 of 2 updated replicas available$`,
+		},
+		{
+			name: "statefulset/complete",
+			kind: KindStatefulSet,
+			workload: `{
+				"spec": {"replicas": 2},
+				"status": {"updatedReplicas": 2, "readyReplicas": 2, "currentRevision": "a", "updateRevision": "a"}
+			}`,
+			wantComplete: true,
+			wantProgress: `^$`,
+		},
+		{
+			name: "statefulset/not ready",
+			kind: KindStatefulSet,
+			workload: `{
+				"spec": {"replicas": 2},
+				"status": {"updatedReplicas": 2, "readyReplicas": 1}
+			}`,
+			wantProgress: `^1 of 2 pods ready$`,
+		},
+		{
+			name: "statefulset/revision",
+			kind: KindStatefulSet,
+			workload: `{
+				"spec": {"replicas": 1},
+				"status": {"updatedReplicas": 1, "readyReplicas": 1, "currentRevision": "a", "updateRevision": "b"}
+			}`,
+			wantProgress: `^waiting for revision "b"$`,
+		},
+		{
+			name: "daemonset/complete",
+			kind: KindDaemonSet,
+			workload: `{
+				"status": {"desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberAvailable": 3}
+			}`,
+			wantComplete: true,
+			wantProgress: `^$`,
+		},
+		{
+			name: "daemonset/updating",
+			kind: KindDaemonSet,
+			workload: `{
+				"status": {"desiredNumberScheduled": 3, "updatedNumberScheduled": 2, "numberAvailable": 3}
+			}`,
+			wantProgress: `^2 of 3 pods updated$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var w workload
+			if err := decode.Unmarshal("json", []byte(tc.workload), &w); err != nil {
+				t.Fatalf("%s\nfailed to unmarshal workload: %v",
+					packageName, err)
+			}
+
+			// WHEN: rolloutStatus is called on it.
+			complete, progress := w.rolloutStatus(tc.kind)
+
+			// THEN: the rollout status is as expected.
+			if complete != tc.wantComplete {
+				t.Errorf(
+					"%s\nworkload.rolloutStatus(%q) complete mismatch\ngot:  %t\nwant: %t",
+					packageName, tc.kind, complete, tc.wantComplete,
+				)
+			}
+			if !util.RegexCheck(tc.wantProgress, progress) {
+				t.Errorf(
+					"%s\nworkload.rolloutStatus(%q) progress mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.kind, progress, tc.wantProgress,
+				)
+			}
+		})
+	}
+}
@@ -16,12 +16,19 @@
 package manual
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/release-argus/Argus/internal/logx"
 )
 
+// RateLimit is the minimum gap between rate-limited manual version updates.
+const RateLimit = time.Second
+
+// ErrRateLimited is returned when a manual version update is within the RateLimit of the previous one.
+var ErrRateLimited = errors.New("manual version updates are rate-limited. Please try again in 1 second")
+
 // applyOptions controls how a pending Version is applied.
 type applyOptions struct {
 	writeToDB bool // Persist the version to the database.
@@ -30,9 +37,10 @@ type applyOptions struct {
 }
 
 // Track applies any version given in the config.
-// A manual lookup has nothing to poll, so this runs once and returns.
-func (l *Lookup) Track() {
+// A manual lookup has nothing to poll, so this returns false.
+func (l *Lookup) Track(_ context.Context, _ func()) bool {
 	l.ApplyConfiguredVersion()
+	return false
 }
 
 // ApplyConfiguredVersion applies any version given in the config to the Status,
@@ -79,8 +87,8 @@ func (l *Lookup) apply(opts applyOptions, logFrom logx.LogFrom) (bool, error) {
 
 	if opts.rateLimit {
 		lastQueriedAt, _ := time.Parse(time.RFC3339, l.Status.DeployedVersionTimestamp())
-		if time.Since(lastQueriedAt) < time.Second {
-			return false, errors.New("manual version updates are rate-limited. Please try again in 1 second")
+		if time.Since(lastQueriedAt) < RateLimit {
+			return false, ErrRateLimited
 		}
 	}
 
@@ -17,6 +17,7 @@
 package manual
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -30,20 +31,21 @@ import (
 func TestLookup_Track(t *testing.T) {
 	// GIVEN: a Lookup.
 	lookup := testLookup(t, "1.2.3")
-	didFinish := make(chan bool, 1)
+	var triggered bool
 
 	// WHEN: Track is called on it.
-	go func() {
-		lookup.Track()
-		didFinish <- true
-	}()
-	time.Sleep(10 * time.Millisecond)
+	poll := lookup.Track(context.Background(), func() { triggered = true })
 
 	prefix := fmt.Sprintf("%s\nLookup.Track()", packageName)
 
-	// THEN: the function exits straight away.
-	if len(didFinish) == 0 {
-		t.Fatalf("%s should have exited immediately", prefix)
+	// THEN: it has nothing to poll.
+	if poll {
+		t.Errorf("%s mismatch\ngot:  %t\nwant: %t",
+			prefix, poll, false)
+	}
+	// AND: nothing triggers a query.
+	if triggered {
+		t.Errorf("%s should not have triggered a query", prefix)
 	}
 
 	// AND: the configured version was applied.
@@ -0,0 +1,80 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a Prometheus metrics-based lookup type.
+package prometheus
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/deployed_version/types/web"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/util"
+)
+
+// GetType returns the type of the receiver.
+func (l *Lookup) GetType() string {
+	return Type
+}
+
+// url returns the URL of the metrics endpoint.
+func (l *Lookup) url() string {
+	return util.EvalEnvVars(l.URL)
+}
+
+// versionLabel returns the label to read the version from.
+func (l *Lookup) versionLabel() string {
+	return util.ValueOr(l.VersionLabel, DefaultVersionLabel)
+}
+
+// request returns a [web.Lookup] for the metrics endpoint,
+// sharing its auth, headers, TLS and proxy handling.
+func (l *Lookup) request() *web.Lookup {
+	headers := l.Headers
+	if !l.hasHeader("Accept") {
+		headers = append(headers.Copy(), shared.Header{Key: "Accept", Value: accept})
+	}
+
+	return &web.Lookup{
+		Lookup:            l.Lookup,
+		Method:            http.MethodGet,
+		URL:               l.URL,
+		AllowInvalidCerts: l.AllowInvalidCerts,
+		TLS:               l.TLS,
+		Proxy:             l.Proxy,
+		BasicAuth:         l.BasicAuth,
+		OAuth2:            l.OAuth2,
+		Headers:           headers,
+	}
+}
+
+// hasHeader reports whether a header with the given key is configured.
+func (l *Lookup) hasHeader(key string) bool {
+	for _, header := range l.Headers {
+		if strings.EqualFold(util.EvalEnvVars(header.Key), key) {
+			return true
+		}
+	}
+	return false
+}
+
+// extraction returns the options to extract the version from the label value.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package prometheus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/service/shared"
+)
+
+func TestLookup_GetType(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+
+	// WHEN: GetType is called on it.
+	got := lookup.GetType()
+
+	// THEN: the type is returned.
+	if got != Type {
+		t.Errorf(
+			"%s\nLookup.GetType() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, Type,
+		)
+	}
+}
+
+func TestLookup_URL(t *testing.T) {
+	// GIVEN: a Lookup with a URL containing an env var.
+	test.SetEnv(t, map[string]string{
+		"TEST_LOOKUP__DV_PROMETHEUS_URL_ONE": "prometheus",
+	})
+	lookup := testLookup(t)
+	lookup.URL = "http://${TEST_LOOKUP__DV_PROMETHEUS_URL_ONE}:9090/metrics"
+
+	// WHEN: url is called on it.
+	got := lookup.url()
+
+	// THEN: the env var is evaluated.
+	if want := "http://prometheus:9090/metrics"; got != want {
+		t.Errorf(
+			"%s\nLookup.url() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, want,
+		)
+	}
+}
+
+func TestLookup_VersionLabel(t *testing.T) {
+	// GIVEN: a Lookup with a VersionLabel.
+	tests := []struct {
+		name         string
+		versionLabel string
+		want         string
+	}{
+		{
+			name: "default",
+			want: DefaultVersionLabel,
+		},
+		{
+			name:         "set",
+			versionLabel: "revision",
+			want:         "revision",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.VersionLabel = tc.versionLabel
+
+			// WHEN: versionLabel is called on it.
+			got := lookup.versionLabel()
+
+			// THEN: the label is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nLookup.versionLabel() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Request(t *testing.T) {
+	// GIVEN: a Lookup with Headers.
+	tests := []struct {
+		name    string
+		headers shared.Headers
+		want    shared.Headers
+	}{
+		{
+			name: "Accept added",
+			headers: shared.Headers{
+				{Key: "X-Test", Value: "value"},
+			},
+			want: shared.Headers{
+				{Key: "X-Test", Value: "value"},
+				{Key: "Accept", Value: accept},
+			},
+		},
+		{
+			name: "Accept kept",
+			headers: shared.Headers{
+				{Key: "accept", Value: "text/plain"},
+			},
+			want: shared.Headers{
+				{Key: "accept", Value: "text/plain"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t)
+			lookup.Headers = tc.headers
+			lookup.OAuth2 = &httpx.OAuth2{TokenURL: "https://example.com/token", ClientID: "id"}
+
+			// WHEN: request is called on it.
+			got := lookup.request()
+
+			// THEN: it is a GET of the URL.
+			if got.Method != http.MethodGet || got.URL != lookup.URL {
+				t.Errorf(
+					"%s\nLookup.request() mismatch\ngot:  %s %s\nwant: %s %s",
+					packageName, got.Method, got.URL, http.MethodGet, lookup.URL,
+				)
+			}
+			// AND: the OAuth2 credentials are passed through.
+			if got.OAuth2 != lookup.OAuth2 {
+				t.Errorf(
+					"%s\nLookup.request() .OAuth2 mismatch\ngot:  %v\nwant: %v",
+					packageName, got.OAuth2, lookup.OAuth2,
+				)
+			}
+			// AND: the headers are as expected.
+			if len(got.Headers) != len(tc.want) {
+				t.Fatalf(
+					"%s\nLookup.request() .Headers mismatch\ngot:  %v\nwant: %v",
+					packageName, got.Headers, tc.want,
+				)
+			}
+			for i := range tc.want {
+				if got.Headers[i] != tc.want[i] {
+					t.Errorf(
+						"%s\nLookup.request() .Headers[%d] mismatch\ngot:  %v\nwant: %v",
+						packageName, i, got.Headers[i], tc.want[i],
+					)
+				}
+			}
+			// AND: the Lookup's Headers are unchanged.
+			if len(lookup.Headers) != len(tc.headers) {
+				t.Errorf(
+					"%s\nLookup.request() modified the Lookup .Headers\ngot:  %v\nwant: %v",
+					packageName, lookup.Headers, tc.headers,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit || integration
+
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	logtest "github.com/release-argus/Argus/internal/test/log"
+	"github.com/release-argus/Argus/service/dashboard"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	opt "github.com/release-argus/Argus/service/option"
+	opttest "github.com/release-argus/Argus/service/option/test"
+	"github.com/release-argus/Argus/service/status"
+)
+
+var packageName = "deployedver_prometheus"
+
+func TestMain(m *testing.M) {
+	// Log.
+	logtest.InitLog()
+
+	// Run other tests.
+	exitCode := m.Run()
+
+	if len(logx.ExitCodeChannel()) > 0 {
+		fmt.Printf("%s\nexit code channel not empty", packageName)
+		exitCode = 1
+	}
+
+	// Exit.
+	os.Exit(exitCode)
+}
+
+func testLookup(t *testing.T) *Lookup {
+	t.Helper()
+
+	// Defaults.
+	dvCfg := plainDefaultsConfig(t)
+	// Options.
+	optCfg := opttest.PlainDefaultsConfig(t)
+	options, _ := opt.Decode(
+		"yaml", []byte("semantic_versioning: true"),
+		optCfg,
+	)
+	// Status.
+	announceChannel := make(chan []byte, 24)
+	saveChannel := make(chan bool, 5)
+	databaseChannel := make(chan dbtype.Message, 5)
+	svcDashboard := &dashboard.Options{}
+	svcStatus := status.New(
+		announceChannel, databaseChannel, saveChannel,
+		"",
+		"", "",
+		"", "",
+		"",
+		svcDashboard,
+	)
+	svcStatus.Init(
+		0, 0, 0,
+		status.ServiceInfo{
+			ID: "prometheus-testLookup",
+		},
+		svcDashboard,
+	)
+
+	lookup, _ := Decode(
+		"yaml", []byte(test.TrimYAML(`
+			type: prometheus
+			url: http://localhost:9090/metrics
+			metric: argus_build_info
+		`)),
+		options,
+		svcStatus,
+		dvCfg,
+	)
+
+	return lookup
+}
+
+// plainDefaultsConfig returns plain defaults and hardDefaults for testing.
+func plainDefaultsConfig(t *testing.T) base.DefaultsConfig {
+	t.Helper()
+
+	optDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults, _ := opt.DecodeDefaults("yaml", nil)
+	optHardDefaults.Default()
+
+	defaults, _ := base.DecodeDefaults("yaml", nil)
+	defaults.Options = optDefaults
+	hardDefaults, _ := base.DecodeDefaults("yaml", nil)
+	hardDefaults.Default()
+	hardDefaults.Options = optHardDefaults
+
+	return base.DefaultsConfig{
+		Soft: defaults,
+		Hard: hardDefaults,
+	}
+}
+
+// testMetrics is a metrics body in the Prometheus text exposition format.
+var testMetrics = `# HELP go_goroutines Number of goroutines that currently exist.
+# TYPE go_goroutines gauge
+go_goroutines 42
+# HELP argus_build_info A metric with a constant '1' value labeled by version, revision, branch, and goversion.
+# TYPE argus_build_info gauge
+argus_build_info{branch="main",goversion="go1.26.6",instance="a",revision="abc",version="1.2.3"} 1
+argus_build_info{branch="main",goversion="go1.26.6",instance="b",revision="def",version="v1.2.4"} 1
+argus_build_info_extra{version="9.9.9"} 1
+`
+
+// testMetricsServer returns a server serving body, requiring the given basic auth and header if set.
+func testMetricsServer(t *testing.T, body string, username, password, headerKey, headerValue string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username != "" {
+			if gotUsername, gotPassword, _ := r.BasicAuth(); gotUsername != username || gotPassword != password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		if headerKey != "" && r.Header.Get(headerKey) != headerValue {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text") {
+			w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
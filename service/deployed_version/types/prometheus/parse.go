@@ -0,0 +1,140 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a Prometheus metrics-based lookup type.
+package prometheus
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sample is a metric sample from a Prometheus text exposition or OpenMetrics body.
+type sample struct {
+	name   string
+	labels map[string]string
+}
+
+// findSample returns the labels of the first sample in body named name that has all the labels of matchers.
+func findSample(body []byte, name string, matchers map[string]string) (map[string]string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		// Skip blank lines, and comments/metadata (# HELP, # TYPE, # EOF).
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		// Cheap check before parsing the labels.
+		if !strings.HasPrefix(line, name) {
+			continue
+		}
+
+		s, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		if s.name == name && matches(s.labels, matchers) {
+			return s.labels, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %w", err)
+	}
+
+	return nil, fmt.Errorf("no %s sample found matching %v", name, matchers)
+}
+
+// matches reports whether labels has every label in matchers.
+func matches(labels, matchers map[string]string) bool {
+	for key, value := range matchers {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSample parses the name and labels of a sample line, e.g.
+//
+//	argus_build_info{version="1.2.3",goversion="go1.26"} 1
+func parseSample(line string) (sample, error) {
+	end := strings.IndexAny(line, "{ \t")
+	if end == -1 {
+		return sample{}, errors.New("sample has no value")
+	}
+	s := sample{name: line[:end], labels: map[string]string{}}
+	if line[end] != '{' {
+		return s, nil
+	}
+
+	rest := line[end+1:]
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return sample{}, errors.New("unterminated label set")
+		}
+		if rest[0] == '}' {
+			return s, nil
+		}
+
+		// Label name.
+		eq := strings.IndexByte(rest, '=')
+		if eq == -1 {
+			return sample{}, fmt.Errorf("label with no value in %q", rest)
+		}
+		key := strings.TrimSpace(rest[:eq])
+		rest = strings.TrimLeft(rest[eq+1:], " \t")
+		if rest == "" || rest[0] != '"' {
+			return sample{}, fmt.Errorf("label %q has an unquoted value", key)
+		}
+
+		// Label value.
+		value, remaining, err := unquote(rest[1:])
+		if err != nil {
+			return sample{}, fmt.Errorf("label %q: %w", key, err)
+		}
+		s.labels[key] = value
+		rest = remaining
+	}
+}
+
+// unquote returns the escaped label value up to the closing quote, and the text after it.
+func unquote(text string) (string, string, error) {
+	var value strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			return value.String(), text[i+1:], nil
+		case '\\':
+			i++
+			if i == len(text) {
+				return "", "", errors.New("unterminated label value")
+			}
+			switch text[i] {
+			case 'n':
+				value.WriteByte('\n')
+			default:
+				value.WriteByte(text[i])
+			}
+		default:
+			value.WriteByte(text[i])
+		}
+	}
+	return "", "", errors.New("unterminated label value")
+}
@@ -0,0 +1,136 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package prometheus
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestFindSample(t *testing.T) {
+	// GIVEN: a metrics body, metric name and label matchers.
+	tests := []struct {
+		name       string
+		body       string
+		metric     string
+		matchers   map[string]string
+		wantLabels map[string]string
+		errRegex   string
+	}{
+		{
+			name:   "first match",
+			body:   testMetrics,
+			metric: "argus_build_info",
+			wantLabels: map[string]string{
+				"branch": "main", "goversion": "go1.26.6", "instance": "a", "revision": "abc", "version": "1.2.3",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name:     "label matchers",
+			body:     testMetrics,
+			metric:   "argus_build_info",
+			matchers: map[string]string{"instance": "b"},
+			wantLabels: map[string]string{
+				"branch": "main", "goversion": "go1.26.6", "instance": "b", "revision": "def", "version": "v1.2.4",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name:     "no match",
+			body:     testMetrics,
+			metric:   "argus_build_info",
+			matchers: map[string]string{"instance": "c"},
+			errRegex: `^no argus_build_info sample found matching map\[instance:c\]$`,
+		},
+		{
+			name:     "prefix of another metric doesn't match",
+			body:     "argus_build_info_extra{version=\"9.9.9\"} 1\n",
+			metric:   "argus_build_info",
+			errRegex: `^no argus_build_info sample found`,
+		},
+		{
+			name: "OpenMetrics",
+			body: "# TYPE app_build info\n" +
+				"app_build_info{version=\"1.2.3\"} 1\n" +
+				"# EOF\n",
+			metric:     "app_build_info",
+			wantLabels: map[string]string{"version": "1.2.3"},
+			errRegex:   `^$`,
+		},
+		{
+			name:       "escaped label values, spaces and trailing comma",
+			body:       `app_build_info{ version = "1.2.3", note="a \"quoted\" \\ back\nslash", } 1 1700000000` + "\n",
+			metric:     "app_build_info",
+			wantLabels: map[string]string{"version": "1.2.3", "note": "a \"quoted\" \\ back\nslash"},
+			errRegex:   `^$`,
+		},
+		{
+			name:       "no labels",
+			body:       "app_build_info 1\n",
+			metric:     "app_build_info",
+			wantLabels: map[string]string{},
+			errRegex:   `^$`,
+		},
+		{
+			name:     "unterminated label value",
+			body:     "app_build_info{version=\"1.2.3} 1\n",
+			metric:   "app_build_info",
+			errRegex: `^line 1:\s+label "version":\s+unterminated label value$`,
+		},
+		{
+			name:     "unquoted label value",
+			body:     "# comment\napp_build_info{version=1.2.3} 1\n",
+			metric:   "app_build_info",
+			errRegex: `^line 2:\s+label "version" has an unquoted value$`,
+		},
+		{
+			name:     "no value",
+			body:     "app_build_info\n",
+			metric:   "app_build_info",
+			errRegex: `^line 1:\s+sample has no value$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: findSample is called.
+			got, err := findSample([]byte(tc.body), tc.metric, tc.matchers)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nfindSample() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			// AND: the labels are as expected.
+			if !maps.Equal(got, tc.wantLabels) {
+				t.Errorf(
+					"%s\nfindSample() labels mismatch\ngot:  %v\nwant: %v",
+					packageName, got, tc.wantLabels,
+				)
+			}
+		})
+	}
+}
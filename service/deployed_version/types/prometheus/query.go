@@ -0,0 +1,77 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a Prometheus metrics-based lookup type.
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/release-argus/Argus/internal/logx"
+)
+
+// Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
+func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
+	err := l.query(metrics, logFrom)
+
+	if metrics {
+		l.QueryMetrics(l, err)
+	}
+
+	return err
+}
+
+// query scrapes the metrics endpoint and updates DeployedVersion if changed.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
+	body, err := l.request().Fetch(logFrom)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	value, err := l.labelValue(body)
+	if err != nil {
+		logx.Warn(err, logFrom, true)
+		return err
+	}
+
+	version, err := l.GetVersion(
+		[]byte(value),
+		l.extraction(),
+		l.url(),
+		logFrom,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Set the deployed version if it has changed.
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+
+	return nil
+}
+
+// labelValue returns the value of the version label on the matching sample in body.
+func (l *Lookup) labelValue(body []byte) (string, error) {
+	labels, err := findSample(body, l.Metric, l.Labels)
+	if err != nil {
+		return "", fmt.Errorf("%q: %w", l.url(), err)
+	}
+
+	versionLabel := l.versionLabel()
+	value, ok := labels[versionLabel]
+	if !ok {
+		return "", fmt.Errorf("label %q not found on %s sample", versionLabel, l.Metric)
+	}
+	return value, nil
+}
@@ -0,0 +1,174 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestLookup_Query(t *testing.T) {
+	// GIVEN: a Lookup on a metrics endpoint.
+	tests := []struct {
+		name                        string
+		body                        string
+		username, password          string
+		headerKey, headerValue      string
+		overrides, optionsOverrides string
+		errRegex                    string
+		wantVersion                 string
+	}{
+		{
+			name:        "first sample",
+			body:        testMetrics,
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "label matchers",
+			body: testMetrics,
+			overrides: test.TrimYAML(`
+				labels:
+					instance: b
+				regex: 'v(.+)'
+			`),
+			wantVersion: `^1\.2\.4$`,
+			errRegex:    `^$`,
+		},
+		{
+			name: "version_label",
+			body: testMetrics,
+			overrides: test.TrimYAML(`
+				version_label: goversion
+				regex: 'go(.+)'
+			`),
+			wantVersion: `^1\.26\.6$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "basic auth",
+			body:     testMetrics,
+			username: "user",
+			password: "pass",
+			overrides: test.TrimYAML(`
+				basic_auth:
+					username: user
+					password: pass
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:     "basic auth/wrong password",
+			body:     testMetrics,
+			username: "user",
+			password: "pass",
+			overrides: test.TrimYAML(`
+				basic_auth:
+					username: user
+					password: wrong
+			`),
+			errRegex: `non-2XX response code: 401`,
+		},
+		{
+			name:        "headers",
+			body:        testMetrics,
+			headerKey:   "Authorization",
+			headerValue: "Bearer token",
+			overrides: test.TrimYAML(`
+				headers:
+					- key: Authorization
+						value: Bearer token
+			`),
+			wantVersion: `^1\.2\.3$`,
+			errRegex:    `^$`,
+		},
+		{
+			name:      "metric not found",
+			body:      testMetrics,
+			overrides: `metric: other_build_info`,
+			errRegex:  `^"http://[^"]+":\s+no other_build_info sample found matching map\[\]$`,
+		},
+		{
+			name:      "version label not found",
+			body:      testMetrics,
+			overrides: `version_label: commit`,
+			errRegex:  `^label "commit" not found on argus_build_info sample$`,
+		},
+		{
+			name: "want semantic versioning but get non-semantic version",
+			body: testMetrics,
+			overrides: test.TrimYAML(`
+				version_label: revision
+			`),
+			optionsOverrides: `semantic_versioning: true`,
+			errRegex:         `failed to convert "abc" to a semantic version`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testMetricsServer(t, tc.body, tc.username, tc.password, tc.headerKey, tc.headerValue)
+
+			dvl := testLookup(t)
+			dvl.URL = server.URL + "/metrics"
+			if err := dvl.ApplyOverrides("yaml", []byte(tc.overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+			if tc.optionsOverrides != "" {
+				if err := decode.Unmarshal("yaml", []byte(tc.optionsOverrides), dvl.Options); err != nil {
+					t.Fatalf(
+						"%s\nfailed to unmarshal Lookup.Options overrides: %s",
+						packageName, err,
+					)
+				}
+			}
+
+			// WHEN: Query is called on it.
+			err := dvl.Query(true, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+
+			// AND: the version matches the expected regex.
+			if tc.wantVersion != "" {
+				if version := dvl.Status.DeployedVersion(); !util.RegexCheck(tc.wantVersion, version) {
+					t.Errorf(
+						"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant %q",
+						packageName, version, tc.wantVersion,
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a Prometheus metrics-based lookup type.
+package prometheus
+
+import (
+	"maps"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/deployed_version/types/web"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
+)
+
+// #############
+// # CONSTANTS #
+// #############
+
+// Type is the lookup type identifier for Prometheus deployed version lookups.
+var Type = "prometheus"
+
+// DefaultVersionLabel is the label the version is read from when no version_label is given.
+const DefaultVersionLabel = "version"
+
+// accept is the Accept header sent when none is configured, preferring OpenMetrics.
+const accept = "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5,*/*;q=0.1"
+
+// #########
+// # TYPES #
+// #########
+
+// Lookup is a Prometheus metrics-based lookup type.
+type Lookup struct {
+	base.Lookup `json:",inline" yaml:",inline"`
+
+	URL               string         `json:"url,omitzero" yaml:"url,omitzero"`                                 // REQUIRED: URL of the metrics endpoint.
+	AllowInvalidCerts *bool          `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *httpx.TLS     `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // OPTIONAL: CA bundle, client certificate and server name.
+	Proxy             *httpx.Proxy   `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // OPTIONAL: HTTP/SOCKS5 proxy to send requests through.
+	BasicAuth         *web.BasicAuth `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`                   // OPTIONAL: basic auth credentials.
+	OAuth2            *httpx.OAuth2  `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OPTIONAL: OAuth2 client-credentials to get a bearer token with.
+	Headers           shared.Headers `json:"headers,omitempty" yaml:"headers,omitempty"`                       // OPTIONAL: request headers.
+
+	Metric        string            `json:"metric,omitzero" yaml:"metric,omitzero"`                 // REQUIRED: name of the metric, e.g. argus_build_info.
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`               // OPTIONAL: label values the metric must have.
+	VersionLabel  string            `json:"version_label,omitzero" yaml:"version_label,omitzero"`   // OPTIONAL: label to read the version from.
+	Regex         string            `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
+	RegexTemplate string            `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+}
+
+// #############
+// # STRINGIFY #
+// #############
+
+// String returns a string representation of the receiver.
+func (l *Lookup) String(prefix string) string {
+	return decode.ToYAMLString(l, prefix)
+}
+
+// #########
+// # STATE #
+// #########
+
+// Copy returns a deep copy of the receiver.
+func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
+	if l == nil {
+		return nil
+	}
+
+	return &Lookup{
+		Lookup:            *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		URL:               l.URL,
+		AllowInvalidCerts: util.ClonePtr(l.AllowInvalidCerts),
+		TLS:               l.TLS.Copy(),
+		Proxy:             l.Proxy.Copy(),
+		BasicAuth:         l.BasicAuth.Copy(),
+		OAuth2:            l.OAuth2.Copy(),
+		Headers:           l.Headers.Copy(),
+		Metric:            l.Metric,
+		Labels:            maps.Clone(l.Labels),
+		VersionLabel:      l.VersionLabel,
+		Regex:             l.Regex,
+		RegexTemplate:     l.RegexTemplate,
+	}
+}
+
+// InheritSecrets copies the BasicAuth password, OAuth2 client secret, proxy password and header secrets from otherLookup.
+func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
+	if otherL, ok := otherLookup.(*Lookup); ok {
+		if l.BasicAuth != nil &&
+			l.BasicAuth.Password == util.SecretValue &&
+			otherL.BasicAuth != nil {
+			l.BasicAuth.Password = otherL.BasicAuth.Password
+		}
+		l.OAuth2.InheritSecrets(otherL.OAuth2)
+		l.Proxy.InheritSecrets(otherL.Proxy)
+
+		if secretRefs != nil {
+			l.Headers.InheritSecrets(otherL.Headers, secretRefs.Headers)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/deployed_version/types/web"
+	"github.com/release-argus/Argus/service/shared"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestLookup_String(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+	lookup.Labels = map[string]string{"job": "argus"}
+	lookup.VersionLabel = "revision"
+
+	// WHEN: String is called on it.
+	got := lookup.String("")
+
+	// THEN: it is stringified as expected.
+	want := test.TrimYAML(`
+		type: prometheus
+		url: http://localhost:9090/metrics
+		metric: argus_build_info
+		labels:
+			job: argus
+		version_label: revision
+	`)
+	if got != want {
+		t.Errorf(
+			"%s\nLookup.String() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, want,
+		)
+	}
+}
+
+func TestLookup_Copy(t *testing.T) {
+	// GIVEN: a Lookup.
+	lookup := testLookup(t)
+	lookup.AllowInvalidCerts = new(true)
+	lookup.BasicAuth = &web.BasicAuth{Username: "user", Password: "pass"}
+	lookup.OAuth2 = &httpx.OAuth2{TokenURL: "https://example.com/token", ClientID: "id", ClientSecret: "secret"}
+	lookup.Headers = shared.Headers{{Key: "X-Test", Value: "value"}}
+	lookup.Labels = map[string]string{"job": "argus"}
+	lookup.Regex = "([0-9.]+)"
+	lookup.RegexTemplate = "v$1"
+
+	// WHEN: Copy is called on it.
+	got, ok := lookup.Copy(lookup.Status).(*Lookup)
+	if !ok {
+		t.Fatalf(
+			"%s\nLookup.Copy() returned %T, want *Lookup",
+			packageName, got,
+		)
+	}
+
+	// THEN: the copy matches the original.
+	if gotStr, wantStr := got.String(""), lookup.String(""); gotStr != wantStr {
+		t.Errorf(
+			"%s\nLookup.Copy() mismatch\ngot:  %q\nwant: %q",
+			packageName, gotStr, wantStr,
+		)
+	}
+	// AND: the copy doesn't share references with the original.
+	got.Labels["job"] = "other"
+	*got.AllowInvalidCerts = false
+	got.BasicAuth.Password = "other"
+	got.OAuth2.ClientSecret = "other"
+	got.Headers[0].Value = "other"
+	if lookup.Labels["job"] != "argus" ||
+		!*lookup.AllowInvalidCerts ||
+		lookup.BasicAuth.Password != "pass" ||
+		lookup.OAuth2.ClientSecret != "secret" ||
+		lookup.Headers[0].Value != "value" {
+		t.Errorf(
+			"%s\nLookup.Copy() shares references with the original\n%s",
+			packageName, lookup.String(""),
+		)
+	}
+
+	// WHEN: Copy is called on a nil Lookup.
+	var nilLookup *Lookup
+	// THEN: nil is returned.
+	if got := nilLookup.Copy(nil); got != nil {
+		t.Errorf(
+			"%s\nLookup.Copy() on nil mismatch\ngot:  %v\nwant: nil",
+			packageName, got,
+		)
+	}
+}
+
+func TestLookup_InheritSecrets(t *testing.T) {
+	// GIVEN: a Lookup with secrets, and another Lookup to inherit from.
+	tests := []struct {
+		name       string
+		lookup     *Lookup
+		other      base.BaseInterface
+		secretRefs *shared.VSecretRef
+		want       *Lookup
+	}{
+		{
+			name: "inherit BasicAuth password",
+			lookup: &Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: util.SecretValue},
+			},
+			other: &Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: "password"},
+			},
+			secretRefs: &shared.VSecretRef{},
+			want: &Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: "password"},
+			},
+		},
+		{
+			name: "inherit OAuth2 client_secret",
+			lookup: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: util.SecretValue,
+				},
+			},
+			other: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: "secret",
+				},
+			},
+			want: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: "secret",
+				},
+			},
+		},
+		{
+			name: "inherit headers",
+			lookup: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: util.SecretValue}},
+			},
+			other: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: "secret"}},
+			},
+			secretRefs: &shared.VSecretRef{
+				Headers: []shared.OldIntIndex{{OldIndex: new(0)}},
+			},
+			want: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: "secret"}},
+			},
+		},
+		{
+			name: "no secretRefs keeps headers",
+			lookup: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: util.SecretValue}},
+			},
+			other: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: "secret"}},
+			},
+			want: &Lookup{
+				Headers: shared.Headers{{Key: "X-Test", Value: util.SecretValue}},
+			},
+		},
+		{
+			name: "other not a prometheus Lookup",
+			lookup: &Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: util.SecretValue},
+			},
+			other: &web.Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: "password"},
+			},
+			secretRefs: &shared.VSecretRef{},
+			want: &Lookup{
+				BasicAuth: &web.BasicAuth{Username: "user", Password: util.SecretValue},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: InheritSecrets is called.
+			tc.lookup.InheritSecrets(tc.other, tc.secretRefs)
+
+			// THEN: the secrets are inherited as expected.
+			if got, want := tc.lookup.String(""), tc.want.String(""); got != want {
+				t.Errorf(
+					"%s\nLookup.InheritSecrets() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, want,
+				)
+			}
+		})
+	}
+}
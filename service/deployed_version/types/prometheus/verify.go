@@ -0,0 +1,100 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a Prometheus metrics-based lookup type.
+package prometheus
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/release-argus/Argus/config/decode"
+)
+
+// metricNameRegex matches a valid Prometheus metric name.
+var metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// CheckValues validates the fields of the receiver.
+func (l *Lookup) CheckValues() error {
+	var errs []error
+
+	// URL.
+	if l.URL == "" {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "url",
+				Description: "URL of the metrics endpoint to get the deployed_version from",
+			},
+		)
+	}
+
+	// TLS.
+	if err := l.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
+	}
+
+	// Proxy.
+	if err := l.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+
+	// OAuth2.
+	if err := l.OAuth2.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "oauth2",
+				Err: err,
+			},
+		)
+	}
+
+	// Metric.
+	if !metricNameRegex.MatchString(l.Metric) {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "metric",
+				Value:       l.Metric,
+				Description: "name of the metric to read the version from, e.g. app_build_info",
+			},
+		)
+	}
+
+	// RegEx.
+	extraction := l.extraction()
+	errs = append(errs, extraction.CheckValues()...)
+	// Remove the RegExTemplate if no RegEx.
+	if l.Regex == "" {
+		l.RegexTemplate = ""
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
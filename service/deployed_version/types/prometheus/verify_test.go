@@ -0,0 +1,154 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestLookup_CheckValues(t *testing.T) {
+	// GIVEN: a Lookup.
+	tests := []struct {
+		name              string
+		data              string
+		wantRegexTemplate string
+		errRegex          string
+	}{
+		{
+			name: "valid",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus_build_info
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "url/empty",
+			data: test.TrimYAML(`
+				metric: argus_build_info
+			`),
+			errRegex: `^url: <required>.*$`,
+		},
+		{
+			name: "oauth2/valid",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus_build_info
+				oauth2:
+					token_url: https://example.com/token
+					client_id: id
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "oauth2/invalid",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus_build_info
+				oauth2:
+					client_secret: secret
+			`),
+			errRegex: test.TrimYAML(`
+				^oauth2:
+					token_url: <required>.*
+					client_id: <required>.*$`,
+			),
+		},
+		{
+			name: "metric/empty",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+			`),
+			errRegex: `^metric: <required>.*$`,
+		},
+		{
+			name: "metric/invalid",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus-build-info
+			`),
+			errRegex: `^metric: "argus-build-info" <invalid>.*$`,
+		},
+		{
+			name: "regex_template/kept with regex",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus_build_info
+				regex: 'v(.+)'
+				regex_template: '$1'
+			`),
+			wantRegexTemplate: "$1",
+			errRegex:          `^$`,
+		},
+		{
+			name: "regex_template/removed without regex",
+			data: test.TrimYAML(`
+				url: http://localhost:9090/metrics
+				metric: argus_build_info
+				regex_template: '$1'
+			`),
+			errRegex: `^$`,
+		},
+		{
+			name: "all invalid",
+			data: test.TrimYAML(`
+				metric: '1_build_info'
+				regex: '[0-'
+			`),
+			errRegex: test.TrimYAML(`
+				^url: <required>.*
+				metric: "1_build_info" <invalid>.*
+				regex: "[^"]+" <invalid>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testLookup(t)
+			input.URL = ""
+			input.Metric = ""
+			// Apply the YAML.
+			if err := input.ApplyOverrides("yaml", []byte(tc.data)); err != nil {
+				t.Fatalf(
+					"%s\nLookup.ApplyOverrides(%q) failed before Lookup.CheckValues(): %v",
+					packageName, tc.data,
+					err,
+				)
+			}
+
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				input.CheckValues,
+			)
+
+			// AND: RegexTemplate is only kept with a RegEx.
+			if input.RegexTemplate != tc.wantRegexTemplate {
+				t.Errorf(
+					"%s\nLookup.CheckValues() .RegexTemplate mismatch\ngot:  %q\nwant: %q",
+					packageName, input.RegexTemplate, tc.wantRegexTemplate,
+				)
+			}
+		})
+	}
+}
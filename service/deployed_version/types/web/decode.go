@@ -43,13 +43,19 @@ func (l *Lookup) DecodeSelf(format string, data []byte) error {
 	l.Method = newL.Method
 	l.URL = newL.URL
 	l.AllowInvalidCerts = newL.AllowInvalidCerts
+	l.TLS = newL.TLS
+	l.Proxy = newL.Proxy
 	l.TargetHeader = newL.TargetHeader
+	l.Targets = newL.Targets
+	l.Quorum = newL.Quorum
 	l.BasicAuth = newL.BasicAuth
+	l.OAuth2 = newL.OAuth2
 	l.Headers = newL.Headers
 	l.Body = newL.Body
 	l.JSON = newL.JSON
 	l.Regex = newL.Regex
 	l.RegexTemplate = newL.RegexTemplate
+	l.URLCommands = newL.URLCommands
 
 	return nil
 }
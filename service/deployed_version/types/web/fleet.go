@@ -0,0 +1,204 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package web provides a web-based lookup type.
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
+)
+
+// Target is an instance of the Service to query for its deployed version.
+type Target struct {
+	Name string `json:"name,omitzero" yaml:"name,omitzero"` // OPTIONAL: name of the instance. Default - host of the URL.
+	URL  string `json:"url,omitzero" yaml:"url,omitzero"`   // REQUIRED: URL to query.
+}
+
+// Targets is a slice of Target.
+type Targets []Target
+
+// name returns the name of the instance, defaulting to the host of its URL.
+func (t *Target) name() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	targetURL := util.EvalEnvVars(t.URL)
+	if parsed, err := url.Parse(targetURL); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+	return targetURL
+}
+
+// CheckValues validates the fields of each [Target].
+func (t Targets) CheckValues() error {
+	var errs []error
+	names := make([]string, 0, len(t))
+	for index, target := range t {
+		var targetErrs []error
+		// URL.
+		if target.URL == "" {
+			targetErrs = append(
+				targetErrs,
+				&decode.ErrField{
+					Key:         "url",
+					Description: "URL of the instance to get the deployed_version from",
+				},
+			)
+		}
+		// Name.
+		name := target.name()
+		if slices.Contains(names, name) {
+			targetErrs = append(
+				targetErrs,
+				&decode.ErrField{
+					Key:         "name",
+					Value:       name,
+					Description: "name must be unique across targets",
+				},
+			)
+		}
+		names = append(names, name)
+
+		if len(targetErrs) != 0 {
+			errs = append(
+				errs,
+				&decode.ErrKeyField{
+					Key: fmt.Sprintf("- item_%d", index),
+					Err: errors.Join(targetErrs...),
+				},
+			)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// checkQuorum validates the Quorum of the receiver against its Targets.
+func (l *Lookup) checkQuorum() error {
+	if l.Quorum == nil {
+		return nil
+	}
+
+	if *l.Quorum < 1 || *l.Quorum > len(l.Targets) {
+		return &decode.ErrField{
+			Key:         "quorum",
+			Value:       strconv.Itoa(*l.Quorum),
+			Description: fmt.Sprintf("number of targets (1-%d) that must report a version before it is deployed", len(l.Targets)),
+		}
+	}
+	return nil
+}
+
+// quorum returns the number of targets that must report a version before it is deployed.
+func (l *Lookup) quorum() int {
+	return util.DerefOr(l.Quorum, len(l.Targets))
+}
+
+// queryFleet queries every Target, records the version of each instance,
+// and updates DeployedVersion once a quorum of them report the same version.
+func (l *Lookup) queryFleet(writeToDB bool, logFrom logx.LogFrom) error {
+	instances := make([]status.Instance, len(l.Targets))
+	errs := make([]error, len(l.Targets))
+
+	var wg sync.WaitGroup
+	for i, target := range l.Targets {
+		wg.Go(func() {
+			name := target.name()
+			version, err := l.queryTarget(
+				target,
+				logx.LogFrom{Primary: logFrom.Primary, Secondary: name},
+			)
+
+			instances[i] = status.Instance{Name: name, Version: version}
+			if err != nil {
+				instances[i].Error = err.Error()
+				errs[i] = fmt.Errorf("target %q: %w", name, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	changed := l.Status.SetInstances(instances, writeToDB)
+
+	// Set the deployed version if a quorum report a new version.
+	previousVersion := l.Status.DeployedVersion()
+	version := quorumVersion(l.Status.Instances(), l.quorum(), previousVersion)
+	l.HandleNewVersion(version, "", writeToDB, true, logFrom)
+	// Announce instance changes that didn't change the deployed version.
+	if changed && l.Status.DeployedVersion() == previousVersion {
+		l.Status.AnnounceUpdate()
+	}
+
+	return errors.Join(errs...)
+}
+
+// queryTarget returns the version reported by the instance at the URL of target.
+func (l *Lookup) queryTarget(target Target, logFrom logx.LogFrom) (string, error) {
+	lookup := *l
+	lookup.URL = target.URL
+	lookup.Targets = nil
+
+	body, _, err := lookup.httpRequest(nil, logFrom)
+	if err != nil {
+		return "", err
+	}
+
+	return lookup.getVersion(body, logFrom)
+}
+
+// quorumVersion returns the version reported by at least quorum instances,
+// or an empty string if no version has reached quorum.
+//
+// When several versions reach quorum, the one reported by the most instances is used,
+// preferring a version other than deployedVersion on a tie so that rollouts progress.
+func quorumVersion(instances []status.Instance, quorum int, deployedVersion string) string {
+	counts := make(map[string]int, len(instances))
+	var versions []string
+	for _, instance := range instances {
+		if instance.Version == "" {
+			continue
+		}
+		if counts[instance.Version] == 0 {
+			versions = append(versions, instance.Version)
+		}
+		counts[instance.Version]++
+	}
+
+	var version string
+	for _, candidate := range versions {
+		if counts[candidate] < quorum {
+			continue
+		}
+		if version == "" ||
+			counts[candidate] > counts[version] ||
+			(counts[candidate] == counts[version] && version == deployedVersion) {
+			version = candidate
+		}
+	}
+	return version
+}
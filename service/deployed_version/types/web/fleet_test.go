@@ -0,0 +1,341 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/service/status"
+	serviceinfo "github.com/release-argus/Argus/service/status/info"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/errfmt"
+)
+
+func TestTarget_Name(t *testing.T) {
+	// GIVEN: a Target.
+	tests := []struct {
+		name   string
+		target Target
+		want   string
+	}{
+		{
+			name:   "named",
+			target: Target{Name: "eu-1", URL: "https://eu-1.example.com/version"},
+			want:   "eu-1",
+		},
+		{
+			name:   "host of the URL",
+			target: Target{URL: "https://eu-1.example.com:8443/version"},
+			want:   "eu-1.example.com:8443",
+		},
+		{
+			name:   "URL without a host",
+			target: Target{URL: "/version"},
+			want:   "/version",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: name is called on it.
+			got := tc.target.name()
+
+			// THEN: the name is returned.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nTarget.name() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestQuorumVersion(t *testing.T) {
+	// GIVEN: the versions of instances, a quorum, and the deployed version.
+	tests := []struct {
+		name            string
+		versions        []string
+		quorum          int
+		deployedVersion string
+		want            string
+	}{
+		{
+			name:     "all agree",
+			versions: []string{"1.2.3", "1.2.3", "1.2.3"},
+			quorum:   3,
+			want:     "1.2.3",
+		},
+		{
+			name:            "rollout in progress",
+			versions:        []string{"1.2.3", "1.2.2", "1.2.3"},
+			quorum:          3,
+			deployedVersion: "1.2.2",
+			want:            "",
+		},
+		{
+			name:            "quorum reached",
+			versions:        []string{"1.2.3", "1.2.2", "1.2.3"},
+			quorum:          2,
+			deployedVersion: "1.2.2",
+			want:            "1.2.3",
+		},
+		{
+			name:     "unreported instances don't count",
+			versions: []string{"1.2.3", "", "1.2.3"},
+			quorum:   3,
+			want:     "",
+		},
+		{
+			name:            "tie prefers the new version",
+			versions:        []string{"1.2.2", "1.2.3"},
+			quorum:          1,
+			deployedVersion: "1.2.2",
+			want:            "1.2.3",
+		},
+		{
+			name:            "most reported wins",
+			versions:        []string{"1.2.2", "1.2.3", "1.2.2"},
+			quorum:          1,
+			deployedVersion: "1.2.2",
+			want:            "1.2.2",
+		},
+		{
+			name:   "no instances",
+			quorum: 1,
+			want:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instances := make([]status.Instance, len(tc.versions))
+			for i, version := range tc.versions {
+				instances[i] = status.Instance{Version: version}
+			}
+
+			// WHEN: quorumVersion is called.
+			got := quorumVersion(instances, tc.quorum, tc.deployedVersion)
+
+			// THEN: the version is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nquorumVersion(%q, %d, %q) mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.versions, tc.quorum, tc.deployedVersion, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_QueryFleet(t *testing.T) {
+	// GIVEN: a fleet of instances, each serving its version.
+	versions := map[string]string{
+		"/a": "1.2.3",
+		"/b": "1.2.3",
+		"/c": "1.2.2",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, ok := versions[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version":"` + version + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name            string
+		targets         string
+		latestVersion   string
+		deployedVersion string
+		errRegex        string
+		wantVersion     string
+		wantInstances   []status.Instance
+		wantFleetState  string
+	}{
+		{
+			name: "all on latest",
+			targets: test.TrimYAML(`
+				targets:
+					- name: a
+						url: {{server}}/a
+					- name: b
+						url: {{server}}/b
+			`),
+			latestVersion: "1.2.3",
+			wantVersion:   "1.2.3",
+			wantInstances: []status.Instance{
+				{Name: "a", Version: "1.2.3"},
+				{Name: "b", Version: "1.2.3"},
+			},
+			wantFleetState: serviceinfo.FleetLatest,
+			errRegex:       `^$`,
+		},
+		{
+			name: "partially rolled out, waits for every instance",
+			targets: test.TrimYAML(`
+				targets:
+					- name: a
+						url: {{server}}/a
+					- name: c
+						url: {{server}}/c
+			`),
+			latestVersion:   "1.2.3",
+			deployedVersion: "1.2.2",
+			wantVersion:     "1.2.2",
+			wantInstances: []status.Instance{
+				{Name: "a", Version: "1.2.3"},
+				{Name: "c", Version: "1.2.2"},
+			},
+			wantFleetState: serviceinfo.FleetPartial,
+			errRegex:       `^$`,
+		},
+		{
+			name: "partially rolled out, quorum reached",
+			targets: test.TrimYAML(`
+				targets:
+					- name: a
+						url: {{server}}/a
+					- name: b
+						url: {{server}}/b
+					- name: c
+						url: {{server}}/c
+				quorum: 2
+			`),
+			latestVersion:   "1.2.3",
+			deployedVersion: "1.2.2",
+			wantVersion:     "1.2.3",
+			wantInstances: []status.Instance{
+				{Name: "a", Version: "1.2.3"},
+				{Name: "b", Version: "1.2.3"},
+				{Name: "c", Version: "1.2.2"},
+			},
+			wantFleetState: serviceinfo.FleetPartial,
+			errRegex:       `^$`,
+		},
+		{
+			name: "unreachable instance",
+			targets: test.TrimYAML(`
+				targets:
+					- name: a
+						url: {{server}}/a
+					- name: down
+						url: {{server}}/down
+			`),
+			latestVersion:   "1.2.3",
+			deployedVersion: "1.2.2",
+			wantVersion:     "1.2.2",
+			wantInstances: []status.Instance{
+				{Name: "a", Version: "1.2.3"},
+				{Name: "down", Error: "non-2XX response code: 503"},
+			},
+			wantFleetState: serviceinfo.FleetLatest,
+			errRegex:       `^target "down":\s+non-2XX response code: 503$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t, false)
+			lookup.URL = ""
+			lookup.Status.SetLatestVersion(tc.latestVersion, "", false)
+			lookup.Status.SetDeployedVersion(tc.deployedVersion, "", false)
+			overrides := strings.ReplaceAll(tc.targets, "{{server}}", server.URL)
+			if err := lookup.UnmarshalYAML([]byte(overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %s",
+					packageName, err,
+				)
+			}
+
+			// WHEN: Query is called on it.
+			err := lookup.Query(false, logx.LogFrom{})
+
+			// THEN: any error is expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Fatalf(
+					"%s\nLookup.Query() error mismatch\ngot:  %q\nwant: %q",
+					packageName, e, tc.errRegex,
+				)
+			}
+			// AND: the deployed version is as expected.
+			if got := lookup.Status.DeployedVersion(); got != tc.wantVersion {
+				t.Errorf(
+					"%s\nLookup.Query() .DeployedVersion() mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.wantVersion,
+				)
+			}
+			// AND: the version of each instance is stored.
+			gotInstances := lookup.Status.Instances()
+			if len(gotInstances) != len(tc.wantInstances) {
+				t.Fatalf(
+					"%s\nLookup.Query() .Instances() mismatch\ngot:  %+v\nwant: %+v",
+					packageName, gotInstances, tc.wantInstances,
+				)
+			}
+			for i, want := range tc.wantInstances {
+				got := gotInstances[i]
+				got.Timestamp = ""
+				if got != want {
+					t.Errorf(
+						"%s\nLookup.Query() .Instances()[%d] mismatch\ngot:  %+v\nwant: %+v",
+						packageName, i, got, want,
+					)
+				}
+			}
+			// AND: the FleetState is as expected.
+			if got := lookup.Status.GetServiceInfo().FleetState; got != tc.wantFleetState {
+				t.Errorf(
+					"%s\nLookup.Query() .FleetState mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.wantFleetState,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Query_ClearsInstances(t *testing.T) {
+	// GIVEN: a Lookup without Targets on a Status with instances.
+	lookup := testLookup(t, false)
+	lookup.URL = test.LookupJSON["url_valid"]
+	lookup.Status.SetInstances([]status.Instance{{Name: "a", Version: "1.2.3"}}, false)
+
+	// WHEN: Query is called on it.
+	_ = lookup.Query(false, logx.LogFrom{})
+
+	// THEN: the instances are cleared.
+	if got := lookup.Status.Instances(); len(got) != 0 {
+		t.Errorf(
+			"%s\nLookup.Query() .Instances() not cleared\ngot:  %+v",
+			packageName, got,
+		)
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"io"
 	"strings"
 
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/service/deployed_version/types/base"
 	"github.com/release-argus/Argus/util"
 )
 
@@ -36,6 +38,15 @@ func (l *Lookup) allowInvalidCerts() bool {
 	)
 }
 
+// tls resolves the TLS settings, merging those of the Lookup with its defaults.
+func (l *Lookup) tls() *httpx.TLS {
+	return httpx.MergeTLS(
+		l.TLS,
+		l.Defaults.TLS,
+		l.HardDefaults.TLS,
+	)
+}
+
 // body returns the stored query response Body.
 func (l *Lookup) body() io.Reader {
 	if l.Body == "" {
@@ -57,3 +68,13 @@ func (l *Lookup) method() string {
 func (l *Lookup) url() string {
 	return util.EvalEnvVars(l.URL)
 }
+
+// extraction returns the options to extract the version from the response.
+func (l *Lookup) extraction() base.Extraction {
+	return base.Extraction{
+		JSON:          l.JSON,
+		Regex:         l.Regex,
+		RegexTemplate: l.RegexTemplate,
+		URLCommands:   l.URLCommands,
+	}
+}
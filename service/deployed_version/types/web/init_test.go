@@ -59,6 +59,10 @@ func TestLookup_Init(t *testing.T) {
 	if err := test.AssertFields(t, fieldTests, prefix, "Lookup"); err != nil {
 		t.Fatal(err)
 	}
+	// AND: the validators for conditional requests are set.
+	if l.validators == nil {
+		t.Errorf("%s .validators was not set", prefix)
+	}
 }
 
 func TestLookup_Metrics(t *testing.T) {
@@ -18,72 +18,81 @@ package web
 import (
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"regexp"
 	"strings"
-	"time"
 
-	"github.com/release-argus/Argus/config/decode"
 	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/util"
 )
 
-// Track polls the URL at the configured interval, updating the deployed version on each query.
-func (l *Lookup) Track() {
-	logFrom := logx.LogFrom{Primary: l.GetServiceID()}
-
-	// Track forever.
-	for {
-		// If we are deleting this Service, stop tracking it.
-		if l.Status.Deleting() {
-			return
-		}
-
-		// Query the deployed version.
-		_ = l.Query(true, logFrom) //nolint:errcheck
-
-		// Sleep interval between queries.
-		time.Sleep(l.Options.GetIntervalDuration())
-	}
-}
-
 // Query fetches the deployed version, sets Prometheus metrics if requested, and returns any error.
 func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) error {
-	err := l.query(metrics, logFrom)
+	notModified, err := l.query(metrics, logFrom)
 
 	if metrics {
-		l.QueryMetrics(l, err)
+		if notModified {
+			l.NotModifiedMetrics(l)
+		} else {
+			l.QueryMetrics(l, err)
+		}
 	}
 
 	return err
 }
 
-// query fetches the deployed version URL and updates DeployedVersion if changed.
-func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) error {
-	body, err := l.httpRequest(logFrom)
+// query fetches the deployed version URL and updates DeployedVersion if changed,
+// returning whether the URL responded 304 Not Modified.
+func (l *Lookup) query(writeToDB bool, logFrom logx.LogFrom) (bool, error) {
+	// Query each instance of a fleet.
+	if len(l.Targets) != 0 {
+		return false, l.queryFleet(writeToDB, logFrom)
+	}
+	// No longer a fleet.
+	l.Status.SetInstances(nil, writeToDB)
+
+	body, notModified, err := l.httpRequest(l.validators, logFrom)
 	if err != nil {
-		return err
+		// Make the next query unconditional.
+		l.validators.Reset()
+		return false, err
+	}
+	// Unchanged since the last query.
+	if notModified {
+		return true, nil
 	}
 
 	version, err := l.getVersion(body, logFrom)
 	if err != nil {
-		return err
+		// Make the next query unconditional.
+		l.validators.Reset()
+		return false, err
 	}
 
 	// Set the deployed version if it has changed.
 	l.HandleNewVersion(version, "", writeToDB, true, logFrom) //nolint:wrapcheck
 
-	return nil
+	return false, nil
 }
 
-// httpRequest makes a HTTP GET request to the URL and returns the body.
-func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
-	client := httpx.Client
-	// HTTPS insecure skip verify.
-	if l.allowInvalidCerts() {
-		client = httpx.InsecureClient
+// Fetch makes the HTTP request of the receiver and returns the response body.
+//
+// It allows other lookup types to share the auth, headers and TLS handling of a [Lookup].
+func (l *Lookup) Fetch(logFrom logx.LogFrom) ([]byte, error) {
+	body, _, err := l.httpRequest(nil, logFrom)
+	return body, err
+}
+
+// httpRequest makes a HTTP request to the URL and returns the body, or the value of the TargetHeader.
+//
+// GET requests are made conditional with validators (when non-nil),
+// returning true with a nil body when the URL responds 304 Not Modified.
+func (l *Lookup) httpRequest(validators *httpx.CacheValidators, logFrom logx.LogFrom) ([]byte, bool, error) {
+	client, err := httpx.ClientFor(l.tls(), l.Proxy, l.allowInvalidCerts())
+	if err != nil {
+		err = fmt.Errorf("tls: %w", err)
+		logx.Error(err, logFrom, true)
+		return nil, false, err
 	}
 
 	// Create the request.
@@ -94,7 +103,7 @@ func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
 			l.URL, err,
 		)
 		logx.Error(err, logFrom, true)
-		return nil, err
+		return nil, false, err
 	}
 
 	// Set headers.
@@ -111,24 +120,42 @@ func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
 			util.EvalEnvVars(l.BasicAuth.Password),
 		)
 	}
+	// Conditional request.
+	if req.Method != http.MethodGet {
+		validators = nil
+	}
+	conditional := validators.Apply(req)
+	// OAuth2.
+	if err := l.OAuth2.Authorize(req, client); err != nil {
+		logx.Error(err, logFrom, true)
+		return nil, false, err //nolint:wrapcheck
+	}
 
-	// Send the request.
-	resp, err := client.Do(req)
+	// Send the request, sharing the response with identical requests.
+	resp, body, err := httpx.Do(client, req)
 	if err != nil {
 		// Don't crash on invalid certs.
 		if strings.Contains(err.Error(), "x509") {
 			err = errors.New("x509 (certificate invalid)")
 			logx.Warn(err, logFrom, true)
-			return nil, err
+			return nil, false, err
 		}
 		logx.Error(err, logFrom, true)
-		return nil, err
+		return nil, false, err
+	}
+
+	// Unchanged since the last request.
+	if conditional && resp.StatusCode == http.StatusNotModified {
+		logx.Debug("304 Not Modified", logFrom, true)
+		return nil, true, nil
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		validators.Update(resp.Header)
 	}
-	defer resp.Body.Close()
 
 	if l.TargetHeader != "" {
 		if headerValue := resp.Header.Get(l.TargetHeader); headerValue != "" {
-			return []byte(headerValue), nil
+			return []byte(headerValue), false, nil
 		}
 		var err error
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -137,73 +164,20 @@ func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
 			err = fmt.Errorf("target header %q not found", l.TargetHeader)
 		}
 		logx.Warn(err, logFrom, true)
-		return nil, err
+		return nil, false, err
 	}
 
 	// Ignore non-2XX responses.
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		err := fmt.Errorf("non-2XX response code: %d", resp.StatusCode)
 		logx.Warn(err, logFrom, true)
-		return nil, err
+		return nil, false, err
 	}
 
-	// Return the body.
-	body, err := io.ReadAll(io.LimitReader(resp.Body, 50<<20)) // Limit to 50 MiB.
-	logx.Error(err, logFrom, err != nil)
-	return body, err //nolint:wrapcheck
+	return body, false, nil
 }
 
-// getVersion returns the version from `body` that matches the URLCommands, and Regex requirements.
+// getVersion returns the version from `body` that matches the JSON, Regex and URLCommands requirements.
 func (l *Lookup) getVersion(body []byte, logFrom logx.LogFrom) (string, error) {
-	var version string
-	// If JSON is provided, use it to extract the version.
-	if l.JSON != "" {
-		var err error
-		version, err = decode.GetValueByKey(body, l.JSON, l.url())
-		if err != nil {
-			logx.Error(err, logFrom, true)
-			//nolint:wrapcheck
-			return "", err
-		}
-	} else {
-		// Use the entire body if not parsing as JSON.
-		version = string(body)
-	}
-
-	if version == "" {
-		err := fmt.Errorf(
-			"no version found in %q",
-			util.TruncateMessage(version, 100),
-		)
-		logx.Warn(err, logFrom, true)
-		return "", err
-	}
-
-	// If a regex is provided, use it to extract the version.
-	if l.Regex != "" {
-		re := regexp.MustCompile(l.Regex)
-		texts := re.FindAllStringSubmatch(version, 1)
-
-		if len(texts) == 0 {
-			err := fmt.Errorf(
-				"regex %q didn't return any matches on %q",
-				l.Regex, util.TruncateMessage(version, 100),
-			)
-			logx.Warn(err, logFrom, true)
-			return "", err
-		}
-
-		regexMatches := texts[0]
-		version = util.RegexTemplate(regexMatches, l.RegexTemplate)
-	}
-
-	// If semantic versioning is enabled, check the version is in the correct format.
-	if l.Options.GetSemanticVersioning() {
-		if _, err := l.Options.VerifySemanticVersioning(version, logFrom); err != nil {
-			logx.Warn(err, logFrom, true)
-			return "", err //nolint:wrapcheck
-		}
-	}
-
-	return version, nil
+	return l.GetVersion(body, l.extraction(), l.url(), logFrom) //nolint:wrapcheck
 }
@@ -17,16 +17,20 @@
 package web
 
 import (
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
-	"time"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/release-argus/Argus/config/decode"
 	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/dashboard"
@@ -38,7 +42,7 @@ import (
 	"github.com/release-argus/Argus/web/metric"
 )
 
-func TestLookup_Track(t *testing.T) {
+func TestLookup_Query__versions(t *testing.T) {
 	dvCfg := plainDefaultsConfig(t)
 
 	plainStableVersion := "1.2.1"
@@ -53,13 +57,9 @@ func TestLookup_Track(t *testing.T) {
 		lookup                                    *Lookup
 		allowInvalidCerts, semanticVersioning     bool
 		basicAuth                                 *BasicAuth
-		expectFinish                              bool
-		wait                                      time.Duration
-		errRegex                                  string
 		startDeployedVersion, wantDeployedVersion string
 		startLatestVersion, wantLatestVersion     string
 		wantAnnounces, wantDatabaseMessages       int
-		deleting                                  bool
 	}{
 		{
 			name:                "get semantic version with regex",
@@ -256,20 +256,6 @@ func TestLookup_Track(t *testing.T) {
 			wantDatabaseMessages: 1,
 			wantAnnounces:        1,
 		},
-		{
-			name:     "deleting service stops track",
-			deleting: true,
-			lookup: &Lookup{
-				URL:  test.LookupJSON["url_valid"],
-				JSON: "bar",
-			},
-			startLatestVersion:   "",
-			wantLatestVersion:    "",
-			startDeployedVersion: "",
-			wantDeployedVersion:  "",
-			wantAnnounces:        0,
-			wantDatabaseMessages: 0,
-		},
 	}
 
 	for _, tc := range tests {
@@ -306,54 +292,16 @@ func TestLookup_Track(t *testing.T) {
 				tc.lookup.Status = svcStatus
 				tc.lookup.Status.ServiceInfo.ID = tc.name
 				tc.lookup.Status.ServiceInfo.WebURL = tc.lookup.URL
-				if tc.deleting {
-					tc.lookup.Status.SetDeleting()
-				}
 
 				tc.lookup.InitMetrics(tc.lookup)
 				t.Cleanup(func() { tc.lookup.DeleteMetrics(tc.lookup) })
 			}
-			didFinish := make(chan bool, 1)
-
-			// WHEN: CheckValues is called on it.
-			go func() {
-				tc.lookup.Track()
-				didFinish <- true
-			}()
+			// WHEN: Query is called on it.
+			_ = tc.lookup.Query(true, logx.LogFrom{Primary: tc.name})
 
-			prefix := fmt.Sprintf("%s\nLookup.Track()", packageName)
+			prefix := fmt.Sprintf("%s\nLookup.Query()", packageName)
 
-			// THEN: the function exits straight away.
-			time.Sleep(tc.wait)
-			if tc.expectFinish {
-				if len(didFinish) == 0 {
-					t.Fatalf(
-						"%s didn't finish in <= %s",
-						prefix, tc.wait,
-					)
-				}
-				releaseStdout()
-				return
-			}
-			haveQueried := false
-			for haveQueried != false {
-				serviceID := tc.lookup.GetServiceID()
-				passQ := testutil.ToFloat64(
-					metric.DeployedVersionQueryResultTotal.WithLabelValues(
-						serviceID, metric.ActionResultSuccess, tc.lookup.Type,
-					),
-				)
-				failQ := testutil.ToFloat64(
-					metric.DeployedVersionQueryResultTotal.WithLabelValues(
-						serviceID, metric.ActionResultFail, tc.lookup.Type,
-					),
-				)
-				if passQ != float64(0) && failQ != float64(0) {
-					haveQueried = true
-				}
-				time.Sleep(time.Second)
-			}
-			time.Sleep(5 * time.Second)
+			// THEN: the versions are updated as expected.
 			stdout := releaseStdout()
 			t.Log(stdout)
 			if gotDeployedVersion := tc.lookup.Status.DeployedVersion(); gotDeployedVersion != tc.wantDeployedVersion {
@@ -386,9 +334,6 @@ func TestLookup_Track(t *testing.T) {
 					prefix, gotDatabaseMessages, tc.wantDatabaseMessages,
 				)
 			}
-
-			// Set Deleting to stop the Track.
-			tc.lookup.Status.SetDeleting()
 		})
 	}
 }
@@ -659,6 +604,81 @@ func TestLookup_Query(t *testing.T) {
 	}
 }
 
+func TestLookup_Query__conditional(t *testing.T) {
+	// GIVEN: a server that supports conditional requests.
+	var (
+		mu              sync.Mutex
+		lastModified    = "Wed, 21 Oct 2015 07:28:00 GMT"
+		ifModifiedSince string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		ifModifiedSince = r.Header.Get("If-Modified-Since")
+		if ifModifiedSince == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = fmt.Fprint(w, `{"version":"1.2.3"}`)
+	}))
+	t.Cleanup(server.Close)
+	lastIfModifiedSince := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return ifModifiedSince
+	}
+	// AND: a Lookup of that server.
+	lookup := testLookup(t, false)
+	lookup.URL = server.URL
+	lookup.validators = &httpx.CacheValidators{}
+	serviceID := "TestLookup_Query__conditional"
+	lookup.Status.ServiceInfo.ID = serviceID
+	t.Cleanup(func() { lookup.DeleteMetrics(lookup) })
+	prefix := fmt.Sprintf("%s\nLookup.Query()", packageName)
+
+	// WHEN: the Lookup is queried twice.
+	for range 2 {
+		if err := lookup.Query(true, logx.LogFrom{}); err != nil {
+			t.Fatalf("%s unexpected error: %v",
+				prefix, err)
+		}
+	}
+
+	// THEN: the second request is conditional.
+	if got := lastIfModifiedSince(); got != lastModified {
+		t.Errorf("%s If-Modified-Since mismatch\ngot:  %q\nwant: %q",
+			prefix, got, lastModified)
+	}
+	// AND: the version is kept.
+	if got := lookup.Status.DeployedVersion(); got != "1.2.3" {
+		t.Errorf("%s DeployedVersion mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "1.2.3")
+	}
+	// AND: the 304 is counted separately from the success.
+	for result, want := range map[string]float64{
+		metric.ActionResultSuccess:     1,
+		metric.ActionResultNotModified: 1,
+	} {
+		got := testutil.ToFloat64(metric.DeployedVersionQueryResultTotal.WithLabelValues(
+			serviceID, lookup.GetType(), result))
+		if got != want {
+			t.Errorf("%s %s count mismatch\ngot:  %f\nwant: %f",
+				prefix, result, got, want)
+		}
+	}
+
+	// WHEN: the Lookup is queried with a method other than GET.
+	lookup.Method = http.MethodPost
+	_ = lookup.Query(false, logx.LogFrom{})
+
+	// THEN: the request is unconditional.
+	if got := lastIfModifiedSince(); got != "" {
+		t.Errorf("%s POST If-Modified-Since mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "")
+	}
+}
+
 func TestLookup_GetVersion(t *testing.T) {
 	const (
 		jsonBody  = `{"bar":"1.2.2","foo":{"bar":{"version":"3.2.1"}}}`
@@ -821,6 +841,36 @@ func TestLookup_GetVersion(t *testing.T) {
 }
 
 func TestLookup_HTTPRequest(t *testing.T) {
+	// OAuth2 token endpoint.
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientID, _, _ := r.BasicAuth(); clientID != "id" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"abc","token_type":"Bearer","expires_in":3600}`)
+	}))
+	t.Cleanup(tokenServer.Close)
+	// Server requiring the OAuth2 token.
+	oauth2Server := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer abc" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, "1.2.3")
+		}))
+	}
+	// Server with a self-signed certificate, and a CA file to trust it.
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "1.2.3")
+	}))
+	t.Cleanup(tlsServer.Close)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("%s\nfailed writing CA file: %v", packageName, err)
+	}
+
 	// GIVEN: a Lookup.
 	tests := []struct {
 		name        string
@@ -913,6 +963,33 @@ func TestLookup_HTTPRequest(t *testing.T) {
 			`),
 			errRegex: `non-2XX response code: 401`,
 		},
+		{
+			name:        "oauth2/pass",
+			serverSetup: oauth2Server,
+			overrides: test.TrimYAML(`
+				oauth2:
+					token_url: ` + tokenServer.URL + `
+					client_id: id
+					client_secret: secret
+			`),
+			bodyRegex: `^1\.2\.3$`,
+			errRegex:  `^$`,
+		},
+		{
+			name:        "oauth2/token request fails",
+			serverSetup: oauth2Server,
+			overrides: test.TrimYAML(`
+				oauth2:
+					token_url: ` + tokenServer.URL + `
+					client_id: unknown
+			`),
+			errRegex: `oauth2 token request to "[^"]+" gave 401 Unauthorized`,
+		},
+		{
+			name:        "oauth2/missing",
+			serverSetup: oauth2Server,
+			errRegex:    `non-2XX response code: 401`,
+		},
 		{
 			name: "self-signed cert/pass",
 			overrides: test.TrimYAML(`
@@ -929,6 +1006,35 @@ func TestLookup_HTTPRequest(t *testing.T) {
 			`),
 			errRegex: `x509 \(certificate invalid\)`,
 		},
+		{
+			name: "tls/ca_file/pass",
+			overrides: test.TrimYAML(`
+				url: ` + tlsServer.URL + `
+				tls:
+					ca_file: ` + caFile + `
+					server_name: example.com
+				allow_invalid_certs: false
+			`),
+			bodyRegex: `^1\.2\.3$`,
+			errRegex:  `^$`,
+		},
+		{
+			name: "tls/ca_file/not given",
+			overrides: test.TrimYAML(`
+				url: ` + tlsServer.URL + `
+				allow_invalid_certs: false
+			`),
+			errRegex: `x509 \(certificate invalid\)`,
+		},
+		{
+			name: "tls/ca_file/missing",
+			overrides: test.TrimYAML(`
+				url: ` + tlsServer.URL + `
+				tls:
+					ca_file: ` + caFile + `.missing
+			`),
+			errRegex: `^tls:\s+ca_file: "[^"]+" <invalid> \(failed to read file\)$`,
+		},
 		{
 			name: "target_header/2XX, header found",
 			serverSetup: func() *httptest.Server {
@@ -994,7 +1100,7 @@ func TestLookup_HTTPRequest(t *testing.T) {
 			}
 
 			// WHEN: httpRequest is called on it.
-			body, err := lookup.httpRequest(logx.LogFrom{})
+			body, _, err := lookup.httpRequest(nil, logx.LogFrom{})
 
 			prefix := fmt.Sprintf("%s\nLookup.httpRequest()", packageName)
 
@@ -16,8 +16,13 @@
 package web
 
 import (
+	"slices"
+
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/service/deployed_version/types/base"
+	"github.com/release-argus/Argus/service/latest_version/filter"
+	opt "github.com/release-argus/Argus/service/option"
 	"github.com/release-argus/Argus/service/shared"
 	"github.com/release-argus/Argus/service/status"
 	"github.com/release-argus/Argus/util"
@@ -38,17 +43,26 @@ var Type = "url"
 type Lookup struct {
 	base.Lookup `json:",inline" yaml:",inline"`
 
-	Method            string `json:"method,omitzero" yaml:"method,omitzero"`                           // REQUIRED: HTTP method.
-	URL               string `json:"url,omitzero" yaml:"url,omitzero"`                                 // REQUIRED: url to query.
-	AllowInvalidCerts *bool  `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
-	TargetHeader      string `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // OPTIONAL: header to target for the version.
+	Method            string       `json:"method,omitzero" yaml:"method,omitzero"`                           // REQUIRED: HTTP method.
+	URL               string       `json:"url,omitzero" yaml:"url,omitzero"`                                 // REQUIRED: url to query.
+	AllowInvalidCerts *bool        `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *httpx.TLS   `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // OPTIONAL: CA bundle, client certificate and server name.
+	Proxy             *httpx.Proxy `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // OPTIONAL: HTTP/SOCKS5 proxy to send requests through.
+	TargetHeader      string       `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // OPTIONAL: header to target for the version.
+	Targets           Targets      `json:"targets,omitempty" yaml:"targets,omitempty"`                       // OPTIONAL: instances to query in place of the URL.
+	Quorum            *int         `json:"quorum,omitzero" yaml:"quorum,omitzero"`                           // OPTIONAL: number of targets that must report a version before it is deployed. Default - all.
 
 	BasicAuth     *BasicAuth     `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`         // OPTIONAL: basic auth credentials.
+	OAuth2        *httpx.OAuth2  `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                 // OPTIONAL: OAuth2 client-credentials to get a bearer token with.
 	Headers       shared.Headers `json:"headers,omitempty" yaml:"headers,omitempty"`             // OPTIONAL: request headers.
 	Body          string         `json:"body,omitzero" yaml:"body,omitzero"`                     // OPTIONAL: request body.
 	JSON          string         `json:"json,omitzero" yaml:"json,omitzero"`                     // OPTIONAL: JSON key to use e.g. version_current.
 	Regex         string         `json:"regex,omitzero" yaml:"regex,omitzero"`                   // OPTIONAL: regex for the version.
 	RegexTemplate string         `json:"regex_template,omitzero" yaml:"regex_template,omitzero"` // OPTIONAL: template to apply to the RegEx match.
+
+	URLCommands filter.URLCommands `json:"url_commands,omitempty" yaml:"url_commands,omitempty"` // OPTIONAL: commands to filter the version from the JSON/header/RegEx result.
+
+	validators *httpx.CacheValidators // ETag and Last-Modified of the last response.
 }
 
 // BasicAuth to use on the HTTP(s) request.
@@ -109,6 +123,17 @@ func (l *Lookup) String(prefix string) string {
 // # STATE #
 // #########
 
+// Init wires the dependencies into the receiver as pointers,
+// and resets the validators used for conditional requests.
+func (l *Lookup) Init(
+	options *opt.Options,
+	status *status.Status,
+	cfg base.DefaultsConfig,
+) {
+	l.Lookup.Init(options, status, cfg)
+	l.validators = &httpx.CacheValidators{}
+}
+
 // Copy returns a deep copy of the receiver.
 func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
 	if l == nil {
@@ -120,13 +145,19 @@ func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
 		Method:            l.Method,
 		URL:               l.URL,
 		AllowInvalidCerts: util.ClonePtr(l.AllowInvalidCerts),
+		TLS:               l.TLS.Copy(),
+		Proxy:             l.Proxy.Copy(),
 		TargetHeader:      l.TargetHeader,
+		Targets:           slices.Clone(l.Targets),
+		Quorum:            util.ClonePtr(l.Quorum),
 		BasicAuth:         l.BasicAuth.Copy(),
+		OAuth2:            l.OAuth2.Copy(),
 		Headers:           l.Headers.Copy(),
 		Body:              l.Body,
 		JSON:              l.JSON,
 		Regex:             l.Regex,
 		RegexTemplate:     l.RegexTemplate,
+		URLCommands:       slices.Clone(l.URLCommands),
 	}
 }
 
@@ -142,7 +173,7 @@ func (b *BasicAuth) Copy() *BasicAuth {
 	}
 }
 
-// InheritSecrets copies the BasicAuth password and header secrets from otherLookup.
+// InheritSecrets copies the BasicAuth password, OAuth2 client secret, proxy password and header secrets from otherLookup.
 func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
 	if otherL, ok := otherLookup.(*Lookup); ok {
 		if l.BasicAuth != nil &&
@@ -150,6 +181,8 @@ func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shar
 			otherL.BasicAuth != nil {
 			l.BasicAuth.Password = otherL.BasicAuth.Password
 		}
+		l.OAuth2.InheritSecrets(otherL.OAuth2)
+		l.Proxy.InheritSecrets(otherL.Proxy)
 
 		if secretRefs != nil {
 			l.Headers.InheritSecrets(otherL.Headers, secretRefs.Headers)
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/deployed_version/types/base"
 	"github.com/release-argus/Argus/service/deployed_version/types/web/constants"
@@ -419,7 +420,19 @@ func TestLookup_Copy(t *testing.T) {
 								basic_auth:
 									username: user
 									password: pass
+								oauth2:
+									token_url: https://example.com/token
+									client_id: id
+									client_secret: secret
+									scopes:
+										- read
+									audience: api
 								target_header: X-Foo
+								targets:
+									- name: a
+										url: https://a.example.com
+									- url: https://b.example.com
+								quorum: 1
 								headers:
 									- key: X-Something
 										value: foo
@@ -523,6 +536,8 @@ func TestLookup_Copy(t *testing.T) {
 				{Name: "URL", Got: got.URL, Want: tc.lookup.URL, Mode: test.CompareEqual},
 				{Name: "AllowInvalidCerts", Got: got.AllowInvalidCerts, Want: tc.lookup.AllowInvalidCerts, Mode: test.CompareDifferentPointer},
 				{Name: "TargetHeader", Got: got.TargetHeader, Want: tc.lookup.TargetHeader, Mode: test.CompareEqual},
+				{Name: "Targets", Got: &got.Targets, Want: &tc.lookup.Targets, Mode: test.CompareDifferentPointer},
+				{Name: "Quorum", Got: got.Quorum, Want: tc.lookup.Quorum, Mode: test.CompareDifferentPointer},
 				{Name: "BasicAuth", Got: got.BasicAuth, Want: tc.lookup.BasicAuth, Mode: test.CompareDifferentPointer},
 				{Name: "Headers", Got: &got.Headers, Want: &tc.lookup.Headers, Mode: test.CompareDifferentPointer},
 				{Name: "Body", Got: got.Body, Want: tc.lookup.Body, Mode: test.CompareEqual},
@@ -640,6 +655,27 @@ func TestLookup_InheritSecrets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "inherit OAuth2 client_secret",
+			lookup: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: util.SecretValue,
+				},
+			},
+			other: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: "secret",
+				},
+			},
+			want: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "id",
+					ClientSecret: "secret",
+				},
+			},
+		},
 		{
 			name: "inherit headers",
 			lookup: &Lookup{
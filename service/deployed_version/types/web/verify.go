@@ -18,7 +18,6 @@ package web
 import (
 	"errors"
 	"net/http"
-	"regexp"
 	"slices"
 	"strings"
 
@@ -32,7 +31,7 @@ func (l *Lookup) CheckValues() error {
 	var errs []error
 
 	// URL.
-	if l.URL == "" {
+	if l.URL == "" && len(l.Targets) == 0 {
 		errs = append(
 			errs,
 			&decode.ErrField{
@@ -41,6 +40,20 @@ func (l *Lookup) CheckValues() error {
 			},
 		)
 	}
+	// Targets.
+	if err := l.Targets.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "targets",
+				Err: err,
+			},
+		)
+	}
+	// Quorum.
+	if err := l.checkQuorum(); err != nil {
+		errs = append(errs, err)
+	}
 
 	// Method.
 	l.Method = strings.ToUpper(l.Method)
@@ -60,35 +73,42 @@ func (l *Lookup) CheckValues() error {
 		l.Body = ""
 	}
 
-	// JSON.
-	if l.JSON != "" {
-		_, err := decode.ParseKeys(l.JSON)
-		if err != nil {
-			errs = append(
-				errs,
-				&decode.ErrField{
-					Key:         "json",
-					Value:       l.JSON,
-					Description: "JSON path to the version in the response",
-				},
-			)
-		}
+	// TLS.
+	if err := l.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
 	}
 
-	// RegEx.
-	if l.Regex != "" {
-		_, err := regexp.Compile(l.Regex)
-		if err != nil {
-			errs = append(
-				errs,
-				&decode.ErrField{
-					Key:         "regex",
-					Value:       l.Regex,
-					Description: "RegEx to extract the version from the response",
-				},
-			)
-		}
+	// Proxy.
+	if err := l.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+
+	// OAuth2.
+	if err := l.OAuth2.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "oauth2",
+				Err: err,
+			},
+		)
 	}
+
+	// JSON/RegEx.
+	extraction := l.extraction()
+	errs = append(errs, extraction.CheckValues()...)
 	// Remove the RegExTemplate if no RegEx.
 	if l.Regex == "" {
 		l.RegexTemplate = ""
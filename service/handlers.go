@@ -22,6 +22,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	serviceinfo "github.com/release-argus/Argus/service/status/info"
 	"github.com/release-argus/Argus/util"
@@ -255,7 +256,7 @@ func (s *Service) UpdatedVersion(writeToDB bool) {
 		}
 		return
 	}
-	s.Status.SetDeployedVersion(s.Status.LatestVersion(), "", writeToDB)
+	s.Status.SetDeployedVersionFrom(s.Status.LatestVersion(), "", dbtype.SourceActions, writeToDB)
 
 	// Announce version change to WebSocket clients.
 	s.Status.AnnounceUpdate()
@@ -264,6 +265,6 @@ func (s *Service) UpdatedVersion(writeToDB bool) {
 // UpdateLatestApproved sets the latest version as approved if not already set.
 func (s *Service) UpdateLatestApproved() {
 	if lv := s.Status.LatestVersion(); lv != s.Status.ApprovedVersion() {
-		s.Status.SetApprovedVersion(lv, true)
+		s.Status.SetApprovedVersionFrom(lv, dbtype.SourceActions, true)
 	}
 }
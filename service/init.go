@@ -104,6 +104,7 @@ func (s *Service) init(
 		&s.Status,
 		notifyCfg,
 	)
+	s.initReminderNotify(notifyCfg)
 
 	// 	If the dashboard icon is not set, use the first icon from a Notify.
 	if s.Dashboard.GetIcon() == "" && s.Notify != nil {
@@ -150,6 +151,7 @@ func (s *Service) initMetrics() {
 		s.DeployedVersionLookup.InitMetrics(s.DeployedVersionLookup)
 	}
 	s.Notify.InitMetrics()
+	s.reminderNotify.InitMetrics()
 	s.CommandController.InitMetrics()
 	s.WebHook.InitMetrics()
 	s.Status.InitMetrics()
@@ -169,6 +171,7 @@ func (s *Service) deleteMetrics() {
 		s.DeployedVersionLookup.DeleteMetrics(s.DeployedVersionLookup)
 	}
 	s.Notify.DeleteMetrics()
+	s.reminderNotify.DeleteMetrics()
 	s.CommandController.DeleteMetrics()
 	s.WebHook.DeleteMetrics()
 	s.Status.DeleteMetrics()
@@ -18,6 +18,7 @@ package latestver
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/release-argus/Argus/config/decode"
@@ -288,6 +289,7 @@ func TestDefaults_Default(t *testing.T) {
 			UsePreRelease: new(false),
 		},
 		URL: web.Defaults{
+			Method:            http.MethodGet,
 			AllowInvalidCerts: new(false),
 		},
 	}
@@ -77,6 +77,13 @@ func (l *Lookup) DeleteMetrics(parentLookup BaseInterface) {
 		lookupType,
 		metric.ActionResultFail,
 	)
+	metric.DeletePrometheusCounter(
+		metric.LatestVersionQueryResultTotal,
+		serviceID,
+		"",
+		lookupType,
+		metric.ActionResultNotModified,
+	)
 }
 
 // QueryMetrics sets the Prometheus metrics for the LatestVersion query.
@@ -117,3 +124,24 @@ func (l *Lookup) QueryMetrics(parentLookup BaseInterface, err error) {
 		result,
 	)
 }
+
+// NotModifiedMetrics sets the Prometheus metrics for a LatestVersion query that returned a 304 Not Modified.
+func (l *Lookup) NotModifiedMetrics(parentLookup BaseInterface) {
+	serviceID := l.GetServiceID()
+	serviceType := parentLookup.GetType()
+
+	// Set liveness.
+	metric.SetPrometheusGauge(
+		metric.LatestVersionQueryResultLast,
+		serviceID, serviceType,
+		float64(metric.LatestVersionQueryResultSuccess),
+	)
+	// Increase not modified count.
+	metric.IncPrometheusCounter(
+		metric.LatestVersionQueryResultTotal,
+		serviceID,
+		"",
+		serviceType,
+		metric.ActionResultNotModified,
+	)
+}
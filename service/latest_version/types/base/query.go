@@ -18,6 +18,7 @@ package base
 import (
 	"fmt"
 
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 )
 
@@ -30,7 +31,7 @@ func (l *Lookup) HandleNewVersion(version, releaseDate string, logFrom logx.LogF
 	if l.Status.LatestVersion() == "" {
 		l.Status.SetLatestVersion(version, releaseDate, true)
 		if l.Status.DeployedVersion() == "" {
-			l.Status.SetDeployedVersion(version, "", true)
+			l.Status.SetDeployedVersionFrom(version, "", dbtype.SourceLatestVersion, true)
 		}
 		msg := fmt.Sprintf("Latest Release - %q", version)
 		logx.Info(msg, logFrom, true)
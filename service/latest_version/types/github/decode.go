@@ -42,6 +42,7 @@ func (l *Lookup) DecodeSelf(format string, data []byte) error {
 	l.Lookup = newL.Lookup
 	l.AccessToken = newL.AccessToken
 	l.UsePreRelease = newL.UsePreRelease
+	l.Proxy = newL.Proxy
 	l.data = Data{}
 
 	return nil
@@ -19,7 +19,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -152,21 +151,21 @@ func (l *Lookup) createRequest(page int, logFrom logx.LogFrom) (*http.Request, e
 
 // getResponse makes the request and returns the response, response body, and any errors encountered.
 func (l *Lookup) getResponse(req *http.Request, logFrom logx.LogFrom) (*http.Response, []byte, error) {
-	// Make the request.
-	resp, err := httpx.Client.Do(req)
+	client, err := httpx.ClientFor(nil, l.Proxy, false)
 	if err != nil {
+		err = fmt.Errorf("proxy: %w", err)
 		logx.Error(err, logFrom, true)
-		return nil, nil, err //nolint:wrapcheck
+		return nil, nil, err
 	}
-	logx.Debug("GET "+req.URL.String(), logFrom, true)
 
-	// Read the response body.
-	defer resp.Body.Close()
-	body, err := io.ReadAll(io.LimitReader(resp.Body, 50<<20)) // Limit to 50 MiB.
+	// Make the request, sharing the response with identical requests.
+	resp, body, err := httpx.Do(client, req)
 	if err != nil {
 		logx.Error(err, logFrom, true)
 		return nil, nil, err //nolint:wrapcheck
 	}
+	logx.Debug("GET "+req.URL.String(), logFrom, true)
+
 	return resp, body, nil
 }
 
@@ -21,8 +21,8 @@ import (
 	"github.com/release-argus/Argus/util"
 )
 
-// InheritSecrets copies the access token and GitHub data from fromLookup when querying the same repository,
-// then delegates to the base.
+// InheritSecrets copies the access token, proxy password and GitHub data from fromLookup
+// when querying the same repository, then delegates to the base.
 func (l *Lookup) InheritSecrets(fromLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
 	// Check whether inheriting from a GitHub Lookup.
 	if oldGitHubLookup, ok := fromLookup.(*Lookup); ok {
@@ -30,6 +30,7 @@ func (l *Lookup) InheritSecrets(fromLookup base.BaseInterface, secretRefs *share
 		if l.AccessToken == util.SecretValue {
 			l.AccessToken = oldGitHubLookup.AccessToken
 		}
+		l.Proxy.InheritSecrets(oldGitHubLookup.Proxy)
 		// Querying the same GitHub repo, and the ETag differs.
 		if l.URL == oldGitHubLookup.URL && l.data.ETag() != oldGitHubLookup.data.ETag() {
 			// Inherit the GitHub data.
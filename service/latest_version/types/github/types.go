@@ -17,6 +17,7 @@ package github
 
 import (
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/service/latest_version/types/base"
 	"github.com/release-argus/Argus/service/status"
 )
@@ -36,8 +37,9 @@ var Type = "github"
 type Lookup struct {
 	base.Lookup `json:",inline" yaml:",inline"`
 
-	AccessToken   string `json:"access_token,omitzero" yaml:"access_token,omitzero"`     // GitHub access token to use.
-	UsePreRelease *bool  `json:"use_prerelease,omitzero" yaml:"use_prerelease,omitzero"` // Whether releases with the prerelease tag should be considered.
+	AccessToken   string       `json:"access_token,omitzero" yaml:"access_token,omitzero"`     // GitHub access token to use.
+	UsePreRelease *bool        `json:"use_prerelease,omitzero" yaml:"use_prerelease,omitzero"` // Whether releases with the prerelease tag should be considered.
+	Proxy         *httpx.Proxy `json:"proxy,omitzero" yaml:"proxy,omitzero"`                   // OPTIONAL: HTTP/SOCKS5 proxy to send requests through.
 
 	data Data // GitHub Conditional Request vars / Releases.
 
@@ -47,8 +49,9 @@ type Lookup struct {
 
 // LookupDecode is an unmarshal-only helper for [Lookup].
 type LookupDecode struct {
-	AccessToken   string `json:"access_token,omitzero" yaml:"access_token,omitzero"`
-	UsePreRelease *bool  `json:"use_prerelease,omitzero" yaml:"use_prerelease,omitzero"`
+	AccessToken   string       `json:"access_token,omitzero" yaml:"access_token,omitzero"`
+	UsePreRelease *bool        `json:"use_prerelease,omitzero" yaml:"use_prerelease,omitzero"`
+	Proxy         *httpx.Proxy `json:"proxy,omitzero" yaml:"proxy,omitzero"`
 }
 
 // ############
@@ -76,6 +79,7 @@ func (l *Lookup) unmarshal(format string, data []byte) error {
 	aux := LookupDecode{
 		AccessToken:   l.AccessToken,
 		UsePreRelease: l.UsePreRelease,
+		Proxy:         l.Proxy,
 	}
 
 	// Unmarshal in the given format.
@@ -84,6 +88,7 @@ func (l *Lookup) unmarshal(format string, data []byte) error {
 	}
 	l.AccessToken = aux.AccessToken
 	l.UsePreRelease = aux.UsePreRelease
+	l.Proxy = aux.Proxy
 	l.data.SetETag(getEmptyListETag())
 
 	// Require.
@@ -130,6 +135,7 @@ func (l *Lookup) Clone(svcStatus *status.Status) *Lookup {
 		Lookup:           *l.Lookup.Clone(svcStatus), //nolint:staticcheck
 		AccessToken:      l.AccessToken,
 		UsePreRelease:    usePreRelease,
+		Proxy:            l.Proxy.Copy(),
 		data:             *l.data.Copy(),
 		typeDefaults:     l.typeDefaults,
 		typeHardDefaults: l.typeHardDefaults,
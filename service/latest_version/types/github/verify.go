@@ -39,6 +39,16 @@ func (l *Lookup) CheckValues() error {
 		l.URL = strings.Join(parts[len(parts)-2:], "/")
 	}
 
+	if err := l.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+
 	if baseErrs := l.Lookup.CheckValues(); baseErrs != nil {
 		errs = append(errs, baseErrs)
 	}
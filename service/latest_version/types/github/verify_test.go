@@ -20,6 +20,7 @@ package github
 import (
 	"testing"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/latest_version/filter"
 )
@@ -30,6 +31,7 @@ func TestLookup_CheckValues(t *testing.T) {
 		url         *string
 		require     *filter.Require
 		urlCommands *filter.URLCommands
+		proxy       *httpx.Proxy
 	}
 	tests := []struct {
 		name     string
@@ -81,6 +83,18 @@ func TestLookup_CheckValues(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid proxy",
+			errRegex: test.TrimYAML(`
+				^proxy:
+					url: "ftp://proxy:21" <invalid>.*$`,
+			),
+			args: args{
+				proxy: &httpx.Proxy{
+					URL: "ftp://proxy:21",
+				},
+			},
+		},
 		{
 			name: "all decode",
 			errRegex: test.TrimYAML(`
@@ -117,6 +131,7 @@ func TestLookup_CheckValues(t *testing.T) {
 			if tc.args.urlCommands != nil {
 				input.URLCommands = *tc.args.urlCommands
 			}
+			input.Proxy = tc.args.proxy
 
 			_ = test.AssertCheckValuesWithError(
 				t,
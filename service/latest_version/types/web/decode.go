@@ -40,8 +40,15 @@ func (l *Lookup) DecodeSelf(format string, data []byte) error {
 	}
 
 	l.Lookup = newL.Lookup
+	l.Method = newL.Method
 	l.AllowInvalidCerts = newL.AllowInvalidCerts
+	l.TLS = newL.TLS
 	l.Headers = newL.Headers
+	l.BasicAuth = newL.BasicAuth
+	l.OAuth2 = newL.OAuth2
+	l.Proxy = newL.Proxy
+	l.Body = newL.Body
+	l.TargetHeader = newL.TargetHeader
 
 	return nil
 }
@@ -14,18 +14,77 @@
 
 package web
 
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/service/deployed_version/types/web/constants"
+	"github.com/release-argus/Argus/util/polymorphic"
+)
+
 // Defaults are the URL-specific default values for a Lookup.
 type Defaults struct {
-	AllowInvalidCerts *bool `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	Method            string     `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
+	AllowInvalidCerts *bool      `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *httpx.TLS `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (d Defaults) IsZero() bool {
-	return d.AllowInvalidCerts == nil
+	return d.Method == "" &&
+		d.AllowInvalidCerts == nil &&
+		d.TLS.IsZero()
 }
 
 // Default sets the values of the receiver to their default values.
 func (d *Defaults) Default() {
+	d.Method = http.MethodGet
 	allowInvalidCerts := false
 	d.AllowInvalidCerts = &allowInvalidCerts
 }
+
+// method returns the Method of the receiver, or "" if it is nil.
+func (d *Defaults) method() string {
+	if d == nil {
+		return ""
+	}
+	return d.Method
+}
+
+// CheckValues validates the fields of the receiver.
+func (d *Defaults) CheckValues() error {
+	var errs []error
+
+	// Method.
+	d.Method = strings.ToUpper(d.Method)
+	if d.Method != "" && !slices.Contains(constants.SupportedMethods, d.Method) {
+		errs = append(
+			errs,
+			polymorphic.ErrInvalidType{
+				Key:     "method",
+				Value:   d.Method,
+				Allowed: constants.SupportedMethods,
+			},
+		)
+	}
+
+	// TLS.
+	if err := d.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
@@ -18,6 +18,9 @@ package web
 
 import (
 	"testing"
+
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/internal/test"
 )
 
 func TestDefaults_IsZero(t *testing.T) {
@@ -32,6 +35,13 @@ func TestDefaults_IsZero(t *testing.T) {
 			data: &Defaults{},
 			want: true,
 		},
+		{
+			name: "non-empty/Method",
+			data: &Defaults{
+				Method: "POST",
+			},
+			want: false,
+		},
 		{
 			name: "non-empty/AllowInvalidCerts",
 			data: &Defaults{
@@ -39,6 +49,13 @@ func TestDefaults_IsZero(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "non-empty/TLS",
+			data: &Defaults{
+				TLS: &httpx.TLS{ServerName: "example.com"},
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -63,6 +80,7 @@ func TestDefaults_Default(t *testing.T) {
 	// GIVEN: a Defaults.
 	defaults := Defaults{}
 	want := Defaults{
+		Method:            "GET",
 		AllowInvalidCerts: new(false),
 	}
 
@@ -70,6 +88,12 @@ func TestDefaults_Default(t *testing.T) {
 	defaults.Default()
 
 	// THEN: it should set the defaults as expected.
+	if defaults.Method != want.Method {
+		t.Errorf(
+			"%s\nDefaults.Default() Method mismatch\ngot:  %q\nwant: %q",
+			packageName, defaults.Method, want.Method,
+		)
+	}
 	if defaults.AllowInvalidCerts == nil || *defaults.AllowInvalidCerts != *want.AllowInvalidCerts {
 		t.Errorf(
 			"%s\nDefaults.Default() AllowInvalidCerts mismatch\ngot:  %v\nwant: %v",
@@ -77,3 +101,76 @@ func TestDefaults_Default(t *testing.T) {
 		)
 	}
 }
+
+func TestDefaults_CheckValues(t *testing.T) {
+	// GIVEN: Defaults.
+	tests := []struct {
+		name     string
+		input    *Defaults
+		errRegex string
+	}{
+		{
+			name:     "empty",
+			input:    &Defaults{},
+			errRegex: `^$`,
+		},
+		{
+			name: "valid method",
+			input: &Defaults{
+				Method: "post",
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "invalid method",
+			input: &Defaults{
+				Method: "put",
+			},
+			errRegex: `^method: "PUT" <invalid>.*$`,
+		},
+		{
+			name: "invalid method and TLS",
+			input: &Defaults{
+				Method: "put",
+				TLS:    &httpx.TLS{CertFile: "cert.pem"},
+			},
+			errRegex: test.TrimYAML(`
+				^method: "PUT" <invalid>.*
+				tls:
+					key_file: <required>.*$`,
+			),
+		},
+		{
+			name: "valid TLS",
+			input: &Defaults{
+				TLS: &httpx.TLS{ServerName: "example.com"},
+			},
+			errRegex: `^$`,
+		},
+		{
+			name: "invalid TLS",
+			input: &Defaults{
+				TLS: &httpx.TLS{CertFile: "cert.pem"},
+			},
+			errRegex: test.TrimYAML(`
+				^tls:
+					key_file: <required>.*$`,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: CheckValues is called.
+			// THEN: the error is as expected.
+			_ = test.AssertCheckValuesWithError(
+				t,
+				packageName,
+				tc.errRegex,
+				tc.input.CheckValues,
+			)
+		})
+	}
+}
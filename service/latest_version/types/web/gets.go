@@ -16,6 +16,11 @@
 package web
 
 import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/util"
 )
 
@@ -32,3 +37,31 @@ func (l *Lookup) allowInvalidCerts() bool {
 		l.typeHardDefaults.AllowInvalidCerts,
 	)
 }
+
+// tls resolves the TLS settings, merging those of the Lookup with its defaults.
+func (l *Lookup) tls() *httpx.TLS {
+	return httpx.MergeTLS(
+		l.TLS,
+		l.typeDefaults.TLS,
+		l.typeHardDefaults.TLS,
+	)
+}
+
+// method resolves the HTTP method to use for requests.
+func (l *Lookup) method() string {
+	return util.FirstNonDefault(
+		l.Method,
+		l.typeDefaults.method(),
+		l.typeHardDefaults.method(),
+		http.MethodGet,
+	)
+}
+
+// body returns the request Body with env vars and Service info templated in.
+func (l *Lookup) body() io.Reader {
+	if l.Body == "" {
+		return nil
+	}
+	body := util.TemplateString(util.EvalEnvVars(l.Body), l.Status.GetServiceInfo())
+	return strings.NewReader(body)
+}
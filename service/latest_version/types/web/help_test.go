@@ -21,6 +21,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/internal/test"
 	logtest "github.com/release-argus/Argus/internal/test/log"
@@ -37,6 +38,8 @@ var packageName = "latestver_web"
 func TestMain(m *testing.M) {
 	// Log.
 	logtest.InitLog()
+	// Don't share responses between the queries of a test.
+	httpx.SharedTTL = 0
 
 	// Run other tests.
 	exitCode := m.Run()
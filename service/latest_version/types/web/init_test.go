@@ -159,6 +159,10 @@ func TestLookup_Init(t *testing.T) {
 			if err := test.AssertFields(t, fieldTests, prefix, "Lookup"); err != nil {
 				t.Fatal(err)
 			}
+			// AND: the validators for conditional requests are set.
+			if l.validators == nil {
+				t.Errorf("%s .validators was not set", prefix)
+			}
 
 			// AND: the Require is given the correct defaults.
 			if l.Require != nil && l.Require.Docker != nil {
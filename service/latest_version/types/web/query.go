@@ -18,7 +18,6 @@ package web
 import (
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"sort"
 	"strings"
@@ -32,57 +31,90 @@ import (
 
 // Query fetches the URL, sets Prometheus metrics if requested, and returns whether a new version was found.
 func (l *Lookup) Query(metrics bool, logFrom logx.LogFrom) (bool, error) {
-	isNewVersion, err := l.query(logFrom)
+	isNewVersion, notModified, err := l.query(logFrom)
 
 	if metrics {
-		l.QueryMetrics(l, err)
+		if notModified {
+			l.NotModifiedMetrics(l)
+		} else {
+			l.QueryMetrics(l, err)
+		}
 	}
 
 	return isNewVersion, err
 }
 
-// query fetches the URL and returns whether a new version was found, updating LatestVersion if so.
-func (l *Lookup) query(logFrom logx.LogFrom) (bool, error) {
-	body, err := l.httpRequest(logFrom)
+// query fetches the URL and returns whether a new version was found, updating LatestVersion if so,
+// and whether the URL responded 304 Not Modified.
+func (l *Lookup) query(logFrom logx.LogFrom) (bool, bool, error) {
+	body, notModified, err := l.httpRequest(l.conditionalValidators(), logFrom)
 	if err != nil {
-		return false, err
+		// Make the next query unconditional.
+		l.validators.Reset()
+		return false, false, err
+	}
+
+	// Unchanged since the last query.
+	if notModified {
+		l.Status.SetLastQueried("")
+		l.Status.AnnounceQuery()
+		return false, true, nil
 	}
 
 	version, err := l.getVersion(string(body), logFrom)
 	if err != nil {
-		return false, err
+		// Make the next query unconditional.
+		l.validators.Reset()
+		return false, false, err
 	}
 
 	l.Status.SetLastQueried("")
 
 	// If this version differs (new?).
 	if previousVersion := l.Status.LatestVersion(); version != previousVersion {
-		return l.HandleNewVersion(version, "", logFrom) //nolint:wrapcheck
+		isNewVersion, err := l.HandleNewVersion(version, "", logFrom)
+		return isNewVersion, false, err //nolint:wrapcheck
 	}
 
 	// Announce `LastQueried`.
 	l.Status.AnnounceQuery()
 	// No version change.
-	return false, nil
+	return false, false, nil
 }
 
-// httpRequest makes a HTTP GET request to the URL and returns the body.
-func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
-	client := httpx.Client
-	// HTTPS insecure skip verify.
-	if l.allowInvalidCerts() {
-		client = httpx.InsecureClient
+// conditionalValidators returns the validators to make the query conditional with,
+// or nil if the Require filters check more than the response (a Command or Docker tag),
+// as their result can change while the URL content doesn't.
+func (l *Lookup) conditionalValidators() *httpx.CacheValidators {
+	if l.Require != nil &&
+		(len(l.Require.Command) != 0 || l.Require.Docker != nil) {
+		return nil
+	}
+
+	return l.validators
+}
+
+// httpRequest makes a HTTP request to the URL and returns the body, or the value of the TargetHeader.
+//
+// GET requests are made conditional with validators (when non-nil),
+// returning true with a nil body when the URL responds 304 Not Modified.
+func (l *Lookup) httpRequest(validators *httpx.CacheValidators, logFrom logx.LogFrom) ([]byte, bool, error) {
+	client, err := httpx.ClientFor(l.tls(), l.Proxy, l.allowInvalidCerts())
+	if err != nil {
+		err = fmt.Errorf("tls: %w", err)
+		logx.Error(err, logFrom, true)
+		return nil, false, err
 	}
 
 	// Create the request.
-	req, err := http.NewRequest(http.MethodGet, l.URL, nil)
+	req, err := http.NewRequest(l.method(), l.URL, l.body())
 	if err != nil {
 		err = fmt.Errorf(
 			"failed creating http request for %q: %w",
 			l.URL, err,
 		)
 		logx.Error(err, logFrom, true)
-		return nil, err
+		return nil, false, err
 	}
 
 	// Set headers.
@@ -92,25 +124,57 @@ func (l *Lookup) httpRequest(logFrom logx.LogFrom) ([]byte, error) {
 			util.EvalEnvVars(header.Value),
 		)
 	}
+	// Basic auth.
+	if l.BasicAuth != nil {
+		req.SetBasicAuth(
+			util.EvalEnvVars(l.BasicAuth.Username),
+			util.EvalEnvVars(l.BasicAuth.Password),
+		)
+	}
+	// Conditional request.
+	if req.Method != http.MethodGet {
+		validators = nil
+	}
+	conditional := validators.Apply(req)
+	// OAuth2.
+	if err := l.OAuth2.Authorize(req, client); err != nil {
+		logx.Error(err, logFrom, true)
+		return nil, false, err //nolint:wrapcheck
+	}
 
-	// Send the request.
-	resp, err := client.Do(req)
+	// Send the request, sharing the response with identical requests.
+	resp, body, err := httpx.Do(client, req)
 	if err != nil {
 		// Don't crash on invalid certs.
 		if strings.Contains(err.Error(), "x509") {
 			err = errors.New("x509 (certificate invalid)")
 			logx.Warn(err, logFrom, true)
-			return nil, err
+			return nil, false, err
 		}
 		logx.Error(err, logFrom, true)
-		return nil, err
+		return nil, false, err
+	}
+
+	// Unchanged since the last request.
+	if conditional && resp.StatusCode == http.StatusNotModified {
+		logx.Debug("304 Not Modified", logFrom, true)
+		return nil, true, nil
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		validators.Update(resp.Header)
+	}
+
+	// Read the version from the TargetHeader.
+	if l.TargetHeader != "" {
+		if headerValue := resp.Header.Get(l.TargetHeader); headerValue != "" {
+			return []byte(headerValue), false, nil
+		}
+		err := fmt.Errorf("target header %q not found (status: %d)", l.TargetHeader, resp.StatusCode)
+		logx.Warn(err, logFrom, true)
+		return nil, false, err
 	}
 
-	// Read the response body.
-	defer resp.Body.Close()
-	body, err := io.ReadAll(io.LimitReader(resp.Body, 50<<20)) // Limit to 50 MiB.
-	logx.Error(err, logFrom, err != nil)
-	return body, err //nolint:wrapcheck
+	return body, false, nil
 }
 
 // getVersion returns the latest version from `body` that matches the URLCommands, and Regex requirements.
@@ -146,6 +210,10 @@ func (l *Lookup) getVersion(body string, logFrom logx.LogFrom) (string, error) {
 	var firstErr error
 	for _, version := range filteredVersions {
 		if err := l.versionMeetsRequirements(version, body, logFrom); err == nil {
+			// Make the next query unconditional, so the rejected releases are checked again.
+			if firstErr != nil {
+				l.validators.Reset()
+			}
 			return version, nil
 		} else if firstErr == nil {
 			firstErr = err
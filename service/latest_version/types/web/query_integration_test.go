@@ -35,7 +35,7 @@ import (
 
 func TestLookup_Query(t *testing.T) {
 	testLookupVersions := testLookup(t, false)
-	_, _ = testLookupVersions.query(logx.LogFrom{})
+	_, _, _ = testLookupVersions.query(logx.LogFrom{})
 
 	type statusVars struct {
 		latestVersion, latestVersionWant string
@@ -19,9 +19,14 @@ package web
 
 import (
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"sort"
+	"sync"
 	"testing"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/latest_version/filter"
@@ -102,7 +107,7 @@ func TestLookup_HTTPRequest(t *testing.T) {
 			}
 
 			// WHEN: httpRequest is called on it.
-			body, err := lookup.httpRequest(logx.LogFrom{})
+			body, _, err := lookup.httpRequest(nil, logx.LogFrom{})
 
 			prefix := fmt.Sprintf("%s\nLookup.httpRequest", packageName)
 
@@ -128,6 +133,293 @@ func TestLookup_HTTPRequest(t *testing.T) {
 	}
 }
 
+func TestLookup_HTTPRequest__request(t *testing.T) {
+	// GIVEN: a server that echoes the request.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		username, password, _ := r.BasicAuth()
+		w.Header().Set("X-Version", "1.2.3")
+		_, _ = fmt.Fprintf(w, "%s|%s|%s:%s", r.Method, body, username, password)
+	}))
+	t.Cleanup(server.Close)
+	// AND: a Lookup.
+	tests := []struct {
+		name      string
+		overrides string
+		bodyRegex string
+		errRegex  string
+	}{
+		{
+			name:      "default method",
+			overrides: ``,
+			bodyRegex: `^GET\|\|:$`,
+			errRegex:  `^$`,
+		},
+		{
+			name: "POST with templated body",
+			overrides: test.TrimYAML(`
+				method: POST
+				body: '{"id":"{{ service_id }}"}'
+			`),
+			bodyRegex: `^POST\|{"id":"web-testLookup"}\|:$`,
+			errRegex:  `^$`,
+		},
+		{
+			name: "basic auth",
+			overrides: test.TrimYAML(`
+				basic_auth:
+					username: user
+					password: pass
+			`),
+			bodyRegex: `^GET\|\|user:pass$`,
+			errRegex:  `^$`,
+		},
+		{
+			name: "target_header found",
+			overrides: test.TrimYAML(`
+				target_header: x-version
+			`),
+			bodyRegex: `^1\.2\.3$`,
+			errRegex:  `^$`,
+		},
+		{
+			name: "target_header not found",
+			overrides: test.TrimYAML(`
+				target_header: X-Unknown
+			`),
+			bodyRegex: `^$`,
+			errRegex:  `^target header "X-Unknown" not found \(status: 200\)$`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t, false)
+			lookup.URL = server.URL
+			// Apply overrides.
+			if err := lookup.ApplyOverrides("yaml", []byte(tc.overrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal overrides: %s",
+					packageName, err,
+				)
+			}
+
+			// WHEN: httpRequest is called on it.
+			body, _, err := lookup.httpRequest(nil, logx.LogFrom{})
+
+			prefix := fmt.Sprintf("%s\nLookup.httpRequest", packageName)
+
+			// THEN: the error is as expected.
+			e := errfmt.FormatError(err)
+			if !util.RegexCheck(tc.errRegex, e) {
+				t.Errorf(
+					"%s error mismatch\ngot:  %q\nwant: %q",
+					prefix, e, tc.errRegex,
+				)
+			}
+
+			// AND: the body matches the expected regex.
+			if !util.RegexCheck(tc.bodyRegex, string(body)) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, string(body), tc.bodyRegex,
+				)
+			}
+		})
+	}
+}
+
+func TestLookup_Query__conditional(t *testing.T) {
+	// GIVEN: a server that supports conditional requests.
+	var (
+		mu          sync.Mutex
+		eTag        = `"v1"`
+		body        = "ver1.2.3"
+		ifNoneMatch string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		ifNoneMatch = r.Header.Get("If-None-Match")
+		if ifNoneMatch == eTag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", eTag)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	lastIfNoneMatch := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return ifNoneMatch
+	}
+	// AND: a Lookup of that server.
+	lookup := testLookup(t, false)
+	lookup.URL = server.URL
+	lookup.validators = &httpx.CacheValidators{}
+	prefix := fmt.Sprintf("%s\nLookup.query()", packageName)
+
+	// WHEN: the Lookup is queried for the first time.
+	_, notModified, err := lookup.query(logx.LogFrom{})
+
+	// THEN: the request is unconditional, and the version is parsed.
+	if err != nil || notModified {
+		t.Fatalf("%s first query\ngot:  notModified=%t, err=%v\nwant: notModified=false, err=nil",
+			prefix, notModified, err)
+	}
+	if got := lastIfNoneMatch(); got != "" {
+		t.Errorf("%s first query If-None-Match mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "")
+	}
+	if got := lookup.Status.LatestVersion(); got != "1.2.3" {
+		t.Errorf("%s first query LatestVersion mismatch\ngot:  %q\nwant: %q",
+			prefix, got, "1.2.3")
+	}
+
+	// WHEN: the Lookup is queried again.
+	_, notModified, err = lookup.query(logx.LogFrom{})
+
+	// THEN: the request is conditional, and the 304 is reported.
+	if err != nil || !notModified {
+		t.Fatalf("%s second query\ngot:  notModified=%t, err=%v\nwant: notModified=true, err=nil",
+			prefix, notModified, err)
+	}
+	if got := lastIfNoneMatch(); got != `"v1"` {
+		t.Errorf("%s second query If-None-Match mismatch\ngot:  %q\nwant: %q",
+			prefix, got, `"v1"`)
+	}
+
+	// WHEN: the page changes to one without a version.
+	mu.Lock()
+	eTag, body = `"v2"`, "no version here"
+	mu.Unlock()
+	_, notModified, err = lookup.query(logx.LogFrom{})
+
+	// THEN: the parse fails.
+	if err == nil || notModified {
+		t.Fatalf("%s third query\ngot:  notModified=%t, err=%v\nwant: notModified=false, err=non-nil",
+			prefix, notModified, err)
+	}
+
+	// WHEN: the Lookup is queried again.
+	_, notModified, _ = lookup.query(logx.LogFrom{})
+
+	// THEN: the request is unconditional, so the body is re-parsed.
+	if got := lastIfNoneMatch(); got != "" || notModified {
+		t.Errorf("%s query after failure\ngot:  If-None-Match=%q, notModified=%t\nwant: If-None-Match=%q, notModified=false",
+			prefix, got, notModified, "")
+	}
+}
+
+func TestLookup_Query__conditionalRequire(t *testing.T) {
+	// GIVEN: a Lookup with Require filters, on a server that supports conditional requests.
+	tests := []struct {
+		name            string
+		body            string
+		lookupOverrides string
+		wantVersion     string
+		wantConditional bool
+	}{
+		{
+			name: "require.regex_version accepts the newest release",
+			body: "ver1.2.3",
+			lookupOverrides: test.TrimYAML(`
+				require:
+					regex_version: ^1\.[0-9.]+$
+			`),
+			wantVersion:     "1.2.3",
+			wantConditional: true,
+		},
+		{
+			name: "require.command",
+			body: "ver1.2.3",
+			lookupOverrides: test.TrimYAML(`
+				require:
+					command: ["true"]
+			`),
+			wantVersion:     "1.2.3",
+			wantConditional: false,
+		},
+		{
+			name: "require rejected a newer release",
+			body: "ver1.2.4 ver1.2.3",
+			lookupOverrides: test.TrimYAML(`
+				require:
+					regex_version: ^1\.2\.3$
+			`),
+			wantVersion:     "1.2.3",
+			wantConditional: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu          sync.Mutex
+				eTag        = `"v1"`
+				ifNoneMatch string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				ifNoneMatch = r.Header.Get("If-None-Match")
+				if ifNoneMatch == eTag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", eTag)
+				_, _ = io.WriteString(w, tc.body)
+			}))
+			t.Cleanup(server.Close)
+			lookup := testLookup(t, false)
+			if err := lookup.ApplyOverrides("yaml", []byte(tc.lookupOverrides)); err != nil {
+				t.Fatalf(
+					"%s\nfailed to unmarshal Lookup overrides: %v",
+					packageName, err,
+				)
+			}
+			// Verified as on load, removing the unused Docker requirement from the defaults.
+			if err := lookup.Require.CheckValues(); err != nil {
+				t.Fatalf(
+					"%s\nLookup.Require.CheckValues() error: %v",
+					packageName, err,
+				)
+			}
+			lookup.URL = server.URL
+			lookup.validators = &httpx.CacheValidators{}
+			prefix := fmt.Sprintf("%s\nLookup.query()", packageName)
+
+			// WHEN: the Lookup is queried twice.
+			if _, _, err := lookup.query(logx.LogFrom{}); err != nil {
+				t.Fatalf("%s first query\ngot:  err=%v\nwant: err=nil",
+					prefix, err)
+			}
+			_, notModified, err := lookup.query(logx.LogFrom{})
+
+			// THEN: the version is found.
+			if got := lookup.Status.LatestVersion(); got != tc.wantVersion {
+				t.Errorf("%s LatestVersion mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantVersion)
+			}
+			// AND: the second request is only conditional when the Require filters allow it.
+			mu.Lock()
+			gotConditional := ifNoneMatch != ""
+			mu.Unlock()
+			if err != nil ||
+				gotConditional != tc.wantConditional ||
+				notModified != tc.wantConditional {
+				t.Errorf("%s second query\ngot:  conditional=%t, notModified=%t, err=%v\nwant: conditional=%t, notModified=%t, err=nil",
+					prefix, gotConditional, notModified, err, tc.wantConditional, tc.wantConditional)
+			}
+		})
+	}
+}
+
 func TestLookup_GetVersion(t *testing.T) {
 	// GIVEN: a Lookup and a Body to filter.
 	body := `
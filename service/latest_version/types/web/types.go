@@ -17,9 +17,12 @@ package web
 
 import (
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/service/latest_version/types/base"
+	opt "github.com/release-argus/Argus/service/option"
 	"github.com/release-argus/Argus/service/shared"
 	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
 )
 
 // #############
@@ -37,17 +40,39 @@ var Type = "url"
 type Lookup struct {
 	base.Lookup `json:",inline" yaml:",inline"`
 
+	Method            string         `json:"method,omitzero" yaml:"method,omitzero"`                           // OPTIONAL: HTTP method. Default - GET.
 	AllowInvalidCerts *bool          `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Allow invalid SSL certificates.
+	TLS               *httpx.TLS     `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // OPTIONAL: CA bundle, client certificate and server name.
 	Headers           shared.Headers `json:"headers,omitempty" yaml:"headers,omitempty"`                       // OPTIONAL: request headers.
+	BasicAuth         *BasicAuth     `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`                   // OPTIONAL: basic auth credentials.
+	OAuth2            *httpx.OAuth2  `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OPTIONAL: OAuth2 client-credentials to get a bearer token with.
+	Proxy             *httpx.Proxy   `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // OPTIONAL: HTTP/SOCKS5 proxy to send requests through.
+	Body              string         `json:"body,omitzero" yaml:"body,omitzero"`                               // OPTIONAL: request body, templated with the Service info.
+	TargetHeader      string         `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // OPTIONAL: response header to filter the version from, in place of the body.
 
 	typeDefaults     *Defaults // URL-specific Defaults.
 	typeHardDefaults *Defaults // URL-specific Hard Defaults.
+
+	validators *httpx.CacheValidators // ETag and Last-Modified of the last response.
 }
 
 // LookupDecode is an unmarshal-only helper for [Lookup].
 type LookupDecode struct {
+	Method            string         `json:"method,omitzero" yaml:"method,omitzero"`
 	AllowInvalidCerts *bool          `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"`
+	TLS               *httpx.TLS     `json:"tls,omitzero" yaml:"tls,omitzero"`
 	Headers           shared.Headers `json:"headers,omitempty" yaml:"headers,omitempty"`
+	BasicAuth         *BasicAuth     `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`
+	OAuth2            *httpx.OAuth2  `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`
+	Proxy             *httpx.Proxy   `json:"proxy,omitzero" yaml:"proxy,omitzero"`
+	Body              string         `json:"body,omitzero" yaml:"body,omitzero"`
+	TargetHeader      string         `json:"target_header,omitzero" yaml:"target_header,omitzero"`
+}
+
+// BasicAuth to use on the HTTP(s) request.
+type BasicAuth struct {
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
 }
 
 // ############
@@ -73,16 +98,30 @@ func (l *Lookup) unmarshal(format string, data []byte) error {
 	}
 
 	aux := LookupDecode{
+		Method:            l.Method,
 		AllowInvalidCerts: l.AllowInvalidCerts,
+		TLS:               l.TLS,
 		Headers:           l.Headers,
+		BasicAuth:         l.BasicAuth,
+		OAuth2:            l.OAuth2,
+		Proxy:             l.Proxy,
+		Body:              l.Body,
+		TargetHeader:      l.TargetHeader,
 	}
 
 	// Unmarshal in the given format.
 	if err := decode.Unmarshal(format, data, &aux); err != nil {
 		return err //nolint:wrapcheck
 	}
+	l.Method = aux.Method
 	l.AllowInvalidCerts = aux.AllowInvalidCerts
+	l.TLS = aux.TLS
 	l.Headers = aux.Headers
+	l.BasicAuth = aux.BasicAuth
+	l.OAuth2 = aux.OAuth2
+	l.Proxy = aux.Proxy
+	l.Body = aux.Body
+	l.TargetHeader = aux.TargetHeader
 
 	// Normalise Type.
 	if l.Type == "web" {
@@ -125,13 +164,32 @@ func (l *Lookup) Clone(svcStatus *status.Status) *Lookup {
 
 	return &Lookup{
 		Lookup:            *l.Lookup.Clone(svcStatus), //nolint:staticcheck
+		Method:            l.Method,
 		AllowInvalidCerts: l.AllowInvalidCerts,
+		TLS:               l.TLS.Copy(),
 		Headers:           l.Headers.Copy(),
+		BasicAuth:         l.BasicAuth.Copy(),
+		OAuth2:            l.OAuth2.Copy(),
+		Proxy:             l.Proxy.Copy(),
+		Body:              l.Body,
+		TargetHeader:      l.TargetHeader,
 		typeDefaults:      l.typeDefaults,
 		typeHardDefaults:  l.typeHardDefaults,
 	}
 }
 
+// Copy returns a deep copy of the receiver.
+func (b *BasicAuth) Copy() *BasicAuth {
+	if b == nil {
+		return nil
+	}
+
+	return &BasicAuth{
+		Username: b.Username,
+		Password: b.Password,
+	}
+}
+
 // Copy returns a deep copy of the receiver as a [base.Interface].
 func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
 	if got := l.Clone(svcStatus); got != nil {
@@ -140,10 +198,20 @@ func (l *Lookup) Copy(svcStatus *status.Status) base.Interface {
 	return nil
 }
 
-// InheritSecrets copies header secrets from otherLookup and delegates to the base.
+// InheritSecrets copies the BasicAuth password, OAuth2 client secret, proxy password and header secrets
+// from otherLookup and delegates to the base.
 func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shared.VSecretRef) {
-	if otherL, ok := otherLookup.(*Lookup); ok && secretRefs != nil {
-		l.Headers.InheritSecrets(otherL.Headers, secretRefs.Headers)
+	if otherL, ok := otherLookup.(*Lookup); ok {
+		if l.BasicAuth != nil &&
+			l.BasicAuth.Password == util.SecretValue &&
+			otherL.BasicAuth != nil {
+			l.BasicAuth.Password = otherL.BasicAuth.Password
+		}
+		l.OAuth2.InheritSecrets(otherL.OAuth2)
+		l.Proxy.InheritSecrets(otherL.Proxy)
+		if secretRefs != nil {
+			l.Headers.InheritSecrets(otherL.Headers, secretRefs.Headers)
+		}
 	}
 
 	l.Lookup.InheritSecrets(otherLookup, secretRefs)
@@ -153,6 +221,17 @@ func (l *Lookup) InheritSecrets(otherLookup base.BaseInterface, secretRefs *shar
 // # DEFAULTS #
 // ############
 
+// Init wires the dependencies into the receiver as pointers,
+// and resets the validators used for conditional requests.
+func (l *Lookup) Init(
+	options *opt.Options,
+	status *status.Status,
+	cfg base.DefaultsConfig,
+) {
+	l.Lookup.Init(options, status, cfg)
+	l.validators = &httpx.CacheValidators{}
+}
+
 // SetTypeDefaults assigns the URL-specific Defaults/HardDefaults to the receiver.
 func (l *Lookup) SetTypeDefaults(defaults, hardDefaults *Defaults) {
 	l.typeDefaults = defaults
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/latest_version/filter"
 	"github.com/release-argus/Argus/service/latest_version/filter/docker"
@@ -555,6 +556,66 @@ func TestLookup_InheritSecrets(t *testing.T) {
 						value: B
 			`),
 		},
+		{
+			name: "inherit OAuth2 client_secret",
+			lookup: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "argus",
+					ClientSecret: util.SecretValue,
+				},
+			},
+			previous: &Lookup{
+				OAuth2: &httpx.OAuth2{
+					ClientID:     "argus",
+					ClientSecret: "shh",
+				},
+			},
+			want: test.TrimYAML(`
+				oauth2:
+					client_id: argus
+					client_secret: shh
+			`),
+		},
+		{
+			name: "inherit BasicAuth password",
+			lookup: &Lookup{
+				BasicAuth: &BasicAuth{
+					Username: "user",
+					Password: util.SecretValue,
+				},
+			},
+			previous: &Lookup{
+				BasicAuth: &BasicAuth{
+					Username: "user",
+					Password: "shh",
+				},
+			},
+			want: test.TrimYAML(`
+				basic_auth:
+					username: user
+					password: shh
+			`),
+		},
+		{
+			name: "BasicAuth password not inherited when changed",
+			lookup: &Lookup{
+				BasicAuth: &BasicAuth{
+					Username: "user",
+					Password: "new",
+				},
+			},
+			previous: &Lookup{
+				BasicAuth: &BasicAuth{
+					Username: "user",
+					Password: "shh",
+				},
+			},
+			want: test.TrimYAML(`
+				basic_auth:
+					username: user
+					password: new
+			`),
+		},
 	}
 
 	for _, tc := range tests {
@@ -17,8 +17,14 @@ package web
 
 import (
 	"errors"
+	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/service/deployed_version/types/web/constants"
+	"github.com/release-argus/Argus/util"
+	"github.com/release-argus/Argus/util/polymorphic"
 )
 
 // CheckValues validates the fields of the receiver.
@@ -34,6 +40,63 @@ func (l *Lookup) CheckValues() error {
 		)
 	}
 
+	// Method.
+	l.Method = strings.ToUpper(l.Method)
+	method := l.method()
+	if !slices.Contains(constants.SupportedMethods, method) {
+		errs = append(
+			errs,
+			polymorphic.ErrInvalidType{
+				Key:     "method",
+				Value:   method,
+				Allowed: constants.SupportedMethods,
+			},
+		)
+	}
+	// Body unused in GET, ensure it is empty.
+	if method == http.MethodGet {
+		l.Body = ""
+	} else if !util.CheckTemplate(l.Body) {
+		errs = append(
+			errs,
+			&decode.ErrField{
+				Key:         "body",
+				Value:       l.Body,
+				Description: "didn't pass templating",
+			},
+		)
+	}
+
+	if err := l.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
+	}
+
+	if err := l.OAuth2.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "oauth2",
+				Err: err,
+			},
+		)
+	}
+
+	if err := l.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+
 	if baseErrs := l.Lookup.CheckValues(); baseErrs != nil {
 		errs = append(errs, baseErrs)
 	}
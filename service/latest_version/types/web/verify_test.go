@@ -19,6 +19,7 @@ package web
 import (
 	"testing"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/latest_version/filter"
 	"github.com/release-argus/Argus/service/latest_version/types/base"
@@ -40,6 +41,37 @@ func TestLookup_CheckValues(t *testing.T) {
 			input:    &Lookup{},
 			errRegex: `^url: <required>[^\n]+$`,
 		},
+		{
+			name: "Invalid Method",
+			input: &Lookup{
+				Lookup: base.Lookup{
+					URL: "https://example.com",
+				},
+				Method: "put",
+			},
+			errRegex: `^method: "PUT" <invalid>.*$`,
+		},
+		{
+			name: "Invalid Body template",
+			input: &Lookup{
+				Lookup: base.Lookup{
+					URL: "https://example.com",
+				},
+				Method: "POST",
+				Body:   "{{ version }",
+			},
+			errRegex: `^body: "{{ version }" <invalid>.*templating.*$`,
+		},
+		{
+			name: "Body ignored for GET",
+			input: &Lookup{
+				Lookup: base.Lookup{
+					URL: "https://example.com",
+				},
+				Method: "get",
+				Body:   "{{ version }",
+			},
+		},
 		{
 			name: "Invalid Require",
 			input: &Lookup{
@@ -73,6 +105,55 @@ func TestLookup_CheckValues(t *testing.T) {
 						type: "foo" <invalid>.*$`,
 			),
 		},
+		{
+			name: "Invalid OAuth2",
+			input: &Lookup{
+				Lookup: base.Lookup{URL: "https://example.com"},
+				OAuth2: &httpx.OAuth2{
+					TokenURL: "https://example.com/token",
+				},
+			},
+			errRegex: test.TrimYAML(`
+				^oauth2:
+					client_id: <required>.*$`,
+			),
+		},
+		{
+			name: "Invalid TLS",
+			input: &Lookup{
+				Lookup: base.Lookup{URL: "https://example.com"},
+				TLS: &httpx.TLS{
+					CAFile: "does-not-exist.pem",
+				},
+			},
+			errRegex: test.TrimYAML(`
+				^tls:
+					ca_file: "does-not-exist.pem" <invalid>.*$`,
+			),
+		},
+		{
+			name: "Invalid Proxy",
+			input: &Lookup{
+				Lookup: base.Lookup{URL: "https://example.com"},
+				Proxy: &httpx.Proxy{
+					URL: "ftp://proxy:21",
+				},
+			},
+			errRegex: test.TrimYAML(`
+				^proxy:
+					url: "ftp://proxy:21" <invalid>.*$`,
+			),
+		},
+		{
+			name: "Valid OAuth2",
+			input: &Lookup{
+				Lookup: base.Lookup{URL: "https://example.com"},
+				OAuth2: &httpx.OAuth2{
+					TokenURL: "https://example.com/token",
+					ClientID: "argus",
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -88,3 +169,62 @@ func TestLookup_CheckValues(t *testing.T) {
 		})
 	}
 }
+
+func TestLookup_CheckValues__Method(t *testing.T) {
+	// GIVEN: a Lookup with a Method and Body.
+	tests := []struct {
+		name         string
+		method, body string
+		wantMethod   string
+		wantBody     string
+	}{
+		{
+			name:       "GET clears Body",
+			method:     "get",
+			body:       "{{ version }}",
+			wantMethod: "GET",
+			wantBody:   "",
+		},
+		{
+			name:       "POST keeps Body",
+			method:     "post",
+			body:       "{{ version }}",
+			wantMethod: "POST",
+			wantBody:   "{{ version }}",
+		},
+		{
+			name:       "default method clears Body",
+			method:     "",
+			body:       "foo",
+			wantMethod: "",
+			wantBody:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := testLookup(t, false)
+			lookup.Method = tc.method
+			lookup.Body = tc.body
+
+			// WHEN: CheckValues is called.
+			err := lookup.CheckValues()
+
+			// THEN: the Method and Body are as expected.
+			if err != nil {
+				t.Fatalf("%s\nunexpected error: %v",
+					packageName, err)
+			}
+			if lookup.Method != tc.wantMethod {
+				t.Errorf("%s\nMethod mismatch\ngot:  %q\nwant: %q",
+					packageName, lookup.Method, tc.wantMethod)
+			}
+			if lookup.Body != tc.wantBody {
+				t.Errorf("%s\nBody mismatch\ngot:  %q\nwant: %q",
+					packageName, lookup.Body, tc.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"time"
+
+	"github.com/release-argus/Argus/util"
+)
+
+// GetBackoff returns the delay before retrying a failed query.
+func (o *Options) GetBackoff() string {
+	return util.FirstNonDefault(
+		o.Backoff,
+		o.Defaults.Backoff,
+		o.HardDefaults.Backoff,
+	)
+}
+
+// GetBackoffMax returns the longest delay before retrying a failed query.
+func (o *Options) GetBackoffMax() string {
+	return util.FirstNonDefault(
+		o.BackoffMax,
+		o.Defaults.BackoffMax,
+		o.HardDefaults.BackoffMax,
+	)
+}
+
+// GetBackoffDuration returns the delay before retrying a query that has failed `failures` times in a row.
+//
+// The delay starts at the backoff and doubles with each further failure, up to the backoff_max.
+// It is 0 when the query has not failed, or the backoff is disabled (0s).
+func (o *Options) GetBackoffDuration(failures int) time.Duration {
+	initial, _ := time.ParseDuration(o.GetBackoff())
+	if failures <= 0 || initial <= 0 {
+		return 0
+	}
+
+	maximum, _ := time.ParseDuration(o.GetBackoffMax())
+	if maximum <= 0 {
+		maximum = initial
+	}
+
+	delay := initial
+	for range failures - 1 {
+		if delay >= maximum {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, maximum)
+}
@@ -0,0 +1,124 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptions_GetBackoff(t *testing.T) {
+	// GIVEN: Options with a backoff in the hard defaults and defaults.
+	options := testOptions(t)
+	options.HardDefaults.Backoff = "30s"
+	options.HardDefaults.BackoffMax = "1h"
+	options.Defaults.Backoff = "1m"
+
+	// WHEN: GetBackoff and GetBackoffMax are called.
+	gotBackoff := options.GetBackoff()
+	gotBackoffMax := options.GetBackoffMax()
+
+	// THEN: the closest values are used.
+	if gotBackoff != "1m" || gotBackoffMax != "1h" {
+		t.Errorf(
+			"%s\nOptions.GetBackoff()/GetBackoffMax() value mismatch\ngot:  %q/%q\nwant: %q/%q",
+			packageName, gotBackoff, gotBackoffMax, "1m", "1h",
+		)
+	}
+}
+
+func TestOptions_GetBackoffDuration(t *testing.T) {
+	// GIVEN: a backoff, backoff_max and number of failures.
+	tests := []struct {
+		name                string
+		backoff, backoffMax string
+		failures            int
+		want                time.Duration
+	}{
+		{
+			name:       "no failures",
+			backoff:    "30s",
+			backoffMax: "1h",
+			failures:   0,
+			want:       0,
+		},
+		{
+			name:       "first failure",
+			backoff:    "30s",
+			backoffMax: "1h",
+			failures:   1,
+			want:       30 * time.Second,
+		},
+		{
+			name:       "doubles with each failure",
+			backoff:    "30s",
+			backoffMax: "1h",
+			failures:   4,
+			want:       4 * time.Minute,
+		},
+		{
+			name:       "capped at backoff_max",
+			backoff:    "30s",
+			backoffMax: "1h",
+			failures:   100,
+			want:       time.Hour,
+		},
+		{
+			name:       "backoff_max below backoff",
+			backoff:    "10m",
+			backoffMax: "1m",
+			failures:   1,
+			want:       time.Minute,
+		},
+		{
+			name:     "no backoff_max",
+			backoff:  "30s",
+			failures: 3,
+			want:     30 * time.Second,
+		},
+		{
+			name:       "disabled",
+			backoff:    "0s",
+			backoffMax: "1h",
+			failures:   3,
+			want:       0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := &Options{
+				Base:         Base{Backoff: tc.backoff, BackoffMax: tc.backoffMax},
+				Defaults:     &Defaults{},
+				HardDefaults: &Defaults{},
+			}
+
+			// WHEN: GetBackoffDuration is called.
+			got := options.GetBackoffDuration(tc.failures)
+
+			// THEN: the delay is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nOptions.GetBackoffDuration(%d) value mismatch\ngot:  %s\nwant: %s",
+					packageName, tc.failures, got, tc.want,
+				)
+			}
+		})
+	}
+}
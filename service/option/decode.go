@@ -47,8 +47,7 @@ func Decode(
 		}
 	}
 
-	field.Defaults = cfg.Soft
-	field.HardDefaults = cfg.Hard
+	field.SetDefaults(cfg.Soft, cfg.Hard)
 
 	return &field, nil
 }
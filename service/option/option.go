@@ -16,6 +16,7 @@
 package option
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/cron"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/util"
 )
@@ -35,14 +37,26 @@ type DefaultsConfig struct {
 
 // Base is the base struct for Options.
 type Base struct {
-	Interval           string `json:"interval,omitzero" yaml:"interval,omitzero"`                       // AhBmCs = Sleep A hours, B minutes, and C seconds between queries.
-	SemanticVersioning *bool  `json:"semantic_versioning,omitzero" yaml:"semantic_versioning,omitzero"` // Default - true = Version has to follow semantic versioning (https://semver.org/), and be greater than the previous to trigger anything.
+	Interval           string    `json:"interval,omitzero" yaml:"interval,omitzero"`                       // AhBmCs = Sleep A hours, B minutes, and C seconds between queries.
+	Schedule           string    `json:"schedule,omitzero" yaml:"schedule,omitzero"`                       // Cron expression of when to query, used instead of the interval.
+	Jitter             string    `json:"jitter,omitzero" yaml:"jitter,omitzero"`                           // AhBmCs = Delay each query by a random duration up to this.
+	ActiveHours        []string  `json:"active_hours,omitempty" yaml:"active_hours,omitempty"`             // 'HH:MM-HH:MM' windows to limit queries to.
+	Backoff            string    `json:"backoff,omitzero" yaml:"backoff,omitzero"`                         // AhBmCs = Retry a failed query after this, doubling with each further failure.
+	BackoffMax         string    `json:"backoff_max,omitzero" yaml:"backoff_max,omitzero"`                 // AhBmCs = Longest to wait before retrying a failed query.
+	SemanticVersioning *bool     `json:"semantic_versioning,omitzero" yaml:"semantic_versioning,omitzero"` // Default - true = Version has to follow semantic versioning (https://semver.org/), and be greater than the previous to trigger anything.
+	Reminder           *Reminder `json:"reminder,omitzero" yaml:"reminder,omitzero"`                       // Remind that the latest version is still undeployed.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (b Base) IsZero() bool {
 	return b.Interval == "" &&
-		b.SemanticVersioning == nil
+		b.Schedule == "" &&
+		b.Jitter == "" &&
+		len(b.ActiveHours) == 0 &&
+		b.Backoff == "" &&
+		b.BackoffMax == "" &&
+		b.SemanticVersioning == nil &&
+		(b.Reminder == nil || b.Reminder.IsZero())
 }
 
 // Defaults are the default values for Options.
@@ -60,6 +74,10 @@ func (d *Defaults) Default() {
 	// interval.
 	d.Interval = "10m"
 
+	// backoff.
+	d.Backoff = "30s"
+	d.BackoffMax = "1h"
+
 	// semantic_versioning.
 	semanticVersioning := true
 	d.SemanticVersioning = &semanticVersioning
@@ -73,6 +91,8 @@ type Options struct {
 
 	Defaults     *Defaults `json:"-" yaml:"-"` // Defaults.
 	HardDefaults *Defaults `json:"-" yaml:"-"` // Hard Defaults.
+
+	scheduleInterval string // Shortest gap between the scheduled queries, set in SetDefaults.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
@@ -89,11 +109,18 @@ func (o *Options) Copy() *Options {
 	return &Options{
 		Base: Base{
 			Interval:           o.Interval,
+			Schedule:           o.Schedule,
+			Jitter:             o.Jitter,
+			ActiveHours:        util.CopySlice(o.ActiveHours),
+			Backoff:            o.Backoff,
+			BackoffMax:         o.BackoffMax,
 			SemanticVersioning: util.ClonePtr(o.SemanticVersioning),
+			Reminder:           o.Reminder.Copy(),
 		},
-		Active:       util.ClonePtr(o.Active),
-		Defaults:     o.Defaults,
-		HardDefaults: o.HardDefaults,
+		Active:           util.ClonePtr(o.Active),
+		Defaults:         o.Defaults,
+		HardDefaults:     o.HardDefaults,
+		scheduleInterval: o.scheduleInterval,
 	}
 }
 
@@ -114,6 +141,15 @@ func (o *Options) GetActive() bool {
 func (o *Options) SetDefaults(defaults, hardDefaults *Defaults) {
 	o.Defaults = defaults
 	o.HardDefaults = hardDefaults
+
+	// Fix the gap between scheduled queries so GetIntervalPointer and GetIntervalDuration agree.
+	o.scheduleInterval = ""
+	if defaults == nil || hardDefaults == nil {
+		return
+	}
+	if schedule := o.cronSchedule(); schedule != nil {
+		o.scheduleInterval = schedule.MinInterval(time.Now(), scheduleIntervalRuns).String()
+	}
 }
 
 // GetInterval returns the query interval between latest/deployed version checks.
@@ -152,7 +188,15 @@ func (o *Options) VerifySemanticVersioning(version string, logFrom logx.LogFrom)
 }
 
 // GetIntervalPointer returns a pointer to the interval between queries on latest/deployed version.
+//
+// With a schedule, this is the shortest gap between the scheduled queries.
 func (o *Options) GetIntervalPointer() *string {
+	if o.GetSchedule() != "" {
+		if o.scheduleInterval != "" {
+			return &o.scheduleInterval
+		}
+		return new(o.GetIntervalDuration().String())
+	}
 	if o.Interval != "" {
 		return &o.Interval
 	}
@@ -163,25 +207,85 @@ func (o *Options) GetIntervalPointer() *string {
 }
 
 // GetIntervalDuration returns the interval between queries on latest/deployed version.
+//
+// With a schedule, this is the shortest gap between the scheduled queries.
 func (o *Options) GetIntervalDuration() time.Duration {
+	if schedule := o.cronSchedule(); schedule != nil {
+		if o.scheduleInterval != "" {
+			d, _ := time.ParseDuration(o.scheduleInterval)
+			return d
+		}
+		return schedule.MinInterval(time.Now(), scheduleIntervalRuns)
+	}
+
 	d, _ := time.ParseDuration(o.GetInterval())
 	return d
 }
 
 // CheckValues validates the fields of the receiver.
 func (b *Base) CheckValues() error {
+	var errs []error
+
 	// interval.
-	if b.Interval != "" {
-		// Treat integers as seconds by default.
-		if _, err := strconv.Atoi(b.Interval); err == nil {
-			b.Interval += "s"
+	if err := checkDuration("interval", &b.Interval); err != nil {
+		errs = append(errs, err)
+	}
+	// schedule.
+	if b.Schedule != "" {
+		schedule, err := cron.Parse(b.Schedule)
+		if err == nil && schedule.Next(time.Now()).IsZero() {
+			err = errors.New("never matches")
 		}
-		if _, err := time.ParseDuration(b.Interval); err != nil {
-			return &decode.ErrField{
-				Key:         "interval",
-				Value:       b.Interval,
-				Description: "use 'AhBmCs' duration format",
-			}
+		if err != nil {
+			errs = append(errs, &decode.ErrField{
+				Key:         "schedule",
+				Value:       b.Schedule,
+				Description: err.Error(),
+			})
+		}
+	}
+	// jitter.
+	if err := checkDuration("jitter", &b.Jitter); err != nil {
+		errs = append(errs, err)
+	}
+	// active_hours.
+	if err := checkActiveHours(b.ActiveHours); err != nil {
+		errs = append(errs, err)
+	}
+	// backoff.
+	if err := checkDuration("backoff", &b.Backoff); err != nil {
+		errs = append(errs, err)
+	}
+	// backoff_max.
+	if err := checkDuration("backoff_max", &b.BackoffMax); err != nil {
+		errs = append(errs, err)
+	}
+	// reminder.
+	if err := b.Reminder.CheckValues(); err != nil {
+		errs = append(errs, &decode.ErrKeyField{
+			Key: "reminder",
+			Err: err,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkDuration validates the duration at value, treating integers as seconds.
+func checkDuration(key string, value *string) error {
+	if *value == "" {
+		return nil
+	}
+
+	// Treat integers as seconds by default.
+	if _, err := strconv.Atoi(*value); err == nil {
+		*value += "s"
+	}
+	if _, err := time.ParseDuration(*value); err != nil {
+		return &decode.ErrField{
+			Key:         key,
+			Value:       *value,
+			Description: "use 'AhBmCs' duration format",
 		}
 	}
 
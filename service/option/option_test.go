@@ -46,6 +46,27 @@ func TestBase_IsZero(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "non-empty/Schedule",
+			base: &Base{
+				Schedule: "0 9 * * 1-5",
+			},
+			want: false,
+		},
+		{
+			name: "non-empty/Jitter",
+			base: &Base{
+				Jitter: "5m",
+			},
+			want: false,
+		},
+		{
+			name: "non-empty/ActiveHours",
+			base: &Base{
+				ActiveHours: []string{"09:00-17:00"},
+			},
+			want: false,
+		},
 		{
 			name: "non-empty/SemanticVersioning",
 			base: &Base{
@@ -57,6 +78,9 @@ func TestBase_IsZero(t *testing.T) {
 			name: "non-empty/all",
 			base: &Base{
 				Interval:           "10s",
+				Schedule:           "0 9 * * 1-5",
+				Jitter:             "5m",
+				ActiveHours:        []string{"09:00-17:00"},
 				SemanticVersioning: new(true),
 			},
 			want: false,
@@ -164,11 +188,15 @@ func TestDefaults_Default(t *testing.T) {
 
 	// AND: the expected values.
 	wants := struct {
-		interval string
-		semVer   bool
+		interval   string
+		backoff    string
+		backoffMax string
+		semVer     bool
 	}{
-		interval: "10m",
-		semVer:   true,
+		interval:   "10m",
+		backoff:    "30s",
+		backoffMax: "1h",
+		semVer:     true,
 	}
 
 	for _, tc := range tests {
@@ -187,6 +215,12 @@ func TestDefaults_Default(t *testing.T) {
 					prefix, tc.defaults.Interval, wants.interval,
 				)
 			}
+			if tc.defaults.Backoff != wants.backoff || tc.defaults.BackoffMax != wants.backoffMax {
+				t.Errorf(
+					"%s .Backoff/.BackoffMax value mismatch\ngot:  %q/%q\nwant: %q/%q",
+					prefix, tc.defaults.Backoff, tc.defaults.BackoffMax, wants.backoff, wants.backoffMax,
+				)
+			}
 			if tc.defaults.SemanticVersioning == nil || *tc.defaults.SemanticVersioning != wants.semVer {
 				t.Errorf(
 					"%s .SemanticVersioning value mismatch\ngot:  %q\nwant: %t",
@@ -286,6 +320,9 @@ func TestOptions_Copy(t *testing.T) {
 			options: &Options{
 				Base: Base{
 					Interval:           "10s",
+					Schedule:           "0 9 * * 1-5",
+					Jitter:             "5m",
+					ActiveHours:        []string{"09:00-17:00"},
 					SemanticVersioning: new(true),
 				},
 				Active:       new(true),
@@ -757,19 +794,58 @@ func TestOptions_GetIntervalPointer(t *testing.T) {
 
 func TestOptions_GetIntervalDuration(t *testing.T) {
 	// GIVEN: Options.
-	options := testOptions(t)
-	options.Interval = "3h2m1s"
-
-	// WHEN: GetInterval is called.
-	got := options.GetIntervalDuration()
-
-	// THEN: the function returns the correct result.
-	want := (3 * time.Hour) + (2 * time.Minute) + time.Second
-	if got != want {
-		t.Errorf(
-			"%s\nOptions.GetIntervalDuration() value mismatch\ngot:  %v\nwant: %v",
-			packageName, got, want,
-		)
+	tests := []struct {
+		name               string
+		interval, schedule string
+		want               time.Duration
+	}{
+		{
+			name:     "interval",
+			interval: "3h2m1s",
+			want:     (3 * time.Hour) + (2 * time.Minute) + time.Second,
+		},
+		{
+			name:     "schedule is the shortest gap between queries",
+			interval: "3h2m1s",
+			schedule: "*/15 * * * *",
+			want:     15 * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := testOptions(t)
+			options.Interval = tc.interval
+			options.Schedule = tc.schedule
+			options.SetDefaults(options.Defaults, options.HardDefaults)
+
+			// WHEN: GetIntervalDuration is called.
+			got := options.GetIntervalDuration()
+
+			// THEN: the function returns the correct result.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nOptions.GetIntervalDuration() value mismatch\ngot:  %v\nwant: %v",
+					packageName, got, tc.want,
+				)
+			}
+			// AND: GetIntervalPointer agrees.
+			if got := *options.GetIntervalPointer(); got != tc.want.String() && tc.schedule != "" {
+				t.Errorf(
+					"%s\nOptions.GetIntervalPointer() value mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want.String(),
+				)
+			}
+			// AND: GetIntervalPointer returns the same pointer each call.
+			if got, again := options.GetIntervalPointer(), options.GetIntervalPointer(); got != again {
+				t.Errorf(
+					"%s\nOptions.GetIntervalPointer() pointer mismatch\ngot:  %p\nwant: %p",
+					packageName, again, got,
+				)
+			}
+		})
 	}
 }
 
@@ -811,6 +887,171 @@ func TestOptions_CheckValues(t *testing.T) {
 				)
 			}),
 		},
+		{
+			name:     "valid schedule, jitter and active_hours",
+			errRegex: `^$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						schedule: 0 9 * * mon-fri
+						jitter: 5m
+						active_hours:
+							- 09:00-17:00
+							- 22:00-02:00
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name:     "invalid schedule",
+			errRegex: `^schedule: "0 9 \* \*" <invalid> \(expected 5 fields .*, got 4\)$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						schedule: 0 9 * *
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name:     "schedule that never matches",
+			errRegex: `^schedule: "0 0 30 2 \*" <invalid> \(never matches\)$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						schedule: 0 0 30 2 *
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name:     "invalid jitter",
+			errRegex: `^jitter: "5x" <invalid> \(use 'AhBmCs' duration format\)$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						jitter: 5x
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name: "invalid active_hours",
+			errRegex: test.TrimYAML(`
+				^active_hours:
+					- item_0: "9-17" <invalid> \(use 'HH:MM-HH:MM' format\)
+					- item_2: "10:00-10:00" <invalid> \(start and end must differ\)$`),
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						active_hours:
+							- 9-17
+							- 09:00-17:00
+							- 10:00-10:00
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name:     "valid backoff",
+			errRegex: `^$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						backoff: 1m
+						backoff_max: 2h
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name: "invalid backoff",
+			errRegex: test.TrimYAML(`
+				^backoff: "1x" <invalid> \(use 'AhBmCs' duration format\)
+				backoff_max: "2y" <invalid> \(use 'AhBmCs' duration format\)$`),
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						backoff: 1x
+						backoff_max: 2y
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name:     "valid reminder",
+			errRegex: `^$`,
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						reminder:
+							after: 72h
+							every: 168h
+							escalate_after: 720h
+							escalate_notify:
+								- oncall
+							major:
+								after: 24h
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name: "invalid reminder",
+			errRegex: test.TrimYAML(`
+				^reminder:
+					after: "3d" <invalid> \(use 'AhBmCs' duration format\)
+					escalate_notify:
+						- item_0: <required>
+					patch:
+						every: "1w" <invalid> \(use 'AhBmCs' duration format\)$`),
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						reminder:
+							after: 3d
+							escalate_notify:
+								- ""
+							patch:
+								every: 1w
+					`)),
+					optCfg,
+				)
+			}),
+		},
+		{
+			name: "all invalid",
+			errRegex: test.TrimYAML(`
+				^interval: "10x" <invalid> .*
+				schedule: "foo" <invalid> .*
+				jitter: "5x" <invalid> .*
+				active_hours:
+					- item_0: "foo" <invalid> .*
+				backoff: "1x" <invalid> .*
+				backoff_max: "2y" <invalid> .*$`),
+			input: test.Must(t, func() (*Options, error) {
+				return Decode(
+					"yaml", []byte(test.TrimYAML(`
+						interval: 10x
+						schedule: foo
+						jitter: 5x
+						active_hours:
+							- foo
+						backoff: 1x
+						backoff_max: 2y
+					`)),
+					optCfg,
+				)
+			}),
+		},
 		{
 			name:         "seconds get appended to pure decimal interval",
 			errRegex:     `^$`,
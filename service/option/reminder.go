@@ -0,0 +1,262 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	serviceinfo "github.com/release-argus/Argus/service/status/info"
+	"github.com/release-argus/Argus/util"
+)
+
+// ReminderCadence is when to remind that a LatestVersion is still undeployed.
+type ReminderCadence struct {
+	After         string `json:"after,omitzero" yaml:"after,omitzero"`                   // AhBmCs = Remind once the LatestVersion has been undeployed this long (0s to never remind).
+	Every         string `json:"every,omitzero" yaml:"every,omitzero"`                   // AhBmCs = Then remind again this often (0s to remind once).
+	EscalateAfter string `json:"escalate_after,omitzero" yaml:"escalate_after,omitzero"` // AhBmCs = Also remind the escalate_notify once undeployed this long.
+}
+
+// IsZero implements the yaml.IsZeroer interface.
+func (c ReminderCadence) IsZero() bool {
+	return c.After == "" &&
+		c.Every == "" &&
+		c.EscalateAfter == ""
+}
+
+// CheckValues validates the fields of the receiver.
+func (c *ReminderCadence) CheckValues() error {
+	if c == nil {
+		return nil
+	}
+
+	var errs []error
+	// after.
+	if err := checkDuration("after", &c.After); err != nil {
+		errs = append(errs, err)
+	}
+	// every.
+	if err := checkDuration("every", &c.Every); err != nil {
+		errs = append(errs, err)
+	}
+	// escalate_after.
+	if err := checkDuration("escalate_after", &c.EscalateAfter); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Reminder is the policy for reminding that a LatestVersion is still undeployed.
+//
+// The Major/Minor/Patch cadences override the base cadence for releases of that semantic version bump.
+type Reminder struct {
+	ReminderCadence `json:",inline" yaml:",inline"`
+
+	EscalateNotify []string `json:"escalate_notify,omitempty" yaml:"escalate_notify,omitempty"` // IDs of the Notify to also remind once escalated.
+
+	Major *ReminderCadence `json:"major,omitzero" yaml:"major,omitzero"` // Cadence for major releases.
+	Minor *ReminderCadence `json:"minor,omitzero" yaml:"minor,omitzero"` // Cadence for minor releases.
+	Patch *ReminderCadence `json:"patch,omitzero" yaml:"patch,omitzero"` // Cadence for patch (and prerelease) releases.
+}
+
+// IsZero implements the yaml.IsZeroer interface.
+func (r Reminder) IsZero() bool {
+	return r.ReminderCadence.IsZero() &&
+		len(r.EscalateNotify) == 0 &&
+		(r.Major == nil || r.Major.IsZero()) &&
+		(r.Minor == nil || r.Minor.IsZero()) &&
+		(r.Patch == nil || r.Patch.IsZero())
+}
+
+// Copy returns a deep copy of the receiver.
+func (r *Reminder) Copy() *Reminder {
+	if r == nil {
+		return nil
+	}
+
+	return &Reminder{
+		ReminderCadence: r.ReminderCadence,
+		EscalateNotify:  util.CopySlice(r.EscalateNotify),
+		Major:           util.ClonePtr(r.Major),
+		Minor:           util.ClonePtr(r.Minor),
+		Patch:           util.ClonePtr(r.Patch),
+	}
+}
+
+// CheckValues validates the fields of the receiver.
+func (r *Reminder) CheckValues() error {
+	if r == nil {
+		return nil
+	}
+
+	var errs []error
+	if err := r.ReminderCadence.CheckValues(); err != nil {
+		errs = append(errs, err)
+	}
+	// escalate_notify.
+	var notifyErrs []error
+	for i, id := range r.EscalateNotify {
+		if id == "" {
+			notifyErrs = append(notifyErrs, &decode.ErrField{
+				Key: fmt.Sprintf("- item_%d", i),
+			})
+		}
+	}
+	if len(notifyErrs) != 0 {
+		errs = append(errs, &decode.ErrKeyField{
+			Key: "escalate_notify",
+			Err: errors.Join(notifyErrs...),
+		})
+	}
+	// major/minor/patch.
+	for _, level := range []struct {
+		key     string
+		cadence *ReminderCadence
+	}{
+		{key: "major", cadence: r.Major},
+		{key: "minor", cadence: r.Minor},
+		{key: "patch", cadence: r.Patch},
+	} {
+		if err := level.cadence.CheckValues(); err != nil {
+			errs = append(errs, &decode.ErrKeyField{
+				Key: level.key,
+				Err: err,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// cadences returns the cadence for releases of the semantic version bump level,
+// followed by the base cadence.
+func (r *Reminder) cadences(level string) []*ReminderCadence {
+	if r == nil {
+		return nil
+	}
+
+	var override *ReminderCadence
+	switch level {
+	case serviceinfo.BumpMajor:
+		override = r.Major
+	case serviceinfo.BumpMinor:
+		override = r.Minor
+	case serviceinfo.BumpPatch, serviceinfo.BumpPrerelease:
+		override = r.Patch
+	}
+
+	if override == nil {
+		return []*ReminderCadence{&r.ReminderCadence}
+	}
+	return []*ReminderCadence{override, &r.ReminderCadence}
+}
+
+// ReminderPolicy is the resolved reminder cadence of a release.
+type ReminderPolicy struct {
+	After         time.Duration // Remind once undeployed this long (0 to never remind).
+	Every         time.Duration // Then remind again this often (0 to remind once).
+	EscalateAfter time.Duration // Escalate once undeployed this long (0 to never escalate).
+}
+
+// reminders returns the Reminder of the Options, Defaults and then HardDefaults.
+func (o *Options) reminders() []*Reminder {
+	reminders := []*Reminder{o.Reminder}
+	for _, defaults := range []*Defaults{o.Defaults, o.HardDefaults} {
+		if defaults != nil {
+			reminders = append(reminders, defaults.Reminder)
+		}
+	}
+	return reminders
+}
+
+// GetReminder returns the reminder cadence of a release with the semantic version bump level
+// (see [serviceinfo.BumpLevel]).
+//
+// Each value is taken from the first set of the level cadence and then the base cadence,
+// of the Options, Defaults and then HardDefaults.
+func (o *Options) GetReminder(level string) ReminderPolicy {
+	var cadences []*ReminderCadence
+	for _, reminder := range o.reminders() {
+		cadences = append(cadences, reminder.cadences(level)...)
+	}
+
+	var after, every, escalateAfter []string
+	for _, cadence := range cadences {
+		after = append(after, cadence.After)
+		every = append(every, cadence.Every)
+		escalateAfter = append(escalateAfter, cadence.EscalateAfter)
+	}
+
+	var policy ReminderPolicy
+	policy.After, _ = time.ParseDuration(util.FirstNonDefault(after...))
+	policy.Every, _ = time.ParseDuration(util.FirstNonDefault(every...))
+	policy.EscalateAfter, _ = time.ParseDuration(util.FirstNonDefault(escalateAfter...))
+	return policy
+}
+
+// GetReminderActive reports whether a release of any semantic version bump level would be reminded of.
+func (o *Options) GetReminderActive() bool {
+	for _, level := range []string{serviceinfo.BumpMajor, serviceinfo.BumpMinor, serviceinfo.BumpPatch, serviceinfo.BumpNone} {
+		if o.GetReminder(level).After > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReminderEscalateNotify returns the IDs of the Notify to also remind once a reminder is escalated.
+func (o *Options) GetReminderEscalateNotify() []string {
+	var escalateNotify [][]string
+	for _, reminder := range o.reminders() {
+		if reminder != nil {
+			escalateNotify = append(escalateNotify, reminder.EscalateNotify)
+		}
+	}
+	return util.FirstNonEmptySlice(escalateNotify...)
+}
+
+// Next returns when the next reminder is due for a release published at `released`,
+// given when it was last reminded (zero if not yet), and whether that reminder was escalated.
+//
+// Returns whether that next reminder is escalated, and false if no further reminder is due.
+func (p ReminderPolicy) Next(released, lastReminded time.Time, lastEscalated bool) (time.Time, bool, bool) {
+	if p.After <= 0 {
+		return time.Time{}, false, false
+	}
+
+	var next time.Time
+	switch {
+	case lastReminded.IsZero():
+		next = released.Add(p.After)
+	case p.Every > 0:
+		next = lastReminded.Add(p.Every)
+	}
+
+	// Escalate at escalate_after, even if sooner than the next reminder.
+	if p.EscalateAfter <= 0 || lastEscalated {
+		if next.IsZero() {
+			return time.Time{}, false, false
+		}
+		return next, lastEscalated, true
+	}
+	escalateAt := released.Add(p.EscalateAfter)
+	if next.IsZero() || escalateAt.Before(next) {
+		next = escalateAt
+	}
+	return next, !next.Before(escalateAt), true
+}
@@ -0,0 +1,301 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package option
+
+import (
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/internal/test"
+)
+
+func TestOptions_GetReminder(t *testing.T) {
+	// GIVEN: Options with a reminder in the defaults, and overridden for the Service.
+	options := testOptions(t)
+	options.Defaults.Reminder = &Reminder{
+		ReminderCadence: ReminderCadence{After: "72h", Every: "168h"},
+		Major:           &ReminderCadence{After: "24h", EscalateAfter: "720h"},
+	}
+	options.Reminder = &Reminder{
+		ReminderCadence: ReminderCadence{Every: "48h"},
+		Patch:           &ReminderCadence{After: "336h", Every: "0s"},
+	}
+	tests := map[string]struct {
+		level string
+		want  ReminderPolicy
+	}{
+		"major - level override of the defaults": {
+			level: "major",
+			want: ReminderPolicy{
+				After:         24 * time.Hour,
+				Every:         48 * time.Hour,
+				EscalateAfter: 720 * time.Hour},
+		},
+		"minor - base cadence": {
+			level: "minor",
+			want: ReminderPolicy{
+				After: 72 * time.Hour,
+				Every: 48 * time.Hour},
+		},
+		"patch - level override of the service": {
+			level: "patch",
+			want: ReminderPolicy{
+				After: 336 * time.Hour},
+		},
+		"prerelease - uses the patch cadence": {
+			level: "prerelease",
+			want: ReminderPolicy{
+				After: 336 * time.Hour},
+		},
+		"not semantic - base cadence": {
+			level: "",
+			want: ReminderPolicy{
+				After: 72 * time.Hour,
+				Every: 48 * time.Hour},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: GetReminder is called for the level.
+			got := options.GetReminder(tc.level)
+
+			// THEN: each value comes from the closest cadence that sets it.
+			if got != tc.want {
+				t.Errorf("%s\nOptions.GetReminder(%q) mismatch\ngot:  %+v\nwant: %+v",
+					packageName, tc.level, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOptions_GetReminderActive(t *testing.T) {
+	// GIVEN: Options with different reminders.
+	tests := map[string]struct {
+		reminder, defaults *Reminder
+		want               bool
+	}{
+		"no reminder": {
+			want: false,
+		},
+		"reminder in the defaults": {
+			defaults: &Reminder{ReminderCadence: ReminderCadence{After: "72h"}},
+			want:     true,
+		},
+		"only for major releases": {
+			reminder: &Reminder{Major: &ReminderCadence{After: "24h"}},
+			want:     true,
+		},
+		"disabled for the service": {
+			reminder: &Reminder{ReminderCadence: ReminderCadence{After: "0s"}},
+			defaults: &Reminder{ReminderCadence: ReminderCadence{After: "72h"}},
+			want:     false,
+		},
+		"only escalation": {
+			reminder: &Reminder{ReminderCadence: ReminderCadence{EscalateAfter: "720h"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			options := testOptions(t)
+			options.Reminder = tc.reminder
+			options.Defaults.Reminder = tc.defaults
+
+			// WHEN: GetReminderActive is called.
+			got := options.GetReminderActive()
+
+			// THEN: it reports whether any release would be reminded of.
+			if got != tc.want {
+				t.Errorf("%s\nOptions.GetReminderActive() mismatch\ngot:  %t\nwant: %t",
+					packageName, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOptions_GetReminderEscalateNotify(t *testing.T) {
+	// GIVEN: Options with escalate_notify in the defaults.
+	options := testOptions(t)
+	options.Defaults.Reminder = &Reminder{EscalateNotify: []string{"oncall"}}
+
+	// WHEN: GetReminderEscalateNotify is called without a Service override.
+	got := options.GetReminderEscalateNotify()
+	// THEN: the defaults are used.
+	if len(got) != 1 || got[0] != "oncall" {
+		t.Errorf("%s\nOptions.GetReminderEscalateNotify() mismatch\ngot:  %v\nwant: %v",
+			packageName, got, []string{"oncall"})
+	}
+
+	// WHEN: the Service overrides them.
+	options.Reminder = &Reminder{EscalateNotify: []string{"team", "manager"}}
+	got = options.GetReminderEscalateNotify()
+	// THEN: the Service's are used.
+	if len(got) != 2 || got[0] != "team" || got[1] != "manager" {
+		t.Errorf("%s\nOptions.GetReminderEscalateNotify() mismatch\ngot:  %v\nwant: %v",
+			packageName, got, []string{"team", "manager"})
+	}
+}
+
+func TestReminderPolicy_Next(t *testing.T) {
+	released := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	// GIVEN: a policy, and the last reminder sent.
+	tests := map[string]struct {
+		policy        ReminderPolicy
+		lastReminded  time.Time
+		lastEscalated bool
+		want          time.Time
+		wantEscalate  bool
+		wantOK        bool
+	}{
+		"no reminder": {
+			policy: ReminderPolicy{Every: 7 * day},
+			wantOK: false,
+		},
+		"first reminder": {
+			policy: ReminderPolicy{After: 3 * day, Every: 7 * day, EscalateAfter: 30 * day},
+			want:   released.Add(3 * day),
+			wantOK: true,
+		},
+		"repeat reminder": {
+			policy:       ReminderPolicy{After: 3 * day, Every: 7 * day, EscalateAfter: 30 * day},
+			lastReminded: released.Add(3 * day),
+			want:         released.Add(10 * day),
+			wantOK:       true,
+		},
+		"escalation sooner than the repeat": {
+			policy:       ReminderPolicy{After: 3 * day, Every: 7 * day, EscalateAfter: 30 * day},
+			lastReminded: released.Add(24 * day),
+			want:         released.Add(30 * day),
+			wantEscalate: true,
+			wantOK:       true,
+		},
+		"repeat reminder lands on the escalation": {
+			policy:       ReminderPolicy{After: 3 * day, Every: 7 * day, EscalateAfter: 31 * day},
+			lastReminded: released.Add(24 * day),
+			want:         released.Add(31 * day),
+			wantEscalate: true,
+			wantOK:       true,
+		},
+		"repeats stay escalated": {
+			policy:        ReminderPolicy{After: 3 * day, Every: 7 * day, EscalateAfter: 30 * day},
+			lastReminded:  released.Add(30 * day),
+			lastEscalated: true,
+			want:          released.Add(37 * day),
+			wantEscalate:  true,
+			wantOK:        true,
+		},
+		"remind once": {
+			policy:       ReminderPolicy{After: 3 * day},
+			lastReminded: released.Add(3 * day),
+			wantOK:       false,
+		},
+		"remind once, then escalate": {
+			policy:       ReminderPolicy{After: 3 * day, EscalateAfter: 30 * day},
+			lastReminded: released.Add(3 * day),
+			want:         released.Add(30 * day),
+			wantEscalate: true,
+			wantOK:       true,
+		},
+		"escalated once": {
+			policy:        ReminderPolicy{After: 3 * day, EscalateAfter: 30 * day},
+			lastReminded:  released.Add(30 * day),
+			lastEscalated: true,
+			wantOK:        false,
+		},
+		"escalate before the first reminder": {
+			policy:       ReminderPolicy{After: 3 * day, EscalateAfter: day},
+			want:         released.Add(day),
+			wantEscalate: true,
+			wantOK:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: Next is called.
+			got, gotEscalate, gotOK := tc.policy.Next(released, tc.lastReminded, tc.lastEscalated)
+
+			// THEN: the next reminder is due as expected.
+			if !got.Equal(tc.want) || gotEscalate != tc.wantEscalate || gotOK != tc.wantOK {
+				t.Errorf("%s\nReminderPolicy.Next() mismatch\ngot:  %s, escalate=%t, ok=%t\nwant: %s, escalate=%t, ok=%t",
+					packageName,
+					got, gotEscalate, gotOK,
+					tc.want, tc.wantEscalate, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestReminder_Copy(t *testing.T) {
+	// GIVEN: a Reminder.
+	reminder := &Reminder{
+		ReminderCadence: ReminderCadence{After: "72h"},
+		EscalateNotify:  []string{"oncall"},
+		Major:           &ReminderCadence{After: "24h"},
+	}
+
+	// WHEN: Copy is called, and the original modified.
+	got := reminder.Copy()
+	reminder.EscalateNotify[0] = "other"
+	reminder.Major.After = "1h"
+
+	// THEN: the copy is unchanged.
+	if got.After != "72h" || got.EscalateNotify[0] != "oncall" || got.Major.After != "24h" || got.Minor != nil {
+		t.Errorf("%s\nReminder.Copy() not a deep copy\ngot:  %+v",
+			packageName, got)
+	}
+	// AND: a nil Reminder copies to nil.
+	if got := (*Reminder)(nil).Copy(); got != nil {
+		t.Errorf("%s\nReminder.Copy() of nil\ngot:  %+v\nwant: nil",
+			packageName, got)
+	}
+}
+
+func TestOptions_String_Reminder(t *testing.T) {
+	// GIVEN: Options with a reminder.
+	yaml := test.TrimYAML(`
+		reminder:
+			after: 72h
+			every: 168h
+			escalate_notify:
+				- oncall
+			major:
+				after: 24h
+	`)
+	options, err := Decode("yaml", []byte(yaml), plainDefaultsConfig(t))
+	if err != nil {
+		t.Fatalf("%s\nDecode() error: %v", packageName, err)
+	}
+
+	// WHEN: String is called.
+	got := options.String()
+
+	// THEN: the reminder is kept, with the base cadence inline.
+	if got != yaml {
+		t.Errorf("%s\nOptions.String() mismatch\ngot:  %q\nwant: %q",
+			packageName, got, yaml)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/cron"
+	"github.com/release-argus/Argus/util"
+)
+
+// scheduleIntervalRuns is the number of upcoming scheduled queries to find the shortest gap between.
+const scheduleIntervalRuns = 8
+
+// GetSchedule returns the cron expression of when to query, or "" to query on the interval.
+//
+// A schedule or interval set closer to the Service takes precedence,
+// so a Service with an interval ignores any schedule in the defaults.
+func (o *Options) GetSchedule() string {
+	for _, b := range []*Base{&o.Base, &o.Defaults.Base, &o.HardDefaults.Base} {
+		if b.Schedule != "" {
+			return b.Schedule
+		}
+		if b.Interval != "" {
+			return ""
+		}
+	}
+	return ""
+}
+
+// cronSchedule returns the parsed schedule, or nil if querying on the interval.
+func (o *Options) cronSchedule() *cron.Schedule {
+	expr := o.GetSchedule()
+	if expr == "" {
+		return nil
+	}
+
+	schedule, _ := cron.Parse(expr)
+	return schedule
+}
+
+// GetJitter returns the maximum random delay to add to each query.
+func (o *Options) GetJitter() string {
+	return util.FirstNonDefault(
+		o.Jitter,
+		o.Defaults.Jitter,
+		o.HardDefaults.Jitter,
+	)
+}
+
+// GetJitterDuration returns the maximum random delay to add to each query.
+func (o *Options) GetJitterDuration() time.Duration {
+	d, _ := time.ParseDuration(o.GetJitter())
+	return d
+}
+
+// GetActiveHours returns the 'HH:MM-HH:MM' windows to limit queries to.
+func (o *Options) GetActiveHours() []string {
+	return util.FirstNonEmptySlice(
+		o.ActiveHours,
+		o.Defaults.ActiveHours,
+		o.HardDefaults.ActiveHours,
+	)
+}
+
+// NextQuery returns when to next query, given the last query was at `last` (zero if never queried).
+//
+// This is the interval (or next scheduled time) after the last query, but no earlier than now,
+// moved to the start of the next active hours window if outside them, plus any jitter.
+// Schedules and active hours are in local time.
+func (o *Options) NextQuery(last, now time.Time) time.Time {
+	last, now = last.Local(), now.Local()
+	next := now
+	if !last.IsZero() {
+		var scheduled time.Time
+		if schedule := o.cronSchedule(); schedule != nil {
+			scheduled = schedule.Next(last)
+		}
+		// Interval, or a schedule that never matches.
+		if scheduled.IsZero() {
+			interval, _ := time.ParseDuration(o.GetInterval())
+			scheduled = last.Add(interval)
+		}
+		if scheduled.After(now) {
+			next = scheduled
+		}
+	}
+
+	// Active hours.
+	windows, _ := parseActiveHours(o.GetActiveHours())
+	next = windows.next(next)
+
+	// Jitter.
+	if jitter := o.GetJitterDuration(); jitter > 0 {
+		next = next.Add(rand.N(jitter))
+	}
+
+	return next
+}
+
+// window is a time-of-day range, in minutes since midnight.
+// An end before the start wraps past midnight.
+type window struct {
+	start, end int
+}
+
+// windows are the active hours.
+type windows []window
+
+// contains reports whether the local time-of-day of t is within any of the windows.
+func (w windows) contains(t time.Time) bool {
+	t = t.Local()
+	minute := t.Hour()*60 + t.Minute()
+	for _, win := range w {
+		if win.start < win.end {
+			if minute >= win.start && minute < win.end {
+				return true
+			}
+		} else if minute >= win.start || minute < win.end {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns t if within the windows (or there are none),
+// otherwise the start of the next window.
+func (w windows) next(t time.Time) time.Time {
+	if len(w) == 0 || w.contains(t) {
+		return t
+	}
+
+	t = t.Local()
+	var earliest time.Time
+	for day := range 2 {
+		for _, win := range w {
+			start := time.Date(t.Year(), t.Month(), t.Day()+day, 0, win.start, 0, 0, time.Local)
+			if start.After(t) && (earliest.IsZero() || start.Before(earliest)) {
+				earliest = start
+			}
+		}
+	}
+	return earliest
+}
+
+// parseActiveHours parses 'HH:MM-HH:MM' windows.
+func parseActiveHours(activeHours []string) (windows, error) {
+	parsed := make(windows, 0, len(activeHours))
+	var errs []error
+	for i, activeHour := range activeHours {
+		startStr, endStr, ok := strings.Cut(activeHour, "-")
+		start, startErr := parseTimeOfDay(startStr)
+		end, endErr := parseTimeOfDay(endStr)
+		if !ok || startErr != nil || endErr != nil {
+			errs = append(errs, &decode.ErrField{
+				Key:         fmt.Sprintf("- item_%d", i),
+				Value:       activeHour,
+				Description: "use 'HH:MM-HH:MM' format",
+			})
+			continue
+		}
+		if start == end {
+			errs = append(errs, &decode.ErrField{
+				Key:         fmt.Sprintf("- item_%d", i),
+				Value:       activeHour,
+				Description: "start and end must differ",
+			})
+			continue
+		}
+		parsed = append(parsed, window{start: start, end: end})
+	}
+
+	return parsed, errors.Join(errs...)
+}
+
+// parseTimeOfDay parses 'HH:MM' into minutes since midnight.
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// checkActiveHours validates the 'HH:MM-HH:MM' windows.
+func checkActiveHours(activeHours []string) error {
+	if _, err := parseActiveHours(activeHours); err != nil {
+		return &decode.ErrKeyField{
+			Key: "active_hours",
+			Err: err,
+		}
+	}
+	return nil
+}
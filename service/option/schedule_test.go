@@ -0,0 +1,298 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package option
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestOptions_GetSchedule(t *testing.T) {
+	// GIVEN: Options with a schedule and/or interval at each level.
+	tests := []struct {
+		name                                     string
+		rootInterval, rootSchedule               string
+		defaultInterval, defaultSchedule         string
+		hardDefaultInterval, hardDefaultSchedule string
+		want                                     string
+	}{
+		{
+			name:                "interval only",
+			hardDefaultInterval: "10m",
+			want:                "",
+		},
+		{
+			name:                "root schedule",
+			rootSchedule:        "0 9 * * *",
+			hardDefaultInterval: "10m",
+			want:                "0 9 * * *",
+		},
+		{
+			name:                "default schedule",
+			defaultSchedule:     "0 9 * * *",
+			hardDefaultInterval: "10m",
+			want:                "0 9 * * *",
+		},
+		{
+			name:                "root interval overrides default schedule",
+			rootInterval:        "5m",
+			defaultSchedule:     "0 9 * * *",
+			hardDefaultInterval: "10m",
+			want:                "",
+		},
+		{
+			name:                "root schedule overrides root interval",
+			rootInterval:        "5m",
+			rootSchedule:        "0 10 * * *",
+			defaultSchedule:     "0 9 * * *",
+			hardDefaultInterval: "10m",
+			want:                "0 10 * * *",
+		},
+		{
+			name:                "hardDefault schedule",
+			hardDefaultInterval: "10m",
+			hardDefaultSchedule: "0 9 * * *",
+			want:                "0 9 * * *",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := &Options{
+				Base:         Base{Interval: tc.rootInterval, Schedule: tc.rootSchedule},
+				Defaults:     &Defaults{Base: Base{Interval: tc.defaultInterval, Schedule: tc.defaultSchedule}},
+				HardDefaults: &Defaults{Base: Base{Interval: tc.hardDefaultInterval, Schedule: tc.hardDefaultSchedule}},
+			}
+
+			// WHEN: GetSchedule is called.
+			got := options.GetSchedule()
+
+			// THEN: the closest schedule/interval wins.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nOptions.GetSchedule() value mismatch\ngot:  %q\nwant: %q",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestOptions_GetJitter(t *testing.T) {
+	// GIVEN: Options with a jitter in the defaults.
+	options := testOptions(t)
+	options.Defaults.Jitter = "2m"
+
+	// WHEN: GetJitter and GetJitterDuration are called.
+	got := options.GetJitter()
+	gotDuration := options.GetJitterDuration()
+
+	// THEN: the default is used.
+	if got != "2m" || gotDuration != 2*time.Minute {
+		t.Errorf(
+			"%s\nOptions.GetJitter() value mismatch\ngot:  %q (%s)\nwant: %q (%s)",
+			packageName, got, gotDuration, "2m", 2*time.Minute,
+		)
+	}
+}
+
+func TestOptions_GetActiveHours(t *testing.T) {
+	// GIVEN: Options with active hours in the defaults and root.
+	options := testOptions(t)
+	options.Defaults.ActiveHours = []string{"08:00-18:00"}
+	options.ActiveHours = []string{"09:00-17:00"}
+
+	// WHEN: GetActiveHours is called.
+	got := options.GetActiveHours()
+
+	// THEN: the root value is used.
+	if len(got) != 1 || got[0] != "09:00-17:00" {
+		t.Errorf(
+			"%s\nOptions.GetActiveHours() value mismatch\ngot:  %q\nwant: %q",
+			packageName, got, []string{"09:00-17:00"},
+		)
+	}
+}
+
+func TestOptions_NextQuery(t *testing.T) {
+	// Monday.
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.Local)
+
+	// GIVEN: Options.
+	tests := []struct {
+		name        string
+		interval    string
+		schedule    string
+		jitter      string
+		activeHours []string
+		last        time.Time
+		want        time.Time
+		wantUpTo    time.Duration // Jitter.
+	}{
+		{
+			name:     "never queried",
+			interval: "10m",
+			want:     now,
+		},
+		{
+			name:     "interval since last query",
+			interval: "10m",
+			last:     now.Add(-4 * time.Minute),
+			want:     now.Add(6 * time.Minute),
+		},
+		{
+			name:     "interval already elapsed",
+			interval: "10m",
+			last:     now.Add(-time.Hour),
+			want:     now,
+		},
+		{
+			name:     "schedule after last query",
+			schedule: "0 9 * * mon-fri",
+			last:     now,
+			want:     time.Date(2026, time.March, 3, 9, 0, 0, 0, time.Local),
+		},
+		{
+			name:     "missed schedule runs now",
+			schedule: "0 9 * * mon-fri",
+			last:     now.Add(-48 * time.Hour),
+			want:     now,
+		},
+		{
+			name:     "never queried with a schedule",
+			schedule: "0 9 * * mon-fri",
+			want:     now,
+		},
+		{
+			name:        "within active hours",
+			interval:    "10m",
+			activeHours: []string{"09:00-17:00"},
+			last:        now,
+			want:        now.Add(10 * time.Minute),
+		},
+		{
+			name:        "outside active hours waits for the next window",
+			interval:    "10m",
+			activeHours: []string{"09:00-11:00", "13:00-14:00"},
+			last:        now,
+			want:        time.Date(2026, time.March, 2, 13, 0, 0, 0, time.Local),
+		},
+		{
+			name:        "outside active hours waits until tomorrow",
+			interval:    "10m",
+			activeHours: []string{"09:00-11:00"},
+			last:        now,
+			want:        time.Date(2026, time.March, 3, 9, 0, 0, 0, time.Local),
+		},
+		{
+			name:        "active hours past midnight",
+			interval:    "10m",
+			activeHours: []string{"22:00-02:00"},
+			last:        time.Date(2026, time.March, 2, 23, 55, 0, 0, time.Local),
+			want:        time.Date(2026, time.March, 3, 0, 5, 0, 0, time.Local),
+		},
+		{
+			name:     "jitter",
+			interval: "10m",
+			jitter:   "1m",
+			last:     now,
+			want:     now.Add(10 * time.Minute),
+			wantUpTo: time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := testOptions(t)
+			options.Interval = tc.interval
+			options.Schedule = tc.schedule
+			options.Jitter = tc.jitter
+			options.ActiveHours = tc.activeHours
+
+			// WHEN: NextQuery is called.
+			got := options.NextQuery(tc.last, now)
+
+			// THEN: the next query is at the expected time.
+			prefix := fmt.Sprintf("%s\nOptions.NextQuery()", packageName)
+			if got.Before(tc.want) || got.Sub(tc.want) > tc.wantUpTo {
+				t.Errorf(
+					"%s mismatch\ngot:  %s\nwant: %s (+%s)",
+					prefix, got, tc.want, tc.wantUpTo,
+				)
+			}
+		})
+	}
+}
+
+func TestOptions_NextQuery__localTime(t *testing.T) {
+	// GIVEN: a local time zone that differs from the times given.
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = time.FixedZone("UTC+10", 10*60*60)
+	// Monday, 12:00 local.
+	now := time.Date(2026, time.March, 2, 2, 0, 0, 0, time.UTC)
+	tomorrow := time.Date(2026, time.March, 3, 9, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		interval    string
+		schedule    string
+		activeHours []string
+		want        time.Time
+	}{
+		{
+			name:        "within local active hours",
+			interval:    "10m",
+			activeHours: []string{"11:00-13:00"},
+			want:        now.Add(10 * time.Minute),
+		},
+		{
+			name:        "outside local active hours",
+			interval:    "10m",
+			activeHours: []string{"09:00-11:00"},
+			want:        tomorrow,
+		},
+		{
+			name:     "schedule in local time",
+			schedule: "0 9 * * mon-fri",
+			want:     tomorrow,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			options := testOptions(t)
+			options.Interval = tc.interval
+			options.Schedule = tc.schedule
+			options.ActiveHours = tc.activeHours
+
+			// WHEN: NextQuery is called with UTC times.
+			got := options.NextQuery(now, now)
+
+			// THEN: the schedule and active hours are applied in local time.
+			if !got.Equal(tc.want) {
+				t.Errorf("%s\nOptions.NextQuery() mismatch\ngot:  %s\nwant: %s",
+					packageName, got, tc.want)
+			}
+		})
+	}
+}
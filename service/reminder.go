@@ -0,0 +1,143 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/notify/shoutrrr"
+	"github.com/release-argus/Argus/service/status"
+	"github.com/release-argus/Argus/util"
+)
+
+// initReminderNotify assigns the Notify to also remind once a reminder is escalated,
+// using those of the Service, or else those of the root config.
+func (s *Service) initReminderNotify(notifyCfg shoutrrr.Config) {
+	s.reminderNotify = nil
+	ids := s.Options.GetReminderEscalateNotify()
+	if len(ids) == 0 {
+		return
+	}
+
+	s.reminderNotify = make(shoutrrr.Shoutrrrs, len(ids))
+	root := make(shoutrrr.Shoutrrrs)
+	for _, id := range ids {
+		if notify := s.Notify[id]; notify != nil {
+			s.reminderNotify[id] = notify
+		} else if notifyCfg.Root[id] != nil {
+			root[id] = &shoutrrr.Shoutrrr{}
+		}
+	}
+	root.Init(&s.Status, notifyCfg)
+	maps.Copy(s.reminderNotify, root)
+}
+
+// checkReminderNotify validates that each escalate_notify of the reminder is a known Notify.
+func (s *Service) checkReminderNotify() error {
+	var errs []error
+	for i, id := range s.Options.GetReminderEscalateNotify() {
+		if id != "" && s.reminderNotify[id] == nil {
+			errs = append(errs, &decode.ErrField{
+				Key:         fmt.Sprintf("- item_%d", i),
+				Value:       id,
+				Description: "no notify with this ID",
+			})
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &decode.ErrKeyField{
+		Key: "reminder",
+		Err: &decode.ErrKeyField{
+			Key: "escalate_notify",
+			Err: errors.Join(errs...),
+		},
+	}
+}
+
+// remind sends a reminder if the LatestVersion has been undeployed for long enough,
+// returning the delay until it should next check.
+func (s *Service) remind(now time.Time) time.Duration {
+	// Versions only change with a query, so recheck at that rate.
+	recheck := s.Options.GetIntervalDuration()
+
+	release, ok := s.Status.Undeployed()
+	if !ok {
+		return recheck
+	}
+	policy := s.Options.GetReminder(release.Bump)
+	due, escalate, ok := policy.Next(release.Released, release.LastReminded, release.Escalated)
+	if ok && !due.After(now) {
+		s.sendReminder(release, now, escalate)
+		due, _, ok = policy.Next(release.Released, now, escalate)
+	}
+	if !ok {
+		return recheck
+	}
+	return min(due.Sub(now), recheck)
+}
+
+// sendReminder sends a reminder that release is undeployed to the Notify,
+// and those to escalate to if escalating.
+func (s *Service) sendReminder(release status.UndeployedRelease, now time.Time, escalate bool) {
+	svcInfo := s.Status.GetServiceInfo()
+	title := "Release not deployed"
+	notifiers := maps.Clone(s.Notify)
+	if escalate {
+		title += " (escalated)"
+		if notifiers == nil {
+			notifiers = make(shoutrrr.Shoutrrrs, len(s.reminderNotify))
+		}
+		maps.Copy(notifiers, s.reminderNotify)
+	}
+	message := fmt.Sprintf(
+		"%s %s was released %s ago, and is still not deployed (on %s)",
+		util.FirstNonDefault(svcInfo.Name, svcInfo.ID), release.Version,
+		formatAge(now.Sub(release.Released)), release.DeployedVersion,
+	)
+
+	logx.Info(
+		fmt.Sprintf("%s: %s", title, message),
+		logx.LogFrom{Primary: s.ID},
+		true,
+	)
+	// Record before sending, so a failing Notify is not reminded again until the next is due.
+	s.Status.SetReminded(release.Version, now, escalate)
+
+	//nolint:errcheck
+	go notifiers.Send(title, message, svcInfo, false)
+}
+
+// formatAge returns d in whole days, or hours/minutes when under a day.
+func formatAge(d time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case d >= 2*day:
+		return fmt.Sprintf("%d days", d/day)
+	case d >= day:
+		return "1 day"
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	default:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+}
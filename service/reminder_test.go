@@ -0,0 +1,283 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/test"
+	"github.com/release-argus/Argus/notify/shoutrrr"
+	shoutrrrtest "github.com/release-argus/Argus/notify/shoutrrr/test"
+	opt "github.com/release-argus/Argus/service/option"
+	"github.com/release-argus/Argus/util/errfmt"
+	whtest "github.com/release-argus/Argus/webhook/test"
+)
+
+func TestService_initReminderNotify(t *testing.T) {
+	// GIVEN: a Service escalating reminders to its own Notify, a root Notify, and an unknown Notify.
+	notifyCfg := shoutrrrtest.PlainConfig(t)
+	notifyCfg.Root["oncall"] = shoutrrr.NewDefaults("gotify", nil, nil, nil)
+	svc := test.Must(t, func() (*Service, error) {
+		return DecodeService(
+			"yaml", []byte(test.TrimYAML(`
+				options:
+					reminder:
+						after: 72h
+						escalate_notify:
+							- team
+							- oncall
+							- unknown
+				latest_version:
+					type: github
+					url: `+test.ArgusGitHubRepo+`
+				notify:
+					team:
+						type: gotify
+						url_fields:
+							host: example.com
+							token: foo
+			`)),
+			"TestService_initReminderNotify",
+			plainDefaultsConfig(t), notifyCfg, whtest.PlainConfig(t),
+		)
+	})
+
+	prefix := fmt.Sprintf("%s\nService.initReminderNotify()", packageName)
+
+	// THEN: the Notify of the Service is used.
+	if svc.reminderNotify["team"] != svc.Notify["team"] {
+		t.Errorf("%s expected the Service's Notify to be used for %q",
+			prefix, "team")
+	}
+	// AND: the root Notify is initialised.
+	if notify := svc.reminderNotify["oncall"]; notify == nil || notify.Main != notifyCfg.Root["oncall"] {
+		t.Errorf("%s expected the root Notify to be used for %q\ngot:  %+v",
+			prefix, "oncall", notify)
+	}
+	// AND: the unknown Notify is not.
+	if len(svc.reminderNotify) != 2 {
+		t.Errorf("%s length mismatch\ngot:  %d\nwant: 2",
+			prefix, len(svc.reminderNotify))
+	}
+	// AND: CheckValues reports the unknown Notify.
+	err, _ := svc.CheckValues()
+	want := test.TrimYAML(`
+		options:
+			reminder:
+				escalate_notify:
+					- item_2: "unknown" <invalid> (no notify with this ID)`)
+	if got := errfmt.FormatError(err); got != want {
+		t.Errorf("%s\nService.CheckValues() error mismatch\ngot:  %q\nwant: %q",
+			packageName, got, want)
+	}
+}
+
+func TestService_remind(t *testing.T) {
+	released := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	// GIVEN: a Service with a reminder policy, and a minor release.
+	svc := testService(t, "TestService_remind", "url", "url")
+	svc.Options.Interval = "1000h"
+	svc.Options.Reminder = &opt.Reminder{
+		ReminderCadence: opt.ReminderCadence{After: "72h", Every: "168h", EscalateAfter: "720h"},
+		Major:           &opt.ReminderCadence{After: "24h"},
+	}
+	svc.Status.SetDeployedVersion("1.0.0", "2025-12-01T00:00:00Z", false)
+	svc.Status.SetLatestVersion("1.1.0", released.Format(time.RFC3339), false)
+
+	steps := []struct {
+		name       string
+		do         func()
+		now        time.Time
+		wantSource string // Empty for no reminder.
+		wantDelay  time.Duration
+	}{
+		{
+			name:      "not due",
+			now:       released.Add(day),
+			wantDelay: 2 * day,
+		},
+		{
+			name:       "first reminder",
+			now:        released.Add(3 * day),
+			wantSource: dbtype.SourceReminder,
+			wantDelay:  7 * day,
+		},
+		{
+			name:      "checked again early",
+			now:       released.Add(4 * day),
+			wantDelay: 6 * day,
+		},
+		{
+			name:       "repeat reminder, late",
+			now:        released.Add(11 * day),
+			wantSource: dbtype.SourceReminder,
+			wantDelay:  7 * day,
+		},
+		{
+			name:       "repeat reminder",
+			now:        released.Add(18 * day),
+			wantSource: dbtype.SourceReminder,
+			wantDelay:  7 * day,
+		},
+		{
+			name:       "repeat reminder",
+			now:        released.Add(25 * day),
+			wantSource: dbtype.SourceReminder,
+			wantDelay:  5 * day,
+		},
+		{
+			name:       "escalation",
+			now:        released.Add(30 * day),
+			wantSource: dbtype.SourceEscalation,
+			wantDelay:  7 * day,
+		},
+		{
+			name:       "escalated repeat reminder",
+			now:        released.Add(37 * day),
+			wantSource: dbtype.SourceEscalation,
+			wantDelay:  7 * day,
+		},
+		{
+			name: "major release uses its cadence",
+			do: func() {
+				svc.Status.SetLatestVersion("2.0.0", released.Add(40*day).Format(time.RFC3339), false)
+			},
+			now:       released.Add(40 * day),
+			wantDelay: day,
+		},
+		{
+			name: "skipped",
+			do: func() {
+				svc.Status.SetApprovedVersion("SKIP_2.0.0", false)
+			},
+			now:       released.Add(45 * day),
+			wantDelay: 1000 * time.Hour,
+		},
+		{
+			name: "deployed",
+			do: func() {
+				svc.Status.SetApprovedVersion("", false)
+				svc.Status.SetDeployedVersion("2.0.0", released.Add(46*day).Format(time.RFC3339), false)
+			},
+			now:       released.Add(46 * day),
+			wantDelay: 1000 * time.Hour,
+		},
+	}
+
+	for _, step := range steps {
+		if step.do != nil {
+			step.do()
+		}
+
+		// WHEN: remind is called.
+		gotDelay := svc.remind(step.now)
+
+		prefix := fmt.Sprintf("%s\n%s:", packageName, step.name)
+
+		// THEN: a reminder is recorded when due.
+		gotSource := ""
+		if len(svc.Status.DatabaseChannel) != 0 {
+			message := <-svc.Status.DatabaseChannel
+			gotSource = message.Events[0].Source
+		}
+		if gotSource != step.wantSource {
+			t.Errorf("%s Service.remind() reminder mismatch\ngot:  %q\nwant: %q",
+				prefix, gotSource, step.wantSource)
+		}
+		// AND: it waits until the next is due.
+		if gotDelay != step.wantDelay {
+			t.Errorf("%s Service.remind() delay mismatch\ngot:  %v\nwant: %v",
+				prefix, gotDelay, step.wantDelay)
+		}
+	}
+}
+
+func TestService_Track_reminder(t *testing.T) {
+	// GIVEN: Services with and without a reminder, queried just now.
+	tests := map[string]struct {
+		reminder      *opt.Reminder
+		wantScheduled bool
+	}{
+		"no reminder": {
+			wantScheduled: false,
+		},
+		"reminder": {
+			reminder:      &opt.Reminder{ReminderCadence: opt.ReminderCadence{After: "72h"}},
+			wantScheduled: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := testService(t, t.Name(), "url", "url")
+			svc.Options.Reminder = tc.reminder
+			svc.Status.SetLastQueried(time.Now().UTC().Format(time.RFC3339))
+
+			// WHEN: the Service is tracked.
+			svc.Track()
+			t.Cleanup(svc.StopTracking)
+
+			prefix := fmt.Sprintf("%s\nService.Track()", packageName)
+
+			// THEN: the reminder check is scheduled when there is a reminder.
+			if _, scheduled := tracker.Next(svc.reminderJobID()); scheduled != tc.wantScheduled {
+				t.Fatalf("%s reminder scheduled mismatch\ngot:  %t\nwant: %t",
+					prefix, scheduled, tc.wantScheduled)
+			}
+
+			// WHEN: tracking is stopped.
+			svc.StopTracking()
+
+			// THEN: the reminder check is no longer scheduled.
+			if _, scheduled := tracker.Next(svc.reminderJobID()); scheduled {
+				t.Errorf("%s\nService.StopTracking() reminder still scheduled",
+					packageName)
+			}
+		})
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	// GIVEN: different durations.
+	tests := map[time.Duration]string{
+		5 * time.Minute:               "5m",
+		3*time.Hour + 59*time.Minute:  "3h",
+		25 * time.Hour:                "1 day",
+		30*24*time.Hour + time.Minute: "30 days",
+	}
+
+	for d, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: formatAge is called.
+			got := formatAge(d)
+
+			// THEN: it is formatted in its largest unit.
+			if got != want {
+				t.Errorf("%s\nformatAge(%v) mismatch\ngot:  %q\nwant: %q",
+					packageName, d, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"time"
+
+	"github.com/release-argus/Argus/internal/httpx"
+	"github.com/release-argus/Argus/service/status"
+)
+
+// untilNextAttempt returns the delay until a lookup should next be queried, given the error of its last query.
+//
+// Consecutive failures back off exponentially (options.backoff, up to options.backoff_max),
+// waiting longer if the host asked for it (Retry-After/rate-limit reset) or its circuit is open.
+// The backoff is read with get, and recorded with set.
+func (s *Service) untilNextAttempt(err error, get func() status.Retry, set func(status.Retry)) time.Duration {
+	if err == nil {
+		if !get().IsZero() {
+			set(status.Retry{})
+		}
+		return s.untilNextQuery()
+	}
+
+	now := time.Now()
+	retry := get()
+	retry.Failures++
+
+	next := s.Options.NextQuery(now, now)
+	if backoff := s.Options.GetBackoffDuration(retry.Failures); backoff > 0 {
+		next = now.Add(backoff)
+	}
+	if retryAt, ok := httpx.RetryAt(err); ok && retryAt.After(next) {
+		next = retryAt
+	}
+
+	retry.Backoff = next.Sub(now).Round(time.Second)
+	retry.NextAttempt = next
+	set(retry)
+
+	return next.Sub(now)
+}
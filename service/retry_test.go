@@ -0,0 +1,119 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/internal/httpx"
+)
+
+func TestService_untilNextAttempt(t *testing.T) {
+	// GIVEN: a Service with a backoff, and the errors of consecutive queries.
+	tests := []struct {
+		name        string
+		errs        []error
+		want        time.Duration
+		wantFailing int
+	}{
+		{
+			name: "success",
+			errs: []error{nil},
+			want: 10 * time.Minute,
+		},
+		{
+			name:        "first failure",
+			errs:        []error{errors.New("fail")},
+			want:        30 * time.Second,
+			wantFailing: 1,
+		},
+		{
+			name:        "backs off exponentially",
+			errs:        []error{errors.New("fail"), errors.New("fail"), errors.New("fail")},
+			want:        2 * time.Minute,
+			wantFailing: 3,
+		},
+		{
+			name:        "capped at backoff_max",
+			errs:        []error{errors.New("fail"), errors.New("fail"), errors.New("fail"), errors.New("fail"), errors.New("fail")},
+			want:        5 * time.Minute,
+			wantFailing: 5,
+		},
+		{
+			name: "success after failures resets",
+			errs: []error{errors.New("fail"), errors.New("fail"), nil},
+			want: 10 * time.Minute,
+		},
+		{
+			name: "waits for the circuit to close",
+			errs: []error{
+				fmt.Errorf("request failed: %w",
+					&httpx.ErrCircuitOpen{Host: "example.com", Until: time.Now().Add(time.Hour)})},
+			want:        time.Hour,
+			wantFailing: 1,
+		},
+		{
+			name: "first failure waits for the Retry-After",
+			errs: []error{
+				fmt.Errorf("request failed: %w",
+					&httpx.ErrRetryAfter{
+						Host: "example.com", StatusCode: http.StatusTooManyRequests, Until: time.Now().Add(2 * time.Hour)})},
+			want:        2 * time.Hour,
+			wantFailing: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := testService(t, tc.name, "url", "url")
+			svc.Options.Interval = "10m"
+			svc.Options.Backoff = "30s"
+			svc.Options.BackoffMax = "5m"
+
+			// WHEN: untilNextAttempt is called after each query.
+			var got time.Duration
+			for _, err := range tc.errs {
+				got = svc.untilNextAttempt(err,
+					svc.Status.LatestVersionRetry, svc.Status.SetLatestVersionRetry)
+			}
+
+			prefix := fmt.Sprintf("%s\nService.untilNextAttempt()", packageName)
+
+			// THEN: the delay is as expected.
+			if diff := tc.want - got; diff < 0 || diff > time.Second {
+				t.Errorf("%s delay mismatch\ngot:  %s\nwant: %s",
+					prefix, got, tc.want)
+			}
+			// AND: the backoff is recorded.
+			retry := svc.Status.LatestVersionRetry()
+			if retry.Failures != tc.wantFailing {
+				t.Errorf("%s failures mismatch\ngot:  %d\nwant: %d",
+					prefix, retry.Failures, tc.wantFailing)
+			}
+			if tc.wantFailing != 0 && svc.Status.LatestVersionRetrySummary() == nil {
+				t.Errorf("%s expected the backoff in the Status summary",
+					prefix)
+			}
+		})
+	}
+}
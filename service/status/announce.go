@@ -92,6 +92,7 @@ func (s *Status) AnnounceQueryNewVersion() {
 				Status: &apitype.Status{
 					LatestVersion:          s.LatestVersion(),
 					LatestVersionTimestamp: s.LatestVersionTimestamp(),
+					Bump:                   s.GetServiceInfo().Bump,
 				},
 			},
 		},
@@ -100,6 +101,8 @@ func (s *Status) AnnounceQueryNewVersion() {
 
 // AnnounceUpdate broadcasts a deployed version change to WebSocket clients.
 func (s *Status) AnnounceUpdate() {
+	serviceInfo := s.GetServiceInfo()
+
 	s.sendAnnouncePayload(
 		apitype.WebSocketMessage{
 			Page:    "APPROVALS",
@@ -110,6 +113,11 @@ func (s *Status) AnnounceUpdate() {
 				Status: &apitype.Status{
 					DeployedVersion:          s.DeployedVersion(),
 					DeployedVersionTimestamp: s.DeployedVersionTimestamp(),
+					Bump:                     serviceInfo.Bump,
+					FleetState:               serviceInfo.FleetState,
+					Instances:                s.InstancesSummary(),
+					// Always sent, so that clients clear a completed rollout.
+					DeployedVersionRollout: new(apitype.Rollout(s.DeployedVersionRollout())),
 				},
 			},
 		},
@@ -0,0 +1,51 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package status provides the status functionality to keep track of the approved/deployed/latest versions of a Service.
+package status
+
+import (
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+// Audit records an action Argus took for the Service (e.g. running a Command) in the audit log,
+// with the outcome given by err.
+func (s *Status) Audit(action, target string, err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry := dbtype.AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Actor:     dbtype.AuditActorArgus,
+		Action:    action,
+		ServiceID: s.ServiceInfo.ID,
+		Target:    target,
+		Outcome:   dbtype.AuditSuccess,
+	}
+	if err != nil {
+		entry.Outcome = dbtype.AuditFailure
+		entry.Detail = err.Error()
+	}
+
+	s.sendDatabase(&dbtype.Message{
+		ServiceID: entry.ServiceID,
+		Audit:     []dbtype.AuditEntry{entry},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+func TestStatus_Audit(t *testing.T) {
+	// GIVEN: a Status, and the outcome of an action.
+	tests := []struct {
+		name        string
+		nilStatus   bool
+		deleting    bool
+		err         error
+		wantOutcome string
+		wantDetail  string
+		wantMessage bool
+	}{
+		{
+			name:        "success",
+			wantOutcome: dbtype.AuditSuccess,
+			wantMessage: true,
+		},
+		{
+			name:        "failure",
+			err:         errors.New("exit status 1"),
+			wantOutcome: dbtype.AuditFailure,
+			wantDetail:  "exit status 1",
+			wantMessage: true,
+		},
+		{
+			name:        "deleting",
+			deleting:    true,
+			wantMessage: false,
+		},
+		{
+			name:      "nil Status",
+			nilStatus: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			databaseChannel := make(chan dbtype.Message, 4)
+			status := &Status{}
+			status.DatabaseChannel = databaseChannel
+			status.ServiceInfo.ID = "TestStatus_Audit"
+			if tc.deleting {
+				status.SetDeleting()
+			}
+			if tc.nilStatus {
+				status = nil
+			}
+
+			// WHEN: Audit is called.
+			status.Audit(dbtype.AuditCommandRun, "ls -lah", tc.err)
+
+			prefix := fmt.Sprintf("%s\nStatus.Audit(err=%v)", packageName, tc.err)
+
+			// THEN: an audit entry is sent to the Database when expected.
+			if got := len(databaseChannel) == 1; got != tc.wantMessage {
+				t.Fatalf("%s message sent mismatch\ngot:  %t\nwant: %t",
+					prefix, got, tc.wantMessage)
+			}
+			if !tc.wantMessage {
+				return
+			}
+			message := <-databaseChannel
+			if len(message.Audit) != 1 {
+				t.Fatalf("%s Audit count mismatch\ngot:  %+v\nwant: 1 entry",
+					prefix, message.Audit)
+			}
+			got := message.Audit[0]
+			if got.Timestamp == "" {
+				t.Errorf("%s Timestamp not set", prefix)
+			}
+			got.Timestamp = ""
+			want := dbtype.AuditEntry{
+				Actor:     dbtype.AuditActorArgus,
+				Action:    dbtype.AuditCommandRun,
+				ServiceID: "TestStatus_Audit",
+				Target:    "ls -lah",
+				Outcome:   tc.wantOutcome,
+				Detail:    tc.wantDetail,
+			}
+			if got != want {
+				t.Errorf("%s entry mismatch\ngot:  %+v\nwant: %+v",
+					prefix, got, want)
+			}
+		})
+	}
+}
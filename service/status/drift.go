@@ -0,0 +1,195 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"slices"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+// undeployedVersion is a LatestVersion that has not been deployed.
+type undeployedVersion struct {
+	version string // Version found.
+	found   string // UTC timestamp the version was found to be the LatestVersion.
+}
+
+// undeployedVersions are the LatestVersions found since the DeployedVersion, oldest first.
+type undeployedVersions []undeployedVersion
+
+// latest records version becoming the LatestVersion at timestamp.
+// If version was already found, the versions found after it are dropped (e.g. a retracted release).
+func (u *undeployedVersions) latest(version, timestamp string) {
+	if version == "" {
+		return
+	}
+
+	if index := u.index(version); index != -1 {
+		*u = (*u)[:index+1]
+		return
+	}
+	*u = append(*u, undeployedVersion{version: version, found: timestamp})
+}
+
+// deployed removes version, and the versions found before it, returning the time
+// from when version was found until timestamp (and whether that is known).
+func (u *undeployedVersions) deployed(version, timestamp string) (time.Duration, bool) {
+	index := u.index(version)
+	if index == -1 {
+		return 0, false
+	}
+
+	found := (*u)[index].found
+	*u = slices.Clone((*u)[index+1:])
+
+	foundAt, err := time.Parse(time.RFC3339, found)
+	if err != nil {
+		return 0, false
+	}
+	deployedAt, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil || deployedAt.Before(foundAt) {
+		return 0, false
+	}
+	return deployedAt.Sub(foundAt), true
+}
+
+// index returns the index of version, or -1 if not present.
+func (u undeployedVersions) index(version string) int {
+	return slices.IndexFunc(u, func(v undeployedVersion) bool {
+		return v.version == version
+	})
+}
+
+// LeadTimes replays the 'latest' and 'deployed' events (oldest first),
+// returning the time from each deployed version being found until its deployment.
+func LeadTimes(events []dbtype.Event) []time.Duration {
+	_, leadTimes := replay(events)
+	return leadTimes
+}
+
+// replay returns the versions left undeployed after the 'latest' and 'deployed' events (oldest first),
+// and the lead time of each deployment of a version that had been found.
+func replay(events []dbtype.Event) (undeployedVersions, []time.Duration) {
+	var (
+		undeployed undeployedVersions
+		leadTimes  []time.Duration
+	)
+	for _, event := range events {
+		switch event.Type {
+		case dbtype.EventLatest:
+			undeployed.latest(event.Version, event.Timestamp)
+		case dbtype.EventDeployed:
+			if leadTime, ok := undeployed.deployed(event.Version, event.Timestamp); ok {
+				leadTimes = append(leadTimes, leadTime)
+			}
+		}
+	}
+
+	return undeployed, leadTimes
+}
+
+// ReplayHistory restores the undeployed versions, last lead time, and last reminder, from the
+// 'latest', 'deployed' and 'reminded' events (oldest first) of the Service.
+func (s *Status) ReplayHistory(events []dbtype.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	undeployed, leadTimes := replay(events)
+	// Drop anything up to the current DeployedVersion, in case its event was pruned.
+	undeployed.deployed(s.ServiceInfo.DeployedVersion, "")
+	s.undeployed = undeployed
+	if len(leadTimes) != 0 {
+		s.lastLeadTime = new(leadTimes[len(leadTimes)-1])
+	}
+	s.reminder = lastReminder(events, s.ServiceInfo.LatestVersion)
+	s.reconcileUndeployed()
+}
+
+// CopyDriftFrom copies the undeployed versions, last lead time, and last reminder, from other.
+func (s *Status) CopyDriftFrom(other *Status) {
+	if other == nil {
+		return
+	}
+	other.mu.RLock()
+	undeployed := slices.Clone(other.undeployed)
+	lastLeadTime := other.lastLeadTime
+	lastReminder := other.reminder
+	other.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.undeployed = undeployed
+	s.lastLeadTime = lastLeadTime
+	s.reminder = lastReminder
+	s.reconcileUndeployed()
+}
+
+// reconcileUndeployed ensures the undeployed versions agree with the Latest/DeployedVersion.
+func (s *Status) reconcileUndeployed() {
+	latestVersion, deployedVersion := s.ServiceInfo.LatestVersion, s.ServiceInfo.DeployedVersion
+	switch {
+	case deployedVersion == "":
+		// Keep them until the DeployedVersion is known.
+	case latestVersion == "", latestVersion == deployedVersion:
+		s.undeployed = nil
+	case s.undeployed.index(latestVersion) == -1:
+		s.undeployed = append(s.undeployed, undeployedVersion{version: latestVersion, found: s.latestVersionTimestamp})
+	}
+}
+
+// Drift returns the number of LatestVersions found that the DeployedVersion is behind,
+// and when the oldest of those was found (zero if up to date, or unknown).
+func (s *Status) Drift() (int, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.drift()
+}
+
+// drift returns Drift without locking.
+func (s *Status) drift() (int, time.Time) {
+	if s.ServiceInfo.LatestVersion == "" || s.ServiceInfo.DeployedVersion == "" ||
+		s.ServiceInfo.LatestVersion == s.ServiceInfo.DeployedVersion || len(s.undeployed) == 0 {
+		return 0, time.Time{}
+	}
+
+	since, _ := time.Parse(time.RFC3339, s.undeployed[0].found)
+	return len(s.undeployed), since
+}
+
+// LeadTime returns the time from the last deployed version being found until its deployment,
+// and whether that is known.
+func (s *Status) LeadTime() (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.lastLeadTime == nil {
+		return 0, false
+	}
+	return *s.lastLeadTime, true
+}
+
+// setDriftMetrics sets the Prometheus metrics for the number of versions behind, and the drift age.
+func (s *Status) setDriftMetrics() {
+	versionsBehind, since := s.drift()
+	metric.SetPrometheusGauge(
+		metric.LatestVersionVersionsBehind,
+		s.ServiceInfo.ID, "",
+		float64(versionsBehind),
+	)
+	metric.LatestVersionDrift.Set(s.ServiceInfo.ID, since)
+}
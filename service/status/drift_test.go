@@ -0,0 +1,328 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	promclient "github.com/prometheus/client_model/go"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+func TestLeadTimes(t *testing.T) {
+	// GIVEN: the 'latest' and 'deployed' events of a Service.
+	tests := []struct {
+		name   string
+		events []dbtype.Event
+		want   []time.Duration
+	}{
+		{
+			name: "no events",
+			want: nil,
+		},
+		{
+			name: "deployed the latest version",
+			events: []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T02:00:00Z"},
+			},
+			want: []time.Duration{2 * time.Hour},
+		},
+		{
+			name: "deployed an older version, then the latest",
+			events: []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+				{Type: dbtype.EventLatest, Version: "1.1.0", Timestamp: "2026-01-02T00:00:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-03T00:00:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.1.0", Timestamp: "2026-01-03T01:00:00Z"},
+			},
+			want: []time.Duration{48 * time.Hour, 25 * time.Hour},
+		},
+		{
+			name: "deployed the latest, skipping a version",
+			events: []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+				{Type: dbtype.EventLatest, Version: "1.1.0", Timestamp: "2026-01-02T00:00:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.1.0", Timestamp: "2026-01-02T00:30:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-02T01:00:00Z"},
+			},
+			want: []time.Duration{30 * time.Minute},
+		},
+		{
+			name: "deployed a version never found",
+			events: []dbtype.Event{
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+			},
+			want: nil,
+		},
+		{
+			name: "deployed before found",
+			events: []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-02T00:00:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+			},
+			want: nil,
+		},
+		{
+			name: "other event types ignored",
+			events: []dbtype.Event{
+				{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+				{Type: dbtype.EventApproved, Version: "1.0.0", Timestamp: "2026-01-01T00:10:00Z"},
+				{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T00:20:00Z"},
+			},
+			want: []time.Duration{20 * time.Minute},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: LeadTimes is called on them.
+			got := LeadTimes(tc.events)
+
+			// THEN: the lead time of each deployment is returned.
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("%s\nLeadTimes() mismatch\ngot:  %v\nwant: %v",
+					packageName, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStatus_Drift(t *testing.T) {
+	// GIVEN: a Status on the LatestVersion.
+	status := testStatus()
+	// setLatest finds version at found, released well before.
+	setLatest := func(version, found string) {
+		status.SetLastQueried(found)
+		status.SetLatestVersion(version, "2025-01-01T00:00:00Z", false)
+	}
+	setLatest("1.0.0", "2026-01-01T00:00:00Z")
+	status.SetDeployedVersion("1.0.0", "2026-01-01T01:00:00Z", false)
+
+	steps := []struct {
+		name               string
+		do                 func()
+		wantVersionsBehind int
+		wantSince          string
+		wantLeadTime       time.Duration // -1 for unknown.
+	}{
+		{
+			name:               "up to date",
+			do:                 func() {},
+			wantVersionsBehind: 0,
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name:               "new latest version",
+			do:                 func() { setLatest("1.1.0", "2026-01-02T00:00:00Z") },
+			wantVersionsBehind: 1,
+			wantSince:          "2026-01-02T00:00:00Z",
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name:               "another latest version",
+			do:                 func() { setLatest("1.2.0", "2026-01-03T00:00:00Z") },
+			wantVersionsBehind: 2,
+			wantSince:          "2026-01-02T00:00:00Z",
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name:               "latest version retracted",
+			do:                 func() { setLatest("1.1.0", "2026-01-04T00:00:00Z") },
+			wantVersionsBehind: 1,
+			wantSince:          "2026-01-02T00:00:00Z",
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name: "DeployedVersion unknown",
+			do: func() {
+				status.SetDeployedVersion("", "", false)
+			},
+			wantVersionsBehind: 0,
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name: "DeployedVersion known again, and newer latest version",
+			do: func() {
+				status.SetDeployedVersion("1.0.0", "2026-01-04T00:00:00Z", false)
+				setLatest("1.3.0", "2026-01-05T00:00:00Z")
+			},
+			wantVersionsBehind: 2,
+			wantSince:          "2026-01-02T00:00:00Z",
+			wantLeadTime:       time.Hour,
+		},
+		{
+			name:               "deployed an older undeployed version",
+			do:                 func() { status.SetDeployedVersion("1.1.0", "2026-01-05T00:00:00Z", false) },
+			wantVersionsBehind: 1,
+			wantSince:          "2026-01-05T00:00:00Z",
+			wantLeadTime:       72 * time.Hour,
+		},
+		{
+			name:               "deployed the latest version",
+			do:                 func() { status.SetDeployedVersion("1.3.0", "2026-01-05T00:30:00Z", false) },
+			wantVersionsBehind: 0,
+			wantLeadTime:       30 * time.Minute,
+		},
+	}
+
+	for _, step := range steps {
+		// WHEN: the versions change.
+		step.do()
+
+		prefix := fmt.Sprintf("%s\n%s:", packageName, step.name)
+
+		// THEN: Drift returns the versions behind, and since when.
+		versionsBehind, since := status.Drift()
+		if versionsBehind != step.wantVersionsBehind {
+			t.Errorf("%s Status.Drift() versions behind mismatch\ngot:  %d\nwant: %d",
+				prefix, versionsBehind, step.wantVersionsBehind)
+		}
+		gotSince := ""
+		if !since.IsZero() {
+			gotSince = since.UTC().Format(time.RFC3339)
+		}
+		if gotSince != step.wantSince {
+			t.Errorf("%s Status.Drift() since mismatch\ngot:  %q\nwant: %q",
+				prefix, gotSince, step.wantSince)
+		}
+		// AND: LeadTime returns that of the last deployment.
+		if leadTime, ok := status.LeadTime(); !ok || leadTime != step.wantLeadTime {
+			t.Errorf("%s Status.LeadTime() mismatch\ngot:  %v (%t)\nwant: %v",
+				prefix, leadTime, ok, step.wantLeadTime)
+		}
+	}
+}
+
+func TestStatus_ReplayHistory(t *testing.T) {
+	// GIVEN: a Status loaded from the database, and its history.
+	status := testStatus()
+	status.SetLatestVersion("1.2.0", "2026-01-03T00:00:00Z", false)
+	status.SetDeployedVersion("1.0.0", "2026-01-01T01:00:00Z", false)
+	events := []dbtype.Event{
+		{Type: dbtype.EventLatest, Version: "1.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+		{Type: dbtype.EventDeployed, Version: "1.0.0", Timestamp: "2026-01-01T01:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.1.0", Timestamp: "2026-01-02T00:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.2.0", Timestamp: "2026-01-03T00:00:00Z"},
+	}
+
+	// WHEN: ReplayHistory is called.
+	status.ReplayHistory(events)
+
+	prefix := fmt.Sprintf("%s\nStatus.ReplayHistory()", packageName)
+
+	// THEN: the versions behind include those found between.
+	if versionsBehind, since := status.Drift(); versionsBehind != 2 ||
+		since.UTC().Format(time.RFC3339) != "2026-01-02T00:00:00Z" {
+		t.Errorf("%s Drift mismatch\ngot:  %d since %v\nwant: 2 since 2026-01-02T00:00:00Z",
+			prefix, versionsBehind, since)
+	}
+	// AND: the lead time of the last deployment is restored.
+	if leadTime, ok := status.LeadTime(); !ok || leadTime != time.Hour {
+		t.Errorf("%s LeadTime mismatch\ngot:  %v (%t)\nwant: %v",
+			prefix, leadTime, ok, time.Hour)
+	}
+
+	// WHEN: the drift is copied to another Status with the same versions.
+	other := testStatus()
+	other.SetLatestVersion("1.2.0", "2026-01-03T00:00:00Z", false)
+	other.SetDeployedVersion("1.0.0", "2026-01-01T01:00:00Z", false)
+	other.CopyDriftFrom(status)
+
+	// THEN: it is behind by as many versions.
+	if versionsBehind, _ := other.Drift(); versionsBehind != 2 {
+		t.Errorf("%s\nStatus.CopyDriftFrom() versions behind mismatch\ngot:  %d\nwant: 2",
+			packageName, versionsBehind)
+	}
+}
+
+func TestStatus_DriftMetrics(t *testing.T) {
+	// GIVEN: a Status on the LatestVersion.
+	status := testStatus()
+	status.ServiceInfo.ID = "TestStatus_DriftMetrics"
+	status.SetLastQueried("2026-01-01T00:00:00Z")
+	status.SetLatestVersion("1.0.0", "", false)
+	status.SetDeployedVersion("1.0.0", "2026-01-01T01:00:00Z", false)
+	status.InitMetrics()
+	t.Cleanup(func() { status.DeleteMetrics() })
+
+	// THEN: it is 0 versions behind, and the last lead time is set.
+	if got := testutil.ToFloat64(metric.LatestVersionVersionsBehind.WithLabelValues(status.ServiceInfo.ID)); got != 0 {
+		t.Errorf("%s\nStatus.InitMetrics() versions behind mismatch\ngot:  %f\nwant: 0",
+			packageName, got)
+	}
+	if got := testutil.ToFloat64(metric.DeploymentLeadTimeLast.WithLabelValues(status.ServiceInfo.ID)); got != 3600 {
+		t.Errorf("%s\nStatus.InitMetrics() last lead time mismatch\ngot:  %f\nwant: 3600",
+			packageName, got)
+	}
+
+	// WHEN: a new LatestVersion is found.
+	found := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	status.SetLastQueried(found)
+	status.SetLatestVersion("1.1.0", "2025-01-01T00:00:00Z", true)
+
+	// THEN: it is 1 version behind, for about an hour.
+	if got := testutil.ToFloat64(metric.LatestVersionVersionsBehind.WithLabelValues(status.ServiceInfo.ID)); got != 1 {
+		t.Errorf("%s\nStatus.SetLatestVersion() versions behind mismatch\ngot:  %f\nwant: 1",
+			packageName, got)
+	}
+	if got := metric.LatestVersionDrift.Seconds(status.ServiceInfo.ID); got < 3599 || got > 3700 {
+		t.Errorf("%s\nStatus.SetLatestVersion() drift mismatch\ngot:  %f\nwant: ~3600",
+			packageName, got)
+	}
+
+	// WHEN: that version is deployed.
+	status.SetDeployedVersion("1.1.0", "", true)
+
+	// THEN: it is up to date.
+	if got := testutil.ToFloat64(metric.LatestVersionVersionsBehind.WithLabelValues(status.ServiceInfo.ID)); got != 0 {
+		t.Errorf("%s\nStatus.SetDeployedVersion() versions behind mismatch\ngot:  %f\nwant: 0",
+			packageName, got)
+	}
+	if got := metric.LatestVersionDrift.Seconds(status.ServiceInfo.ID); got != 0 {
+		t.Errorf("%s\nStatus.SetDeployedVersion() drift mismatch\ngot:  %f\nwant: 0",
+			packageName, got)
+	}
+	// AND: the lead time was observed.
+	var histogram promclient.Metric
+	_ = metric.DeploymentLeadTimeSeconds.WithLabelValues(status.ServiceInfo.ID).(prometheus.Histogram).Write(&histogram)
+	if got := histogram.GetHistogram().GetSampleCount(); got != 1 {
+		t.Errorf("%s\nStatus.SetDeployedVersion() lead time histogram count mismatch\ngot:  %d\nwant: 1",
+			packageName, got)
+	}
+	if got := testutil.ToFloat64(metric.DeploymentLeadTimeLast.WithLabelValues(status.ServiceInfo.ID)); got < 3599 || got > 3700 {
+		t.Errorf("%s\nStatus.SetDeployedVersion() last lead time mismatch\ngot:  %f\nwant: ~3600",
+			packageName, got)
+	}
+
+	// WHEN: the metrics are deleted.
+	status.DeleteMetrics()
+
+	// THEN: the lead time histogram of the Service is removed.
+	if metric.DeploymentLeadTimeSeconds.DeleteLabelValues(status.ServiceInfo.ID) {
+		t.Errorf("%s\nStatus.DeleteMetrics() did not remove the lead time histogram",
+			packageName)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package status provides the status functionality to keep track of the approved/deployed/latest versions of a Service.
+package status
+
+import (
+	"slices"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	dbtype "github.com/release-argus/Argus/db/types"
+	serviceinfo "github.com/release-argus/Argus/service/status/info"
+	apitype "github.com/release-argus/Argus/web/api/types"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+// Instance is the deployed version reported by one instance of a Service.
+type Instance struct {
+	Name      string // Name of the instance.
+	Version   string // Version last reported by the instance.
+	Timestamp string // UTC timestamp that the Version changed.
+	Error     string // Error from the last query of the instance.
+}
+
+// Instances returns a copy of the versions reported by each instance of the Service.
+func (s *Status) Instances() []Instance {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Clone(s.instances)
+}
+
+// SetInstances sets the versions reported by each instance of the Service, returning whether they changed.
+//
+// An instance that failed to report a version (empty Version) keeps its previous Version,
+// and an instance reporting an unchanged Version keeps its previous Timestamp.
+func (s *Status) SetInstances(instances []Instance, writeToDB bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.deleting {
+		return false
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	newInstances := make([]Instance, len(instances))
+	for i, instance := range instances {
+		previousIndex := slices.IndexFunc(s.instances, func(previous Instance) bool {
+			return previous.Name == instance.Name
+		})
+		switch {
+		case previousIndex != -1 && (instance.Version == "" || instance.Version == s.instances[previousIndex].Version):
+			instance.Version = s.instances[previousIndex].Version
+			instance.Timestamp = s.instances[previousIndex].Timestamp
+		case instance.Version != "":
+			instance.Timestamp = now
+		}
+		newInstances[i] = instance
+	}
+	if len(newInstances) == 0 {
+		newInstances = nil
+	}
+
+	if slices.Equal(s.instances, newInstances) {
+		return false
+	}
+	previousInstances := s.instances
+	s.instances = newInstances
+	s.refreshServiceInfo()
+
+	if writeToDB {
+		setDeployedVersionInstanceMetrics(s.ServiceInfo, previousInstances, newInstances)
+
+		// Database.
+		s.sendDatabase(&dbtype.Message{
+			ServiceID: s.ServiceInfo.ID,
+			Cells: []dbtype.Cell{
+				{Column: "instances", Value: encodeInstances(s.instancesSummary())},
+			},
+		})
+	}
+
+	return true
+}
+
+// RestoreInstances sets the versions reported by each instance of the Service
+// from their encoding in the database (see [encodeInstances]), clearing them if empty.
+func (s *Status) RestoreInstances(encoded string) error {
+	var summary []apitype.Instance
+	if encoded != "" {
+		if err := decode.Unmarshal("json", []byte(encoded), &summary); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(summary) == 0 {
+		s.instances = nil
+		s.refreshServiceInfo()
+		return nil
+	}
+	s.instances = make([]Instance, len(summary))
+	for i, instance := range summary {
+		s.instances[i] = Instance(instance)
+	}
+	s.refreshServiceInfo()
+
+	return nil
+}
+
+// encodeInstances returns the encoding of the instances for the database, or "" if there are none.
+func encodeInstances(instances []apitype.Instance) string {
+	if len(instances) == 0 {
+		return ""
+	}
+
+	data, _ := decode.Marshal("json", instances)
+	return string(data)
+}
+
+// InstancesSummary returns the versions reported by each instance of the Service for the API.
+func (s *Status) InstancesSummary() []apitype.Instance {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.instancesSummary()
+}
+
+// instancesSummary returns the versions reported by each instance for the API.
+// Requires the Status mutex to already be held.
+func (s *Status) instancesSummary() []apitype.Instance {
+	if len(s.instances) == 0 {
+		return nil
+	}
+
+	summary := make([]apitype.Instance, len(s.instances))
+	for i, instance := range s.instances {
+		summary[i] = apitype.Instance{
+			Name:      instance.Name,
+			Version:   instance.Version,
+			Timestamp: instance.Timestamp,
+			Error:     instance.Error,
+		}
+	}
+	return summary
+}
+
+// fleetState returns the aggregate state of the versions reported by the instances.
+// Requires the Status mutex to already be held.
+func (s *Status) fleetState() string {
+	versions := make([]string, len(s.instances))
+	for i, instance := range s.instances {
+		versions[i] = instance.Version
+	}
+
+	return serviceinfo.FleetState(versions, s.ServiceInfo.LatestVersion)
+}
+
+// setDeployedVersionInstanceMetrics sets the Prometheus metrics for the versions reported by each instance,
+// removing those of instances no longer reported.
+func setDeployedVersionInstanceMetrics(serviceInfo serviceinfo.ServiceInfo, previousInstances, newInstances []Instance) {
+	for _, instance := range previousInstances {
+		if !slices.ContainsFunc(newInstances, func(newInstance Instance) bool {
+			return newInstance.Name == instance.Name
+		}) {
+			metric.DeleteDeployedVersionInstance(serviceInfo.ID, instance.Name)
+		}
+	}
+	for _, instance := range newInstances {
+		if instance.Version != "" {
+			metric.SetDeployedVersionInstance(serviceInfo.ID, instance.Name, instance.Version)
+		}
+	}
+
+	setDeployedVersionFleetStateMetric(serviceInfo)
+}
+
+// setDeployedVersionFleetStateMetric sets the Prometheus metric for the aggregate state of the instances.
+func setDeployedVersionFleetStateMetric(serviceInfo serviceinfo.ServiceInfo) {
+	metric.SetPrometheusGauge(
+		metric.DeployedVersionFleetStateLast,
+		serviceInfo.ID, "",
+		float64(metric.GetFleetState(serviceInfo)),
+	)
+}
@@ -0,0 +1,352 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	serviceinfo "github.com/release-argus/Argus/service/status/info"
+	"github.com/release-argus/Argus/web/metric"
+)
+
+func TestStatus_SetInstances(t *testing.T) {
+	// GIVEN: a Status with instances, and the instances to set.
+	tests := []struct {
+		name           string
+		previous       []Instance
+		instances      []Instance
+		wantChanged    bool
+		want           []Instance
+		wantFleetState string
+	}{
+		{
+			name: "first report",
+			instances: []Instance{
+				{Name: "a", Version: "2.2.2"},
+				{Name: "b", Version: "1.1.1"},
+			},
+			wantChanged: true,
+			want: []Instance{
+				{Name: "a", Version: "2.2.2", Timestamp: "<now>"},
+				{Name: "b", Version: "1.1.1", Timestamp: "<now>"},
+			},
+			wantFleetState: serviceinfo.FleetPartial,
+		},
+		{
+			name: "unchanged versions keep timestamps",
+			previous: []Instance{
+				{Name: "a", Version: "2.2.2", Timestamp: "2020-01-01T00:00:00Z"},
+				{Name: "b", Version: "2.2.2", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			instances: []Instance{
+				{Name: "a", Version: "2.2.2"},
+				{Name: "b", Version: "2.2.2"},
+			},
+			wantChanged: false,
+			want: []Instance{
+				{Name: "a", Version: "2.2.2", Timestamp: "2020-01-01T00:00:00Z"},
+				{Name: "b", Version: "2.2.2", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			wantFleetState: serviceinfo.FleetLatest,
+		},
+		{
+			name: "changed version gets a new timestamp",
+			previous: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+				{Name: "b", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			instances: []Instance{
+				{Name: "a", Version: "2.2.2"},
+				{Name: "b", Version: "1.1.1"},
+			},
+			wantChanged: true,
+			want: []Instance{
+				{Name: "a", Version: "2.2.2", Timestamp: "<now>"},
+				{Name: "b", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			wantFleetState: serviceinfo.FleetPartial,
+		},
+		{
+			name: "failed instance keeps its version",
+			previous: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			instances: []Instance{
+				{Name: "a", Error: "timeout"},
+			},
+			wantChanged: true,
+			want: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z", Error: "timeout"},
+			},
+			wantFleetState: serviceinfo.FleetOutdated,
+		},
+		{
+			name: "removed instances are dropped",
+			previous: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+				{Name: "b", Version: "1.0.0", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			instances: []Instance{
+				{Name: "a", Version: "1.1.1"},
+			},
+			wantChanged: true,
+			want: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			wantFleetState: serviceinfo.FleetOutdated,
+		},
+		{
+			name: "cleared",
+			previous: []Instance{
+				{Name: "a", Version: "1.1.1", Timestamp: "2020-01-01T00:00:00Z"},
+			},
+			wantChanged:    true,
+			wantFleetState: serviceinfo.FleetUnknown,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := testStatus()
+			status.ServiceInfo.ID = "TestStatus_SetInstances/" + tc.name
+			status.instances = tc.previous
+			status.RefreshServiceInfo()
+
+			// WHEN: SetInstances is called.
+			gotChanged := status.SetInstances(tc.instances, false)
+
+			// THEN: whether they changed is reported.
+			if gotChanged != tc.wantChanged {
+				t.Errorf(
+					"%s\nStatus.SetInstances() changed mismatch\ngot:  %t\nwant: %t",
+					packageName, gotChanged, tc.wantChanged,
+				)
+			}
+			// AND: the instances are as expected.
+			got := status.Instances()
+			if len(got) != len(tc.want) {
+				t.Fatalf(
+					"%s\nStatus.SetInstances() mismatch\ngot:  %+v\nwant: %+v",
+					packageName, got, tc.want,
+				)
+			}
+			for i := range tc.want {
+				want := tc.want[i]
+				if want.Timestamp == "<now>" {
+					if got[i].Timestamp == "" || got[i].Timestamp == "2020-01-01T00:00:00Z" {
+						t.Errorf(
+							"%s\nStatus.SetInstances() [%d].Timestamp not updated\ngot:  %q",
+							packageName, i, got[i].Timestamp,
+						)
+					}
+					want.Timestamp = got[i].Timestamp
+				}
+				if got[i] != want {
+					t.Errorf(
+						"%s\nStatus.SetInstances() [%d] mismatch\ngot:  %+v\nwant: %+v",
+						packageName, i, got[i], want,
+					)
+				}
+			}
+			// AND: the FleetState is as expected.
+			if gotFleetState := status.GetServiceInfo().FleetState; gotFleetState != tc.wantFleetState {
+				t.Errorf(
+					"%s\nStatus.SetInstances() .FleetState mismatch\ngot:  %q\nwant: %q",
+					packageName, gotFleetState, tc.wantFleetState,
+				)
+			}
+		})
+	}
+}
+
+func TestStatus_SetInstances_Metrics(t *testing.T) {
+	// GIVEN: a Status.
+	status := testStatus()
+	status.ServiceInfo.ID = "TestStatus_SetInstances_Metrics"
+
+	// WHEN: SetInstances is called with writeToDB.
+	status.SetInstances(
+		[]Instance{
+			{Name: "a", Version: "2.2.2"},
+			{Name: "b", Version: "1.1.1"},
+		},
+		true,
+	)
+
+	// THEN: the fleet state metric is set.
+	if got, want := testutil.ToFloat64(metric.DeployedVersionFleetStateLast.WithLabelValues(status.ServiceInfo.ID)),
+		float64(metric.DeployedVersionFleetPartial); got != want {
+		t.Errorf(
+			"%s\nStatus.SetInstances() fleet state metric mismatch\ngot:  %f\nwant: %f",
+			packageName, got, want,
+		)
+	}
+	// AND: each instance is reported.
+	for _, instance := range [][2]string{{"a", "2.2.2"}, {"b", "1.1.1"}} {
+		if got := testutil.ToFloat64(metric.DeployedVersionInstance.WithLabelValues(
+			status.ServiceInfo.ID, instance[0], instance[1])); got != 1 {
+			t.Errorf(
+				"%s\nStatus.SetInstances() instance %q metric mismatch\ngot:  %f\nwant: 1",
+				packageName, instance[0], got,
+			)
+		}
+	}
+
+	// WHEN: the LatestVersion changes.
+	status.SetLatestVersion("3.0.0", "", true)
+
+	// THEN: the fleet state metric is updated.
+	if got, want := testutil.ToFloat64(metric.DeployedVersionFleetStateLast.WithLabelValues(status.ServiceInfo.ID)),
+		float64(metric.DeployedVersionFleetMixed); got != want {
+		t.Errorf(
+			"%s\nStatus.SetLatestVersion() fleet state metric mismatch\ngot:  %f\nwant: %f",
+			packageName, got, want,
+		)
+	}
+
+	// WHEN: the metrics are deleted.
+	status.DeleteMetrics()
+
+	// THEN: no instances are reported.
+	if got := testutil.CollectAndCount(metric.DeployedVersionInstance); got != 0 {
+		t.Errorf(
+			"%s\nStatus.DeleteMetrics() instance metric count mismatch\ngot:  %d\nwant: 0",
+			packageName, got,
+		)
+	}
+}
+
+func TestStatus_SetInstances_Database(t *testing.T) {
+	// GIVEN: a Status.
+	status := testStatus()
+	instances := []Instance{
+		{Name: "a", Version: "2.2.2"},
+		{Name: "b", Error: "timeout"},
+	}
+
+	// WHEN: SetInstances is called with writeToDB.
+	status.SetInstances(instances, true)
+
+	// THEN: the instances are sent to the database.
+	if got := len(status.DatabaseChannel); got != 1 {
+		t.Fatalf(
+			"%s\nStatus.SetInstances() database message count mismatch\ngot:  %d\nwant: 1",
+			packageName, got,
+		)
+	}
+	message := <-status.DatabaseChannel
+	if len(message.Cells) != 1 || message.Cells[0].Column != "instances" {
+		t.Fatalf(
+			"%s\nStatus.SetInstances() database cells mismatch\ngot:  %+v",
+			packageName, message.Cells,
+		)
+	}
+
+	// WHEN: the instances are restored from the database on another Status.
+	restored := testStatus()
+	restored.SetLatestVersion("2.2.2", "", false)
+	err := restored.RestoreInstances(message.Cells[0].Value)
+
+	// THEN: they match the instances that were set.
+	if err != nil {
+		t.Fatalf(
+			"%s\nStatus.RestoreInstances() error mismatch\ngot:  %v\nwant: nil",
+			packageName, err,
+		)
+	}
+	if got, want := restored.Instances(), status.Instances(); !slices.Equal(got, want) {
+		t.Errorf(
+			"%s\nStatus.RestoreInstances() mismatch\ngot:  %+v\nwant: %+v",
+			packageName, got, want,
+		)
+	}
+	// AND: the fleet state is refreshed.
+	if got := restored.GetServiceInfo().FleetState; got != serviceinfo.FleetLatest {
+		t.Errorf(
+			"%s\nStatus.RestoreInstances() FleetState mismatch\ngot:  %q\nwant: %q",
+			packageName, got, serviceinfo.FleetLatest,
+		)
+	}
+
+	// WHEN: invalid instances are restored.
+	err = restored.RestoreInstances("[")
+
+	// THEN: an error is returned.
+	if err == nil {
+		t.Errorf(
+			"%s\nStatus.RestoreInstances() error mismatch\ngot:  nil\nwant: error",
+			packageName,
+		)
+	}
+	// WHEN: no instances are restored.
+	err = restored.RestoreInstances("")
+
+	// THEN: the instances are cleared.
+	if got := restored.Instances(); err != nil || len(got) != 0 {
+		t.Errorf(
+			"%s\nStatus.RestoreInstances(\"\") mismatch\ngot:  %+v (err=%v)\nwant: none",
+			packageName, got, err,
+		)
+	}
+}
+
+func TestStatus_InstancesSummary(t *testing.T) {
+	// GIVEN: a Status with instances.
+	status := testStatus()
+	status.SetInstances(
+		[]Instance{
+			{Name: "a", Version: "2.2.2"},
+			{Name: "b", Error: "timeout"},
+		},
+		false,
+	)
+
+	// WHEN: InstancesSummary is called.
+	got := status.InstancesSummary()
+
+	// THEN: each instance is summarised.
+	if len(got) != 2 ||
+		got[0].Name != "a" || got[0].Version != "2.2.2" || got[0].Timestamp == "" ||
+		got[1].Name != "b" || got[1].Version != "" || got[1].Error != "timeout" {
+		t.Errorf(
+			"%s\nStatus.InstancesSummary() mismatch\ngot:  %+v",
+			packageName, got,
+		)
+	}
+
+	// WHEN: the Status is copied.
+	copied := status.Copy(false)
+
+	// THEN: the instances are copied.
+	if got := copied.Instances(); len(got) != 2 || got[0].Version != "2.2.2" {
+		t.Errorf(
+			"%s\nStatus.Copy() .Instances() mismatch\ngot:  %+v",
+			packageName, got,
+		)
+	}
+	if got := copied.GetServiceInfo().FleetState; got != serviceinfo.FleetLatest {
+		t.Errorf(
+			"%s\nStatus.Copy() .FleetState mismatch\ngot:  %q\nwant: %q",
+			packageName, got, serviceinfo.FleetLatest,
+		)
+	}
+}
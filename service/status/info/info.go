@@ -15,7 +15,12 @@
 // Package info provides information about the service.
 package info
 
-import "sync"
+import (
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+)
 
 // ServiceInfo holds information about a service.
 type ServiceInfo struct {
@@ -33,6 +38,8 @@ type ServiceInfo struct {
 	ApprovedVersion string `json:"approved_version,omitzero"` // The version of the Service that has been approved for deployment.
 	DeployedVersion string `json:"deployed_version,omitzero"` // The deployed version of the Service.
 	LatestVersion   string `json:"latest_version,omitzero"`   // The latest version of the Service.
+	Bump            string `json:"bump,omitzero"`             // Semantic version bump from the deployed/approved version to the latest version.
+	FleetState      string `json:"fleet_state,omitzero"`      // Aggregate state of the versions reported by the instances of the Service.
 
 	Tags []string `json:"tags,omitempty"` // Tags for the Service.
 }
@@ -73,3 +80,104 @@ const SkipPrefix = "SKIP_"
 func SkippedVersion(version string) string {
 	return SkipPrefix + version
 }
+
+// Bump* are the levels of a semantic version bump (see [BumpLevel]).
+const (
+	BumpNone       = ""
+	BumpPrerelease = "prerelease"
+	BumpPatch      = "patch"
+	BumpMinor      = "minor"
+	BumpMajor      = "major"
+)
+
+// BumpLevel returns the semantic version bump level from the deployed version
+// (or the approved version when no version is deployed) to the latest version.
+//
+// Returns BumpNone if any version is not semantic, or latest is not newer than the base version.
+func BumpLevel(deployedVersion, approvedVersion, latestVersion string) string {
+	base := deployedVersion
+	if base == "" && !strings.HasPrefix(approvedVersion, SkipPrefix) {
+		base = approvedVersion
+	}
+	if base == "" || latestVersion == "" {
+		return BumpNone
+	}
+
+	baseVersion, err := semver.NewVersion(base)
+	if err != nil {
+		return BumpNone
+	}
+	latest, err := semver.NewVersion(latestVersion)
+	if err != nil || !latest.GreaterThan(baseVersion) {
+		return BumpNone
+	}
+
+	switch {
+	case latest.Major() != baseVersion.Major():
+		return BumpMajor
+	case latest.Minor() != baseVersion.Minor():
+		return BumpMinor
+	case latest.Patch() != baseVersion.Patch():
+		return BumpPatch
+	default:
+		return BumpPrerelease
+	}
+}
+
+// Fleet* are the aggregate states of the versions reported by the instances of a service (see [FleetState]).
+const (
+	FleetUnknown  = ""
+	FleetLatest   = "latest"
+	FleetPartial  = "partial"
+	FleetMixed    = "mixed"
+	FleetOutdated = "outdated"
+)
+
+// FleetState returns the aggregate state of the versions reported by the instances of a service,
+// compared to the latest version. Instances that have not reported a version are ignored.
+//
+// Returns:
+// - FleetLatest: Every instance is on the latest version.
+// - FleetPartial: Some, but not all, instances are on the latest version.
+// - FleetMixed: No instance is on the latest version, and the instances disagree.
+// - FleetOutdated: Every instance is on the same version, which is not the latest version.
+// - FleetUnknown: No instance has reported a version, or they agree and the latest version is unknown.
+func FleetState(versions []string, latestVersion string) string {
+	var (
+		reported, onLatest int
+		first              string
+	)
+	uniform := true
+	for _, version := range versions {
+		if version == "" {
+			continue
+		}
+		if reported == 0 {
+			first = version
+		} else if version != first {
+			uniform = false
+		}
+		reported++
+		if version == latestVersion {
+			onLatest++
+		}
+	}
+
+	switch {
+	case reported == 0:
+		return FleetUnknown
+	case latestVersion == "":
+		if uniform {
+			return FleetUnknown
+		}
+		return FleetMixed
+	case onLatest == reported:
+		return FleetLatest
+	case onLatest != 0:
+		return FleetPartial
+	case uniform:
+		return FleetOutdated
+	default:
+		return FleetMixed
+	}
+}
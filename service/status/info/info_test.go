@@ -204,3 +204,177 @@ func TestSkippedVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestBumpLevel(t *testing.T) {
+	// GIVEN: deployed/approved/latest versions.
+	tests := []struct {
+		name                             string
+		deployed, approved, latest, want string
+	}{
+		{
+			name:     "major",
+			deployed: "1.2.3", latest: "2.0.0",
+			want: BumpMajor,
+		},
+		{
+			name:     "minor",
+			deployed: "1.2.3", latest: "1.3.0",
+			want: BumpMinor,
+		},
+		{
+			name:     "patch",
+			deployed: "1.2.3", latest: "1.2.4",
+			want: BumpPatch,
+		},
+		{
+			name:     "prerelease",
+			deployed: "1.2.3-rc.1", latest: "1.2.3",
+			want: BumpPrerelease,
+		},
+		{
+			name:     "v prefix",
+			deployed: "v1.2.3", latest: "v1.3.0",
+			want: BumpMinor,
+		},
+		{
+			name:     "same version",
+			deployed: "1.2.3", latest: "1.2.3",
+			want: BumpNone,
+		},
+		{
+			name:     "downgrade",
+			deployed: "2.0.0", latest: "1.2.3",
+			want: BumpNone,
+		},
+		{
+			name:     "non-semantic deployed",
+			deployed: "latest", latest: "1.2.3",
+			want: BumpNone,
+		},
+		{
+			name:     "non-semantic latest",
+			deployed: "1.2.3", latest: "foo",
+			want: BumpNone,
+		},
+		{
+			name:   "no deployed/no approved",
+			latest: "1.2.3",
+			want:   BumpNone,
+		},
+		{
+			name:     "no latest",
+			deployed: "1.2.3",
+			want:     BumpNone,
+		},
+		{
+			name:     "deployed takes precedence over approved",
+			deployed: "1.2.3", approved: "2.0.0", latest: "2.0.0",
+			want: BumpMajor,
+		},
+		{
+			name:     "approved used when no deployed",
+			approved: "1.2.3", latest: "1.3.0",
+			want: BumpMinor,
+		},
+		{
+			name:     "skipped approved ignored",
+			approved: SkippedVersion("1.2.3"), latest: "1.3.0",
+			want: BumpNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: BumpLevel is called.
+			got := BumpLevel(tc.deployed, tc.approved, tc.latest)
+
+			// THEN: the bump level is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nBumpLevel(%q, %q, %q) mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.deployed, tc.approved, tc.latest, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestFleetState(t *testing.T) {
+	// GIVEN: the versions reported by instances, and the latest version.
+	tests := []struct {
+		name         string
+		versions     []string
+		latest, want string
+	}{
+		{
+			name:     "all on latest",
+			versions: []string{"1.2.3", "1.2.3", "1.2.3"},
+			latest:   "1.2.3",
+			want:     FleetLatest,
+		},
+		{
+			name:     "partially rolled out",
+			versions: []string{"1.2.3", "1.2.2", "1.2.3"},
+			latest:   "1.2.3",
+			want:     FleetPartial,
+		},
+		{
+			name:     "mixed",
+			versions: []string{"1.2.1", "1.2.2"},
+			latest:   "1.2.3",
+			want:     FleetMixed,
+		},
+		{
+			name:     "outdated",
+			versions: []string{"1.2.2", "1.2.2"},
+			latest:   "1.2.3",
+			want:     FleetOutdated,
+		},
+		{
+			name:     "unreported instances ignored",
+			versions: []string{"", "1.2.3", ""},
+			latest:   "1.2.3",
+			want:     FleetLatest,
+		},
+		{
+			name:     "no versions reported",
+			versions: []string{"", ""},
+			latest:   "1.2.3",
+			want:     FleetUnknown,
+		},
+		{
+			name:   "no instances",
+			latest: "1.2.3",
+			want:   FleetUnknown,
+		},
+		{
+			name:     "no latest/agree",
+			versions: []string{"1.2.3", "1.2.3"},
+			want:     FleetUnknown,
+		},
+		{
+			name:     "no latest/disagree",
+			versions: []string{"1.2.3", "", "1.2.4"},
+			want:     FleetMixed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: FleetState is called.
+			got := FleetState(tc.versions, tc.latest)
+
+			// THEN: the state is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nFleetState(%q, %q) mismatch\ngot:  %q\nwant: %q",
+					packageName, tc.versions, tc.latest, got, tc.want,
+				)
+			}
+		})
+	}
+}
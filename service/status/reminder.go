@@ -0,0 +1,116 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	serviceinfo "github.com/release-argus/Argus/service/status/info"
+)
+
+// reminder is the last reminder sent that a LatestVersion is undeployed.
+type reminder struct {
+	version   string    // LatestVersion reminded of.
+	at        time.Time // When the reminder was sent.
+	escalated bool      // Whether the reminder was escalated.
+}
+
+// UndeployedRelease is a LatestVersion awaiting deployment.
+type UndeployedRelease struct {
+	Version         string    // LatestVersion.
+	DeployedVersion string    // DeployedVersion.
+	Bump            string    // Semantic version bump level from the DeployedVersion (see [serviceinfo.BumpLevel]).
+	Released        time.Time // When the LatestVersion was released.
+	LastReminded    time.Time // When last reminded of (zero if not yet).
+	Escalated       bool      // Whether the last reminder was escalated.
+}
+
+// Undeployed returns the LatestVersion awaiting deployment, and whether there is one.
+//
+// There is not if either version is unknown, or the LatestVersion is deployed or has been skipped.
+func (s *Status) Undeployed() (UndeployedRelease, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	latestVersion, deployedVersion := s.ServiceInfo.LatestVersion, s.ServiceInfo.DeployedVersion
+	if latestVersion == "" || deployedVersion == "" || latestVersion == deployedVersion ||
+		s.ServiceInfo.ApprovedVersion == serviceinfo.SkippedVersion(latestVersion) {
+		return UndeployedRelease{}, false
+	}
+	released, err := time.Parse(time.RFC3339, s.latestVersionTimestamp)
+	if err != nil {
+		return UndeployedRelease{}, false
+	}
+
+	release := UndeployedRelease{
+		Version:         latestVersion,
+		DeployedVersion: deployedVersion,
+		Bump:            serviceinfo.BumpLevel(deployedVersion, s.ServiceInfo.ApprovedVersion, latestVersion),
+		Released:        released,
+	}
+	if s.reminder.version == latestVersion {
+		release.LastReminded = s.reminder.at
+		release.Escalated = s.reminder.escalated
+	}
+	return release, true
+}
+
+// SetReminded records that a reminder of the undeployed `version` was sent at `at`, adding it to the history.
+func (s *Status) SetReminded(version string, at time.Time, escalated bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Do not record if no longer the LatestVersion, or deleting.
+	if s.ServiceInfo.LatestVersion != version || s.deleting {
+		return
+	}
+
+	source := dbtype.SourceReminder
+	if escalated {
+		source = dbtype.SourceEscalation
+	}
+	at = at.UTC().Truncate(time.Second)
+	s.reminder = reminder{version: version, at: at, escalated: escalated}
+	event := newEvent(dbtype.EventReminded, version, s.ServiceInfo.DeployedVersion, source)
+	event.Timestamp = at.Format(time.RFC3339)
+
+	// Database.
+	message := dbtype.Message{
+		ServiceID: s.ServiceInfo.ID,
+		Events:    []dbtype.Event{event},
+	}
+	s.sendDatabase(&message)
+}
+
+// lastReminder returns the last reminder of version in the 'reminded' events (oldest first).
+func lastReminder(events []dbtype.Event, version string) reminder {
+	var last reminder
+	for _, event := range events {
+		if event.Type != dbtype.EventReminded || event.Version != version || version == "" {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, event.Timestamp)
+		if err != nil {
+			continue
+		}
+		last = reminder{
+			version:   version,
+			at:        at,
+			escalated: last.escalated || event.Source == dbtype.SourceEscalation,
+		}
+	}
+	return last
+}
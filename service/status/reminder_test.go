@@ -0,0 +1,217 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+func TestStatus_Undeployed(t *testing.T) {
+	// GIVEN: a Status with different versions.
+	tests := []struct {
+		name                                     string
+		approvedVersion, deployedVersion, latest string
+		latestTimestamp                          string
+		want                                     UndeployedRelease
+		wantOK                                   bool
+	}{
+		{
+			name:            "latest version undeployed",
+			deployedVersion: "1.0.0",
+			latest:          "2.0.0",
+			latestTimestamp: "2026-01-02T00:00:00Z",
+			want: UndeployedRelease{
+				Version:         "2.0.0",
+				DeployedVersion: "1.0.0",
+				Bump:            "major",
+				Released:        time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name:            "latest version approved",
+			approvedVersion: "1.0.1",
+			deployedVersion: "1.0.0",
+			latest:          "1.0.1",
+			latestTimestamp: "2026-01-02T00:00:00Z",
+			want: UndeployedRelease{
+				Version:         "1.0.1",
+				DeployedVersion: "1.0.0",
+				Bump:            "patch",
+				Released:        time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name:            "latest version deployed",
+			deployedVersion: "1.0.0",
+			latest:          "1.0.0",
+			latestTimestamp: "2026-01-02T00:00:00Z",
+			wantOK:          false,
+		},
+		{
+			name:            "latest version skipped",
+			approvedVersion: "SKIP_2.0.0",
+			deployedVersion: "1.0.0",
+			latest:          "2.0.0",
+			latestTimestamp: "2026-01-02T00:00:00Z",
+			wantOK:          false,
+		},
+		{
+			name:            "deployed version unknown",
+			latest:          "2.0.0",
+			latestTimestamp: "2026-01-02T00:00:00Z",
+			wantOK:          false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := testStatus()
+			status.SetDeployedVersion(tc.deployedVersion, "2026-01-01T00:00:00Z", false)
+			status.SetLatestVersion(tc.latest, tc.latestTimestamp, false)
+			status.SetApprovedVersion(tc.approvedVersion, false)
+
+			// WHEN: Undeployed is called.
+			got, gotOK := status.Undeployed()
+
+			// THEN: the undeployed LatestVersion is returned, if awaiting deployment.
+			if got != tc.want || gotOK != tc.wantOK {
+				t.Errorf("%s\nStatus.Undeployed() mismatch\ngot:  %+v (%t)\nwant: %+v (%t)",
+					packageName, got, gotOK, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestStatus_SetReminded(t *testing.T) {
+	// GIVEN: a Status with an undeployed LatestVersion.
+	status := testStatus()
+	status.SetDeployedVersion("1.0.0", "2026-01-01T00:00:00Z", false)
+	status.SetLatestVersion("1.1.0", "2026-01-02T00:00:00Z", false)
+
+	// WHEN: SetReminded is called for the LatestVersion.
+	at := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	status.SetReminded("1.1.0", at, false)
+
+	prefix := fmt.Sprintf("%s\nStatus.SetReminded()", packageName)
+
+	// THEN: the reminder is recorded.
+	release, _ := status.Undeployed()
+	if !release.LastReminded.Equal(at) || release.Escalated {
+		t.Errorf("%s reminder not recorded\ngot:  %+v",
+			prefix, release)
+	}
+	// AND: it is added to the history.
+	if got := len(status.DatabaseChannel); got != 1 {
+		t.Fatalf("%s DatabaseChannel length mismatch\ngot:  %d\nwant: 1",
+			prefix, got)
+	}
+	message := <-status.DatabaseChannel
+	event := message.Events[0]
+	if got := fmt.Sprintf("%s/%s/%s/%s@%s", event.Type, event.Version, event.PreviousVersion, event.Source, event.Timestamp); got != "reminded/1.1.0/1.0.0/reminder@2026-01-05T00:00:00Z" {
+		t.Errorf("%s event mismatch\ngot:  %s\nwant: %s",
+			prefix, got, "reminded/1.1.0/1.0.0/reminder@2026-01-05T00:00:00Z")
+	}
+
+	// WHEN: SetReminded is called for the escalation.
+	status.SetReminded("1.1.0", at.Add(time.Hour), true)
+	// THEN: the escalation is recorded.
+	if release, _ := status.Undeployed(); !release.Escalated {
+		t.Errorf("%s escalation not recorded\ngot:  %+v",
+			prefix, release)
+	}
+	if event := (<-status.DatabaseChannel).Events[0]; event.Source != dbtype.SourceEscalation {
+		t.Errorf("%s event source mismatch\ngot:  %q\nwant: %q",
+			prefix, event.Source, dbtype.SourceEscalation)
+	}
+
+	// WHEN: SetReminded is called for a version that is no longer the LatestVersion.
+	status.SetReminded("1.0.5", at, false)
+	// THEN: nothing is recorded.
+	if got := len(status.DatabaseChannel); got != 0 {
+		t.Errorf("%s DatabaseChannel length mismatch\ngot:  %d\nwant: 0",
+			prefix, got)
+	}
+
+	// WHEN: a newer LatestVersion is found.
+	status.SetLatestVersion("1.2.0", "2026-01-03T00:00:00Z", false)
+	// THEN: it has not been reminded of.
+	if release, _ := status.Undeployed(); !release.LastReminded.IsZero() || release.Escalated {
+		t.Errorf("%s reminder of the previous LatestVersion kept\ngot:  %+v",
+			prefix, release)
+	}
+}
+
+func TestLastReminder(t *testing.T) {
+	// GIVEN: the history of a Service.
+	events := []dbtype.Event{
+		{Type: dbtype.EventLatest, Version: "1.1.0", Timestamp: "2026-01-01T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.1.0", Source: dbtype.SourceReminder, Timestamp: "2026-01-04T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.1.0", Source: dbtype.SourceEscalation, Timestamp: "2026-01-31T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.1.0", Source: dbtype.SourceEscalation, Timestamp: "2026-02-07T00:00:00Z"},
+		{Type: dbtype.EventLatest, Version: "1.2.0", Timestamp: "2026-02-08T00:00:00Z"},
+		{Type: dbtype.EventReminded, Version: "1.2.0", Source: dbtype.SourceReminder, Timestamp: "2026-02-11T00:00:00Z"},
+	}
+	tests := map[string]struct {
+		version string
+		want    reminder
+	}{
+		"escalated version": {
+			version: "1.1.0",
+			want: reminder{
+				version:   "1.1.0",
+				at:        time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC),
+				escalated: true},
+		},
+		"reminded version": {
+			version: "1.2.0",
+			want: reminder{
+				version: "1.2.0",
+				at:      time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)},
+		},
+		"not reminded of": {
+			version: "1.3.0",
+			want:    reminder{},
+		},
+		"no version": {
+			version: "",
+			want:    reminder{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: lastReminder is called for the version.
+			got := lastReminder(events, tc.version)
+
+			// THEN: the last reminder of that version is returned.
+			if got != tc.want {
+				t.Errorf("%s\nlastReminder(%q) mismatch\ngot:  %+v\nwant: %+v",
+					packageName, tc.version, got, tc.want)
+			}
+		})
+	}
+}
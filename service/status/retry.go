@@ -0,0 +1,93 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"time"
+
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// Retry is the backoff of a lookup whose queries are failing.
+type Retry struct {
+	Failures    int           // Consecutive failed queries.
+	Backoff     time.Duration // Delay before the next attempt.
+	NextAttempt time.Time     // Time of the next attempt.
+}
+
+// IsZero reports whether the lookup is not backing off.
+func (r Retry) IsZero() bool {
+	return r == Retry{}
+}
+
+// summary returns the Retry for the API, or nil if not backing off.
+func (r Retry) summary() *apitype.Retry {
+	if r.IsZero() {
+		return nil
+	}
+
+	summary := &apitype.Retry{
+		Failures: r.Failures,
+		Backoff:  r.Backoff.String(),
+	}
+	if !r.NextAttempt.IsZero() {
+		summary.NextAttempt = r.NextAttempt.UTC().Format(time.RFC3339)
+	}
+	return summary
+}
+
+// LatestVersionRetry returns the backoff of the latest version lookup.
+func (s *Status) LatestVersionRetry() Retry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.latestVersionRetry
+}
+
+// SetLatestVersionRetry sets the backoff of the latest version lookup.
+func (s *Status) SetLatestVersionRetry(retry Retry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latestVersionRetry = retry
+}
+
+// DeployedVersionRetry returns the backoff of the deployed version lookup.
+func (s *Status) DeployedVersionRetry() Retry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.deployedVersionRetry
+}
+
+// SetDeployedVersionRetry sets the backoff of the deployed version lookup.
+func (s *Status) SetDeployedVersionRetry(retry Retry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deployedVersionRetry = retry
+}
+
+// LatestVersionRetrySummary returns the backoff of the latest version lookup for the API,
+// or nil if it is not backing off.
+func (s *Status) LatestVersionRetrySummary() *apitype.Retry {
+	return s.LatestVersionRetry().summary()
+}
+
+// DeployedVersionRetrySummary returns the backoff of the deployed version lookup for the API,
+// or nil if it is not backing off.
+func (s *Status) DeployedVersionRetrySummary() *apitype.Retry {
+	return s.DeployedVersionRetry().summary()
+}
@@ -0,0 +1,66 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatus_Retry(t *testing.T) {
+	// GIVEN: a Status with a failing latest version lookup.
+	status := testStatus()
+	nextAttempt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	status.SetLatestVersionRetry(Retry{
+		Failures:    3,
+		Backoff:     2 * time.Minute,
+		NextAttempt: nextAttempt,
+	})
+
+	// WHEN: the retry summaries are fetched.
+	gotLatest := status.LatestVersionRetrySummary()
+	gotDeployed := status.DeployedVersionRetrySummary()
+
+	// THEN: the failing lookup is summarised.
+	if gotLatest == nil ||
+		gotLatest.Failures != 3 ||
+		gotLatest.Backoff != "2m0s" ||
+		gotLatest.NextAttempt != "2026-01-02T03:04:05Z" {
+		t.Errorf(
+			"%s\nStatus.LatestVersionRetrySummary() mismatch\ngot:  %+v",
+			packageName, gotLatest,
+		)
+	}
+	// AND: the lookup that isn't failing is omitted.
+	if gotDeployed != nil {
+		t.Errorf(
+			"%s\nStatus.DeployedVersionRetrySummary() mismatch\ngot:  %+v\nwant: nil",
+			packageName, gotDeployed,
+		)
+	}
+
+	// WHEN: the lookup succeeds.
+	status.SetLatestVersionRetry(Retry{})
+
+	// THEN: it is no longer summarised.
+	if got := status.LatestVersionRetrySummary(); got != nil {
+		t.Errorf(
+			"%s\nStatus.LatestVersionRetrySummary() after reset mismatch\ngot:  %+v\nwant: nil",
+			packageName, got,
+		)
+	}
+}
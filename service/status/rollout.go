@@ -0,0 +1,71 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// Rollout is an in-progress rollout of the DeployedVersion.
+type Rollout struct {
+	Version  string // Version being rolled out.
+	Progress string // Description of the progress of the rollout.
+}
+
+// IsZero reports whether no rollout is in progress.
+func (r Rollout) IsZero() bool {
+	return r == Rollout{}
+}
+
+// summary returns the Rollout for the API, or nil if no rollout is in progress.
+func (r Rollout) summary() *apitype.Rollout {
+	if r.IsZero() {
+		return nil
+	}
+
+	return &apitype.Rollout{
+		Version:  r.Version,
+		Progress: r.Progress,
+	}
+}
+
+// DeployedVersionRollout returns the in-progress rollout of the DeployedVersion.
+func (s *Status) DeployedVersionRollout() Rollout {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.deployedVersionRollout
+}
+
+// SetDeployedVersionRollout sets the in-progress rollout of the DeployedVersion, returning whether it changed.
+//
+// A zero Rollout marks the rollout as complete.
+func (s *Status) SetDeployedVersionRollout(rollout Rollout) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.deleting || s.deployedVersionRollout == rollout {
+		return false
+	}
+
+	s.deployedVersionRollout = rollout
+	return true
+}
+
+// DeployedVersionRolloutSummary returns the in-progress rollout of the DeployedVersion for the API,
+// or nil if no rollout is in progress.
+func (s *Status) DeployedVersionRolloutSummary() *apitype.Rollout {
+	return s.DeployedVersionRollout().summary()
+}
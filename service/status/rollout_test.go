@@ -0,0 +1,79 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package status
+
+import (
+	"testing"
+)
+
+func TestStatus_DeployedVersionRollout(t *testing.T) {
+	// GIVEN: a Status without a rollout in progress.
+	status := testStatus()
+	rollout := Rollout{
+		Version:  "1.2.3",
+		Progress: "1 of 3 replicas updated",
+	}
+
+	// WHEN: a rollout is set.
+	changed := status.SetDeployedVersionRollout(rollout)
+
+	// THEN: it is reported as changed.
+	if !changed {
+		t.Errorf(
+			"%s\nStatus.SetDeployedVersionRollout() changed mismatch\ngot:  false\nwant: true",
+			packageName,
+		)
+	}
+	// AND: the rollout is summarised.
+	if got := status.DeployedVersionRolloutSummary(); got == nil ||
+		got.Version != rollout.Version ||
+		got.Progress != rollout.Progress {
+		t.Errorf(
+			"%s\nStatus.DeployedVersionRolloutSummary() mismatch\ngot:  %+v\nwant: %+v",
+			packageName, got, rollout,
+		)
+	}
+	// AND: it is kept in a Copy.
+	if got := status.Copy(false).DeployedVersionRollout(); got != rollout {
+		t.Errorf(
+			"%s\nStatus.Copy().DeployedVersionRollout() mismatch\ngot:  %+v\nwant: %+v",
+			packageName, got, rollout,
+		)
+	}
+
+	// WHEN: the same rollout is set again.
+	changed = status.SetDeployedVersionRollout(rollout)
+
+	// THEN: it is not reported as changed.
+	if changed {
+		t.Errorf(
+			"%s\nStatus.SetDeployedVersionRollout() repeat changed mismatch\ngot:  true\nwant: false",
+			packageName,
+		)
+	}
+
+	// WHEN: the rollout completes.
+	status.SetDeployedVersionRollout(Rollout{})
+
+	// THEN: it is no longer summarised.
+	if got := status.DeployedVersionRolloutSummary(); got != nil {
+		t.Errorf(
+			"%s\nStatus.DeployedVersionRolloutSummary() after completion mismatch\ngot:  %+v\nwant: nil",
+			packageName, got,
+		)
+	}
+}
@@ -16,6 +16,7 @@
 package status
 
 import (
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -62,14 +63,21 @@ type Status struct {
 	ServiceInfo serviceinfo.ServiceInfo // ServiceInfo holds information about the service.
 	Dashboard   *dashboard.Options      // Dashboard options for the Service.
 
-	mu                       sync.RWMutex // Lock for the Status.
-	deployedVersionTimestamp string       // UTC timestamp of latest DeployedVersion change.
-	latestVersionTimestamp   string       // UTC timestamp of latest LatestVersion change.
-	lastQueried              string       // UTC timestamp of latest LatestVersion query.
-	regexMissesContent       uint         // Counter for the number of regex misses on the URL content.
-	regexMissesVersion       uint         // Counter for the number of regex misses on the version.
-	Fails                    Fails        // Track the Notify/WebHook fails.
-	deleting                 bool         // Flag to indicate undergoing deletion.
+	mu                       sync.RWMutex       // Lock for the Status.
+	deployedVersionTimestamp string             // UTC timestamp of latest DeployedVersion change.
+	latestVersionTimestamp   string             // UTC timestamp of latest LatestVersion change.
+	lastQueried              string             // UTC timestamp of latest LatestVersion query.
+	regexMissesContent       uint               // Counter for the number of regex misses on the URL content.
+	regexMissesVersion       uint               // Counter for the number of regex misses on the version.
+	instances                []Instance         // Versions reported by each instance of the Service.
+	deployedVersionRollout   Rollout            // In-progress rollout of the DeployedVersion.
+	undeployed               undeployedVersions // LatestVersions found since the DeployedVersion.
+	lastLeadTime             *time.Duration     // Time taken to deploy the last deployed version.
+	reminder                 reminder           // Last reminder that the LatestVersion is undeployed.
+	latestVersionRetry       Retry              // Backoff of the failing LatestVersion lookup.
+	deployedVersionRetry     Retry              // Backoff of the failing DeployedVersion lookup.
+	Fails                    Fails              // Track the Notify/WebHook fails.
+	deleting                 bool               // Flag to indicate undergoing deletion.
 }
 
 // New returns a Status populated with version fields and channel references.
@@ -141,6 +149,12 @@ func (s *Status) Copy(withChannels bool) *Status {
 		s.Dashboard,
 	)
 
+	newStatus.instances = slices.Clone(s.instances)
+	newStatus.deployedVersionRollout = s.deployedVersionRollout
+	newStatus.undeployed = slices.Clone(s.undeployed)
+	newStatus.lastLeadTime = s.lastLeadTime
+	newStatus.reminder = s.reminder
+
 	if withChannels {
 		newStatus.AnnounceChannel = s.AnnounceChannel
 		newStatus.DatabaseChannel = s.DatabaseChannel
@@ -252,7 +266,8 @@ func (s *Status) SetAnnounceChannel(channel chan []byte) {
 }
 
 // RefreshServiceInfo updates the ServiceInfo struct with the latest values.
-// It uses the dashboard options to set the Icon, IconLinkTo, and WebURL fields.
+// It uses the dashboard options to set the Icon, IconLinkTo, and WebURL fields,
+// and the versions to set the Bump field.
 func (s *Status) RefreshServiceInfo() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -262,6 +277,13 @@ func (s *Status) RefreshServiceInfo() {
 
 // refreshServiceInfo is like [RefreshServiceInfo] but requires the Status mutex to already be held.
 func (s *Status) refreshServiceInfo() {
+	s.ServiceInfo.Bump = serviceinfo.BumpLevel(
+		s.ServiceInfo.DeployedVersion,
+		s.ServiceInfo.ApprovedVersion,
+		s.ServiceInfo.LatestVersion,
+	)
+	s.ServiceInfo.FleetState = s.fleetState()
+
 	s.ServiceInfo.Icon = util.TemplateString(
 		s.Dashboard.GetIcon(),
 		s.ServiceInfo,
@@ -336,6 +358,11 @@ func (s *Status) ApprovedVersion() string {
 
 // SetApprovedVersion sets ApprovedVersion to version.
 func (s *Status) SetApprovedVersion(version string, writeToDB bool) {
+	s.SetApprovedVersionFrom(version, dbtype.SourceAPI, writeToDB)
+}
+
+// SetApprovedVersionFrom sets ApprovedVersion to version, recording `source` in the history.
+func (s *Status) SetApprovedVersionFrom(version, source string, writeToDB bool) {
 	s.mu.Lock()
 
 	previousServiceInfo := s.ServiceInfo
@@ -359,6 +386,7 @@ func (s *Status) SetApprovedVersion(version string, writeToDB bool) {
 
 	// Metrics.
 	setLatestVersionIsDeployedMetric(newServiceInfo)
+	setLatestVersionBumpMetric(newServiceInfo)
 	updateUpdatesCurrentMetric(previousServiceInfo, newServiceInfo)
 
 	// Update metrics if acting on the LatestVersion.
@@ -386,6 +414,16 @@ func (s *Status) SetApprovedVersion(version string, writeToDB bool) {
 			{Column: "approved_version", Value: version},
 		},
 	}
+	if version != "" {
+		eventType := dbtype.EventApproved
+		if skipped, ok := strings.CutPrefix(version, serviceinfo.SkipPrefix); ok {
+			eventType = dbtype.EventSkipped
+			version = skipped
+		}
+		message.Events = []dbtype.Event{
+			newEvent(eventType, version, previousServiceInfo.ApprovedVersion, source),
+		}
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	s.sendDatabase(&message)
@@ -402,6 +440,11 @@ func (s *Status) DeployedVersion() string {
 // SetDeployedVersion sets the DeployedVersion to `version` and DeployedVersionTimestamp to `releaseDate`
 // (or now if empty).
 func (s *Status) SetDeployedVersion(version, releaseDate string, writeToDB bool) {
+	s.SetDeployedVersionFrom(version, releaseDate, dbtype.SourceDeployedVersion, writeToDB)
+}
+
+// SetDeployedVersionFrom sets the DeployedVersion as SetDeployedVersion does, recording `source` in the history.
+func (s *Status) SetDeployedVersionFrom(version, releaseDate, source string, writeToDB bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -422,7 +465,12 @@ func (s *Status) SetDeployedVersion(version, releaseDate string, writeToDB bool)
 		serviceinfo.SkippedVersion(version) == previousServiceInfo.ApprovedVersion {
 		s.ServiceInfo.ApprovedVersion = ""
 	}
+	leadTime, hasLeadTime := s.undeployed.deployed(version, s.deployedVersionTimestamp)
+	if hasLeadTime {
+		s.lastLeadTime = &leadTime
+	}
 	s.refreshServiceInfo()
+	s.reconcileUndeployed()
 
 	if !writeToDB {
 		return
@@ -432,18 +480,26 @@ func (s *Status) SetDeployedVersion(version, releaseDate string, writeToDB bool)
 
 	// Metrics.
 	setLatestVersionIsDeployedMetric(newServiceInfo)
+	setLatestVersionBumpMetric(newServiceInfo)
 	updateUpdatesCurrentMetric(previousServiceInfo, newServiceInfo)
+	if hasLeadTime {
+		metric.ObserveDeploymentLeadTime(newServiceInfo.ID, leadTime)
+	}
+	s.setDriftMetrics()
 
 	// Clear the fail status of WebHooks/Commands.
 	s.Fails.resetFails()
 
 	// Database.
+	event := newEvent(dbtype.EventDeployed, version, previousServiceInfo.DeployedVersion, source)
+	event.Timestamp = s.deployedVersionTimestamp
 	message := dbtype.Message{
 		ServiceID: newServiceInfo.ID,
 		Cells: []dbtype.Cell{
 			{Column: "deployed_version", Value: newServiceInfo.DeployedVersion},
 			{Column: "deployed_version_timestamp", Value: s.deployedVersionTimestamp},
 		},
+		Events: []dbtype.Event{event},
 	}
 	s.sendDatabase(&message)
 }
@@ -482,7 +538,15 @@ func (s *Status) SetLatestVersion(version, releaseDate string, writeToDB bool) {
 	} else {
 		s.latestVersionTimestamp = s.lastQueried
 	}
+	// Drift and lead time are measured from the query that found the version (as is the 'latest' event),
+	// not its release.
+	found := s.lastQueried
+	if found == "" {
+		found = time.Now().UTC().Format(time.RFC3339)
+	}
+	s.undeployed.latest(version, found)
 	s.refreshServiceInfo()
+	s.reconcileUndeployed()
 
 	if !writeToDB {
 		return
@@ -492,18 +556,26 @@ func (s *Status) SetLatestVersion(version, releaseDate string, writeToDB bool) {
 
 	// Metrics.
 	setLatestVersionIsDeployedMetric(newServiceInfo)
+	setLatestVersionBumpMetric(newServiceInfo)
 	updateUpdatesCurrentMetric(previousServiceInfo, newServiceInfo)
+	s.setDriftMetrics()
+	if len(s.instances) != 0 {
+		setDeployedVersionFleetStateMetric(newServiceInfo)
+	}
 
 	// Clear the fail status of WebHooks/Commands.
 	s.Fails.resetFails()
 
 	// Database.
+	event := newEvent(dbtype.EventLatest, version, previousServiceInfo.LatestVersion, dbtype.SourceLatestVersion)
+	event.Timestamp = found
 	message := dbtype.Message{
 		ServiceID: newServiceInfo.ID,
 		Cells: []dbtype.Cell{
 			{Column: "latest_version", Value: newServiceInfo.LatestVersion},
 			{Column: "latest_version_timestamp", Value: s.latestVersionTimestamp},
 		},
+		Events: []dbtype.Event{event},
 	}
 	s.sendDatabase(&message)
 }
@@ -596,6 +668,17 @@ func (s *Status) sendDatabase(payload *dbtype.Message) {
 	s.DatabaseChannel <- *payload
 }
 
+// newEvent returns a history Event timestamped now.
+func newEvent(eventType, version, previousVersion, source string) dbtype.Event {
+	return dbtype.Event{
+		Type:            eventType,
+		Version:         version,
+		PreviousVersion: previousVersion,
+		Source:          source,
+		Timestamp:       time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
 // SendSave sends a save request to the SaveChannel if the service is not being deleted.
 func (s *Status) SendSave() {
 	s.mu.RLock()
@@ -617,6 +700,15 @@ func setLatestVersionIsDeployedMetric(serviceInfo serviceinfo.ServiceInfo) {
 	)
 }
 
+// setLatestVersionBumpMetric sets the Prometheus metric for the semantic version bump level of the LatestVersion.
+func setLatestVersionBumpMetric(serviceInfo serviceinfo.ServiceInfo) {
+	metric.SetPrometheusGauge(
+		metric.LatestVersionBump,
+		serviceInfo.ID, "",
+		float64(metric.GetVersionBumpLevel(serviceInfo)),
+	)
+}
+
 // updateUpdatesCurrentMetric adjusts the UpdatesCurrent metric when the deployment state changes.
 func updateUpdatesCurrentMetric(previousServiceInfo, newServiceInfo serviceinfo.ServiceInfo) {
 	previousValue := metric.GetVersionDeployedState(previousServiceInfo)
@@ -635,7 +727,22 @@ func (s *Status) InitMetrics() {
 	serviceInfo := s.GetServiceInfo()
 
 	setLatestVersionIsDeployedMetric(serviceInfo)
+	setLatestVersionBumpMetric(serviceInfo)
 	metric.SetUpdatesCurrent(1, metric.GetVersionDeployedState(serviceInfo))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.setDriftMetrics()
+	if len(s.instances) != 0 {
+		setDeployedVersionInstanceMetrics(serviceInfo, nil, s.instances)
+	}
+	if s.lastLeadTime != nil {
+		metric.SetPrometheusGauge(
+			metric.DeploymentLeadTimeLast,
+			serviceInfo.ID, "",
+			s.lastLeadTime.Seconds(),
+		)
+	}
 }
 
 // DeleteMetrics removes status-derived Prometheus metrics for the service.
@@ -644,5 +751,15 @@ func (s *Status) DeleteMetrics() {
 		metric.LatestVersionIsDeployed,
 		s.ServiceInfo.ID, "",
 	)
+	metric.DeletePrometheusGauge(
+		metric.LatestVersionBump,
+		s.ServiceInfo.ID, "",
+	)
+	metric.DeletePrometheusGauge(
+		metric.DeployedVersionFleetStateLast,
+		s.ServiceInfo.ID, "",
+	)
+	metric.DeleteDeployedVersionInstances(s.ServiceInfo.ID)
+	metric.DeleteDeploymentMetrics(s.ServiceInfo.ID)
 	metric.SetUpdatesCurrent(-1, metric.GetVersionDeployedState(s.GetServiceInfo()))
 }
@@ -1597,6 +1597,97 @@ func TestSetLatestVersionIsDeployedMetric(t *testing.T) {
 	}
 }
 
+func TestStatus_Bump(t *testing.T) {
+	type versions struct {
+		approved, deployed, latest string
+	}
+	// GIVEN: a Status with versions.
+	tests := []struct {
+		name        string
+		hadVersions versions
+		set         func(s *Status)
+		want        string
+	}{
+		{
+			name:        "SetLatestVersion/major",
+			hadVersions: versions{deployed: "1.2.3", latest: "1.2.3"},
+			set:         func(s *Status) { s.SetLatestVersion("2.0.0", "", true) },
+			want:        serviceinfo.BumpMajor,
+		},
+		{
+			name:        "SetLatestVersion/minor",
+			hadVersions: versions{deployed: "1.2.3", latest: "1.2.3"},
+			set:         func(s *Status) { s.SetLatestVersion("1.3.0", "", true) },
+			want:        serviceinfo.BumpMinor,
+		},
+		{
+			name:        "SetDeployedVersion/patch",
+			hadVersions: versions{deployed: "1.2.3", latest: "1.3.1"},
+			set:         func(s *Status) { s.SetDeployedVersion("1.3.0", "", true) },
+			want:        serviceinfo.BumpPatch,
+		},
+		{
+			name:        "SetDeployedVersion/deployed latest",
+			hadVersions: versions{deployed: "1.2.3", latest: "1.3.1"},
+			set:         func(s *Status) { s.SetDeployedVersion("1.3.1", "", true) },
+			want:        serviceinfo.BumpNone,
+		},
+		{
+			name:        "SetApprovedVersion/no deployed version",
+			hadVersions: versions{latest: "2.0.0"},
+			set:         func(s *Status) { s.SetApprovedVersion("1.9.0", true) },
+			want:        serviceinfo.BumpMajor,
+		},
+	}
+
+	// Changing UpdatesCurrent.
+	metricsMu.RLock()
+	t.Cleanup(metricsMu.RUnlock)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := New(
+				nil, nil, nil,
+				tc.hadVersions.approved,
+				tc.hadVersions.deployed, "",
+				tc.hadVersions.latest, "",
+				"",
+				&dashboard.Options{},
+			)
+			status.Init(
+				0, 0, 0,
+				ServiceInfo{ID: "TestStatus_Bump/" + tc.name},
+				status.Dashboard,
+			)
+
+			// WHEN: the versions are changed.
+			tc.set(status)
+
+			prefix := fmt.Sprintf("%s\nStatus(%+v)", packageName, tc.hadVersions)
+
+			// THEN: the Bump is as expected.
+			if got := status.GetServiceInfo().Bump; got != tc.want {
+				t.Errorf(
+					"%s Bump mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.want,
+				)
+			}
+
+			// AND: the LatestVersionBump metric is as expected.
+			got := testutil.ToFloat64(metric.LatestVersionBump.WithLabelValues(status.ServiceInfo.ID))
+			want := float64(metric.GetVersionBumpLevel(serviceinfo.ServiceInfo{Bump: tc.want}))
+			if got != want {
+				t.Errorf(
+					"%s LatestVersionBump metric mismatch\ngot:  %f\nwant: %f",
+					prefix, got, want,
+				)
+			}
+		})
+	}
+}
+
 type fuzzSetVersionsOp uint8
 
 const (
@@ -1751,3 +1842,149 @@ func TestStatus_InitMetrics_then_DeleteMetrics(t *testing.T) {
 		t.Fatalf("latest version is deployed metric not deleted")
 	}
 }
+
+func TestStatus_Set__historyEvents(t *testing.T) {
+	// GIVEN: a Status with LatestVersion ahead of DeployedVersion.
+	tests := []struct {
+		name          string
+		set           func(s *Status)
+		want          *dbtype.Event
+		wantTimestamp string
+	}{
+		{
+			name: "latest",
+			set:  func(s *Status) { s.SetLatestVersion("1.2.0", "", true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventLatest, Version: "1.2.0", PreviousVersion: "1.1.0",
+				Source: dbtype.SourceLatestVersion},
+		},
+		{
+			name: "latest, stamped when queried",
+			set: func(s *Status) {
+				s.SetLastQueried("2026-01-02T03:04:05Z")
+				s.SetLatestVersion("1.2.0", "2025-01-01T00:00:00Z", true)
+			},
+			want: &dbtype.Event{
+				Type: dbtype.EventLatest, Version: "1.2.0", PreviousVersion: "1.1.0",
+				Source: dbtype.SourceLatestVersion},
+			wantTimestamp: "2026-01-02T03:04:05Z",
+		},
+		{
+			name: "deployed",
+			set:  func(s *Status) { s.SetDeployedVersion("1.1.0", "", true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventDeployed, Version: "1.1.0", PreviousVersion: "1.0.0",
+				Source: dbtype.SourceDeployedVersion},
+		},
+		{
+			name: "deployed, with release date",
+			set:  func(s *Status) { s.SetDeployedVersion("1.1.0", "2026-01-02T03:04:05Z", true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventDeployed, Version: "1.1.0", PreviousVersion: "1.0.0",
+				Source: dbtype.SourceDeployedVersion},
+			wantTimestamp: "2026-01-02T03:04:05Z",
+		},
+		{
+			name: "deployed, with source",
+			set:  func(s *Status) { s.SetDeployedVersionFrom("1.1.0", "", dbtype.SourceActions, true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventDeployed, Version: "1.1.0", PreviousVersion: "1.0.0",
+				Source: dbtype.SourceActions},
+		},
+		{
+			name: "approved",
+			set:  func(s *Status) { s.SetApprovedVersion("1.1.0", true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventApproved, Version: "1.1.0",
+				Source: dbtype.SourceAPI},
+		},
+		{
+			name: "skipped",
+			set:  func(s *Status) { s.SetApprovedVersion(serviceinfo.SkippedVersion("1.1.0"), true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventSkipped, Version: "1.1.0",
+				Source: dbtype.SourceAPI},
+		},
+		{
+			name: "approved, with source",
+			set:  func(s *Status) { s.SetApprovedVersionFrom("1.1.0", dbtype.SourceActions, true) },
+			want: &dbtype.Event{
+				Type: dbtype.EventApproved, Version: "1.1.0",
+				Source: dbtype.SourceActions},
+		},
+		{
+			name: "approval cleared",
+			set: func(s *Status) {
+				s.SetApprovedVersion("1.1.0", false)
+				s.SetApprovedVersion("", true)
+			},
+			want: nil,
+		},
+	}
+
+	// Changing UpdatesCurrent.
+	metricsMu.RLock()
+	t.Cleanup(metricsMu.RUnlock)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			databaseChannel := make(chan dbtype.Message, 4)
+			status := New(
+				nil, databaseChannel, nil,
+				"",
+				"1.0.0", "",
+				"1.1.0", "",
+				"",
+				&dashboard.Options{},
+			)
+			status.Init(
+				0, 0, 0,
+				ServiceInfo{
+					ID: "TestStatus_Set__historyEvents_" + tc.name,
+				},
+				status.Dashboard,
+			)
+			before := time.Now().UTC().Add(-time.Second)
+
+			// WHEN: the version is set.
+			tc.set(status)
+
+			prefix := fmt.Sprintf("%s\nStatus.Set*(%s)", packageName, tc.name)
+
+			// THEN: the message sent to the Database carries the expected event.
+			if len(databaseChannel) != 1 {
+				t.Fatalf("%s DatabaseChannel message count mismatch\ngot:  %d\nwant: 1",
+					prefix, len(databaseChannel))
+			}
+			message := <-databaseChannel
+			if tc.want == nil {
+				if len(message.Events) != 0 {
+					t.Errorf("%s Events mismatch\ngot:  %+v\nwant: none",
+						prefix, message.Events)
+				}
+				return
+			}
+			if len(message.Events) != 1 {
+				t.Fatalf("%s Events count mismatch\ngot:  %+v\nwant: 1 event",
+					prefix, message.Events)
+			}
+			got := message.Events[0]
+			if tc.wantTimestamp != "" {
+				if got.Timestamp != tc.wantTimestamp {
+					t.Errorf("%s Timestamp mismatch\ngot:  %q\nwant: %q",
+						prefix, got.Timestamp, tc.wantTimestamp)
+				}
+			} else if timestamp, err := time.Parse(time.RFC3339, got.Timestamp); err != nil || timestamp.Before(before) {
+				t.Errorf("%s Timestamp mismatch\ngot:  %q\nwant: after %s",
+					prefix, got.Timestamp, before.Format(time.RFC3339))
+			}
+			got.Timestamp = ""
+			if got != *tc.want {
+				t.Errorf("%s Event mismatch\ngot:  %+v\nwant: %+v",
+					prefix, got, *tc.want)
+			}
+		})
+	}
+}
@@ -16,17 +16,26 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/internal/scheduler"
 	"github.com/release-argus/Argus/web/metric"
 )
 
-// Track starts a tracking goroutine for each active Service.
-func (s *Services) Track(ordering *[]string, orderMu *sync.RWMutex) {
+// trackWorkers is the maximum number of lookups to query at once.
+const trackWorkers = 32
+
+// tracker schedules the lookups of all Services.
+var tracker = scheduler.New(trackWorkers)
+
+// Track schedules the tracking of each active Service, stopping all tracking when ctx is cancelled.
+func (s *Services) Track(ctx context.Context, ordering *[]string, orderMu *sync.RWMutex) {
 	metric.InitMetrics()
+	context.AfterFunc(ctx, tracker.Stop)
 
 	orderMu.RLock()
 	defer orderMu.RUnlock()
@@ -36,15 +45,14 @@ func (s *Services) Track(ordering *[]string, orderMu *sync.RWMutex) {
 			svc.Options.Active = nil
 		}
 
-		// Track this Service in an infinite loop goroutine.
-		go svc.Track()
-
-		// Space out the tracking of each Service.
-		time.Sleep(time.Second / 2)
+		svc.Track()
 	}
 }
 
-// Track monitors the Service for new releases, triggering notifications and WebHooks when found.
+// Track schedules the Service to be monitored for new releases, triggering notifications and WebHooks when found.
+//
+// The first queries wait until the interval has elapsed since the Service was last queried,
+// and tracking stops when the Service is deleted (PrepDelete) or tracked again.
 func (s *Service) Track() {
 	s.initMetrics()
 	// Skip inactive Services.
@@ -52,17 +60,44 @@ func (s *Service) Track() {
 		return
 	}
 
-	// Wait until the interval has elapsed.
+	ctx := s.trackContext()
+	logFrom := logx.LogFrom{Primary: s.ID}
+
+	// Wait until the interval has elapsed (or the next scheduled time).
 	lastQueriedAt, _ := time.Parse(time.RFC3339, s.Status.LastQueried())
-	if time.Since(lastQueriedAt) < s.Options.GetIntervalDuration() {
-		time.Sleep(s.Options.GetIntervalDuration() - time.Since(lastQueriedAt))
+	start := s.Options.NextQuery(lastQueriedAt, time.Now())
+
+	// Track the deployed version.
+	if lookup := s.DeployedVersionLookup; lookup != nil {
+		jobID := s.deployedVersionJobID()
+		trigger := func() { tracker.RunNow(jobID) }
+		if lookup.Track(ctx, trigger) {
+			tracker.Add(ctx, jobID, start, func(context.Context) time.Duration {
+				// Stop tracking if deleting.
+				if s.Status.Deleting() {
+					return -1
+				}
+
+				// Query the deployed version.
+				err := lookup.Query(true, logFrom)
+
+				return s.untilNextAttempt(err,
+					s.Status.DeployedVersionRetry, s.Status.SetDeployedVersionRetry)
+			})
+		}
 	}
 
-	// Track the deployed version in an infinite loop goroutine.
-	if s.DeployedVersionLookup != nil {
-		go func() {
-			go s.DeployedVersionLookup.Track()
-		}()
+	// Remind of a LatestVersion left undeployed.
+	if s.Options.GetReminderActive() {
+		tracker.Add(ctx, s.reminderJobID(), start.Add(5*time.Second), // Give the lookups some time to query first.
+			func(context.Context) time.Duration {
+				// Stop reminding if deleting.
+				if s.Status.Deleting() {
+					return -1
+				}
+
+				return s.remind(time.Now())
+			})
 	}
 
 	// If we have no LatestVersion, we can't track.
@@ -70,29 +105,84 @@ func (s *Service) Track() {
 		return
 	}
 
-	time.Sleep(2 * time.Second) // Give DeployedVersion some time to query first.
-	// Track forever.
-	logFrom := logx.LogFrom{Primary: s.ID}
+	every := "every " + s.Options.GetInterval()
+	if schedule := s.Options.GetSchedule(); schedule != "" {
+		every = fmt.Sprintf("on schedule %q", schedule)
+	}
 	logx.Verbose(
 		fmt.Sprintf(
-			"Tracking %s at %s every %s",
-			s.ID, s.LatestVersion.ServiceURL(), s.Options.GetInterval(),
+			"Tracking %s at %s %s",
+			s.ID, s.LatestVersion.ServiceURL(), every,
 		),
 		logFrom,
 		true,
 	)
-	for {
-		// Stop tracking if deleting.
-		if s.Status.Deleting() {
-			return
-		}
+	lookup := s.LatestVersion
+	tracker.Add(ctx, s.latestVersionJobID(),
+		start.Add(2*time.Second), // Give DeployedVersion some time to query first.
+		func(context.Context) time.Duration {
+			// Stop tracking if deleting.
+			if s.Status.Deleting() {
+				return -1
+			}
 
-		// Query the Lookup.
-		if newVersion, _ := s.LatestVersion.Query(true, logFrom); newVersion {
-			go s.HandleUpdateActions(true)
-		}
+			// Query the Lookup.
+			newVersion, err := lookup.Query(true, logFrom)
+			if newVersion {
+				go s.HandleUpdateActions(true)
+			}
 
-		// Sleep interval between checks.
-		time.Sleep(s.Options.GetIntervalDuration())
+			return s.untilNextAttempt(err,
+				s.Status.LatestVersionRetry, s.Status.SetLatestVersionRetry)
+		})
+}
+
+// untilNextQuery returns the delay until the Service should next be queried.
+func (s *Service) untilNextQuery() time.Duration {
+	now := time.Now()
+	return s.Options.NextQuery(now, now).Sub(now)
+}
+
+// trackContext returns a new context for tracking the Service,
+// cancelling that of any previous Track.
+func (s *Service) trackContext() context.Context {
+	s.trackMu.Lock()
+	defer s.trackMu.Unlock()
+
+	if s.stopTracking != nil {
+		s.stopTracking()
 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopTracking = cancel
+	return ctx
+}
+
+// StopTracking stops any tracking of the Service.
+func (s *Service) StopTracking() {
+	s.trackMu.Lock()
+	defer s.trackMu.Unlock()
+
+	if s.stopTracking != nil {
+		s.stopTracking()
+		s.stopTracking = nil
+	}
+	// Remove now, rather than when the cancellation is seen.
+	tracker.Remove(s.deployedVersionJobID())
+	tracker.Remove(s.latestVersionJobID())
+	tracker.Remove(s.reminderJobID())
+}
+
+// deployedVersionJobID returns the ID of the scheduled DeployedVersionLookup query.
+func (s *Service) deployedVersionJobID() string {
+	return s.ID + "/deployed_version"
+}
+
+// latestVersionJobID returns the ID of the scheduled LatestVersion query.
+func (s *Service) latestVersionJobID() string {
+	return s.ID + "/latest_version"
+}
+
+// reminderJobID returns the ID of the scheduled reminder check.
+func (s *Service) reminderJobID() string {
+	return s.ID + "/reminder"
 }
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"slices"
@@ -77,14 +78,14 @@ func TestServices_Track(t *testing.T) {
 
 			servicesBefore := decode.ToYAMLString(services, "")
 			t.Cleanup(func() {
-				// Set Deleting to stop the Track.
+				// Stop the Track.
 				for _, s := range *services {
-					s.Status.SetDeleting()
+					s.StopTracking()
 				}
 			})
 
 			// WHEN: Track is called on it.
-			services.Track(&tc.ordering, &sync.RWMutex{})
+			services.Track(context.Background(), &tc.ordering, &sync.RWMutex{})
 
 			prefix := fmt.Sprintf("%s\nServices.Track()", packageName)
 
@@ -553,8 +554,8 @@ func TestService_Track(t *testing.T) {
 			svc := testService(t, tc.name, tc.latestVersionType, "url")
 			svcStatus := svc.Status.Copy(true)
 			t.Cleanup(func() {
-				// Set Deleting to stop the Track.
-				svc.Status.SetDeleting()
+				// Stop the Track.
+				svc.StopTracking()
 			})
 
 			// Overrides.
@@ -595,14 +596,10 @@ func TestService_Track(t *testing.T) {
 					time.Now().Add(-interval + wantQueryIn).UTC().Format(time.RFC3339),
 				)
 			}
-			didFinish := make(chan bool, 1)
 			svcBefore := svc.String("")
 
 			// WHEN: Track is called on it.
-			go func() {
-				svc.Track()
-				didFinish <- true
-			}()
+			svc.Track()
 			for range 200 {
 				var passQ, failQ float64
 				if svc.LatestVersion != nil {
@@ -732,18 +729,19 @@ func TestService_Track(t *testing.T) {
 					gotDatabaseMessages = len(svc.Status.DatabaseChannel)
 				}
 			}
-			// Track should finish if it is not Active and is not being deleted.
+			// Track should stop if it is not Active, is being deleted, or has no LatestVersion.
 			shouldFinish := !svc.Options.GetActive() || tc.deleting || svc.LatestVersion == nil
-			// Didn't finish, but should have.
-			if shouldFinish && len(didFinish) == 0 {
+			_, scheduled := tracker.Next(svc.latestVersionJobID())
+			// Didn't stop, but should have.
+			if shouldFinish && scheduled {
 				t.Fatalf(
-					"%s expected to finish when not active, deleting, or LatestVersion is nil",
+					"%s expected to stop when not active, deleting, or LatestVersion is nil",
 					prefix,
 				)
 			}
-			// Finished when it shouldn't have.
-			if !shouldFinish && len(didFinish) != 0 {
-				t.Fatalf("%s unexpected finish", prefix)
+			// Stopped when it shouldn't have.
+			if !shouldFinish && !scheduled {
+				t.Fatalf("%s unexpected stop", prefix)
 			}
 
 			// AND: the service should marshal the same.
@@ -16,6 +16,9 @@
 package service
 
 import (
+	"context"
+	"sync"
+
 	"github.com/release-argus/Argus/command"
 	"github.com/release-argus/Argus/config/decode"
 	"github.com/release-argus/Argus/notify/shoutrrr"
@@ -52,6 +55,7 @@ type Service struct {
 
 	Notify             shoutrrr.Shoutrrrs `json:"notify,omitempty" yaml:"notify,omitempty"` // Service-specific Shoutrrr vars.
 	NotifyFromDefaults bool               `json:"-" yaml:"-"`
+	reminderNotify     shoutrrr.Shoutrrrs // Notify to also remind once a reminder is escalated.
 
 	CommandController   *command.Controller `json:"-" yaml:"-"`                                 // The controller for the OS Commands that tracks fails and has the announce channel.
 	Command             command.Commands    `json:"command,omitempty" yaml:"command,omitempty"` // OS Commands to run on new release.
@@ -66,6 +70,9 @@ type Service struct {
 
 	Defaults     *Defaults `json:"-" yaml:"-"` // Default values.
 	HardDefaults *Defaults `json:"-" yaml:"-"` // Hardcoded default values.
+
+	trackMu      sync.Mutex         // Guards stopTracking.
+	stopTracking context.CancelFunc // Cancels the scheduled lookups of this Service.
 }
 
 // serviceMarshal is a marshal-only helper for [Service].
@@ -294,7 +301,13 @@ func (s *Service) Summary() *apitype.ServiceSummary {
 			DeployedVersionTimestamp: s.Status.DeployedVersionTimestamp(),
 			LatestVersion:            svcInfo.LatestVersion,
 			LatestVersionTimestamp:   s.Status.LatestVersionTimestamp(),
+			Bump:                     svcInfo.Bump,
+			FleetState:               svcInfo.FleetState,
+			Instances:                s.Status.InstancesSummary(),
+			DeployedVersionRollout:   s.Status.DeployedVersionRolloutSummary(),
 			LastQueried:              s.Status.LastQueried(),
+			LatestVersionRetry:       s.Status.LatestVersionRetrySummary(),
+			DeployedVersionRetry:     s.Status.DeployedVersionRetrySummary(),
 		},
 	}
 
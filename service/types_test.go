@@ -1506,6 +1506,7 @@ func TestService_Summary(t *testing.T) {
 					DeployedVersionTimestamp: "2-",
 					LatestVersion:            "3",
 					LatestVersionTimestamp:   "3-",
+					Bump:                     "major",
 					LastQueried:              "4",
 				},
 				Tags: &[]string{"hello", "there"},
@@ -71,6 +71,15 @@ func (d *Defaults) CheckValues() error {
 			},
 		)
 	}
+	if err := d.DeployedVersionLookup.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "deployed_version",
+				Err: err,
+			},
+		)
+	}
 
 	if len(errs) == 0 {
 		return nil
@@ -114,7 +123,7 @@ func (s *Service) CheckValues() (error, bool) {
 	}
 
 	var errs []error
-	if err := s.Options.CheckValues(); err != nil {
+	if err := errors.Join(s.Options.CheckValues(), s.checkReminderNotify()); err != nil {
 		errs = append(
 			errs,
 			&decode.ErrKeyField{
@@ -35,6 +35,7 @@ func testServiceInfo() serviceinfo.ServiceInfo {
 		ApprovedVersion: "APPROVED",
 		DeployedVersion: "DEPLOYED",
 		LatestVersion:   "NEW",
+		Bump:            "major",
 		Tags:            []string{"tag1", "tag2"},
 	}
 }
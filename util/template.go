@@ -55,6 +55,7 @@ func TemplateString(template string, info serviceinfo.ServiceInfo) string {
 			"deployed_version": info.DeployedVersion,
 			"version":          info.LatestVersion,
 			"latest_version":   info.LatestVersion,
+			"bump":             info.Bump,
 			"tags":             info.Tags,
 		},
 	)
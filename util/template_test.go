@@ -118,12 +118,12 @@ func TestTemplate_String(t *testing.T) {
 		},
 		{
 			name:     "all django vars",
-			template: "{{ service_id }}-{{ service_name }}-{{ service_url }}--{{ icon }}-{{ icon_link_to }}-{{ web_url }}--{{ version }}-{{ approved_version }}-{{ deployed_version }}-{{ latest_version }}-{{ tags|first }}-{{ tags.1 }}",
+			template: "{{ service_id }}-{{ service_name }}-{{ service_url }}--{{ icon }}-{{ icon_link_to }}-{{ web_url }}--{{ version }}-{{ approved_version }}-{{ deployed_version }}-{{ latest_version }}-{{ bump }}-{{ tags|first }}-{{ tags.1 }}",
 			want: fmt.Sprintf(
-				"%s-%s-%s--%s-%s-%s--%s-%s-%s-%s-%s-%s",
+				"%s-%s-%s--%s-%s-%s--%s-%s-%s-%s-%s-%s-%s",
 				svcInfo.ID, svcInfo.Name, svcInfo.URL,
 				svcInfo.Icon, svcInfo.IconLinkTo, svcInfo.WebURL,
-				svcInfo.LatestVersion, svcInfo.ApprovedVersion, svcInfo.DeployedVersion, svcInfo.LatestVersion,
+				svcInfo.LatestVersion, svcInfo.ApprovedVersion, svcInfo.DeployedVersion, svcInfo.LatestVersion, svcInfo.Bump,
 				svcInfo.Tags[0], svcInfo.Tags[1],
 			),
 			serviceInfo: svcInfo,
@@ -16,11 +16,13 @@
 package types
 
 import (
+	"slices"
 	"time"
 
 	"github.com/goccy/go-yaml"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	shoutrrr_types "github.com/release-argus/Argus/notify/shoutrrr/types"
 	"github.com/release-argus/Argus/util"
 )
@@ -35,7 +37,7 @@ type ServiceSummary struct {
 	WebURL              *string   `json:"url,omitzero" yaml:"url,omitzero"`                                     // URL to provide on the Web UI.
 	Icon                *string   `json:"icon,omitzero" yaml:"icon,omitzero"`                                   // Service.Dashboard.Icon / Service.Notify.*.Params.Icon / Service.Notify.*.Defaults.Params.Icon.
 	IconLinkTo          *string   `json:"icon_link_to,omitzero" yaml:"icon_link_to,omitzero"`                   // URL to redirect Icon clicks to.
-	DeployedVersionType *string   `json:"deployed_version_type,omitzero" yaml:"deployed_version_type,omitzero"` // "manual"|"url", empty string if no DeployedVersionLookup.
+	DeployedVersionType *string   `json:"deployed_version_type,omitzero" yaml:"deployed_version_type,omitzero"` // "command"|"manual"|"url", empty string if no DeployedVersionLookup.
 	Command             *int      `json:"command,omitzero" yaml:"command,omitzero"`                             // Amount of Commands to send on a new release.
 	WebHook             *int      `json:"webhook,omitzero" yaml:"webhook,omitzero"`                             // Amount of WebHooks to send on a new release.
 	Status              *Status   `json:"status,omitempty" yaml:"status,omitempty"`                             // Track the Status of this source (version and regex misses).
@@ -122,8 +124,32 @@ func (s *ServiceSummary) RemoveUnchanged(oldData *ServiceSummary) {
 		s.Status.LatestVersionTimestamp = ""
 		statusSameCount++
 	}
+	// 	Bump.
+	if oldData.Status.Bump == s.Status.Bump {
+		s.Status.Bump = ""
+		statusSameCount++
+	} else if s.Status.Bump == "" {
+		// Explicitly send the cleared bump, as an empty value is omitted.
+		s.Status.Bump = BumpCleared
+	}
+	// 	FleetState/Instances.
+	if oldData.Status.FleetState == s.Status.FleetState &&
+		slices.Equal(oldData.Status.Instances, s.Status.Instances) {
+		s.Status.FleetState = ""
+		s.Status.Instances = nil
+		statusSameCount++
+	}
+	// 	DeployedVersionRollout.
+	if util.DerefOrZero(oldData.Status.DeployedVersionRollout) ==
+		util.DerefOrZero(s.Status.DeployedVersionRollout) {
+		s.Status.DeployedVersionRollout = nil
+		statusSameCount++
+	} else if s.Status.DeployedVersionRollout == nil {
+		// Explicitly send the completed rollout, as a nil value is omitted.
+		s.Status.DeployedVersionRollout = &Rollout{}
+	}
 	// nil Status if all fields match.
-	if statusSameCount == 3 {
+	if statusSameCount == 6 {
 		s.Status = nil
 	}
 
@@ -222,28 +248,34 @@ type Config struct {
 
 // Settings contain settings for the program.
 type Settings struct {
-	Data DataSettings `json:"data,omitzero" yaml:"data,omitzero"`
-	Log  LogSettings  `json:"log,omitzero" yaml:"log,omitzero"`
-	Web  WebSettings  `json:"web,omitzero" yaml:"web,omitzero"`
+	Data   DataSettings `json:"data,omitzero" yaml:"data,omitzero"`
+	Log    LogSettings  `json:"log,omitzero" yaml:"log,omitzero"`
+	Web    WebSettings  `json:"web,omitzero" yaml:"web,omitzero"`
+	Proxy  *Proxy       `json:"proxy,omitzero" yaml:"proxy,omitzero"`
+	Limits *Limits      `json:"limits,omitzero" yaml:"limits,omitzero"`
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (s *Settings) IsZero() bool {
 	return s.Log.IsZero() &&
 		s.Data.IsZero() &&
-		s.Web.IsZero()
+		s.Web.IsZero() &&
+		s.Proxy == nil &&
+		s.Limits == nil
 }
 
 // DataSettings contains data settings for the program.
 type DataSettings struct {
-	DatabaseFile string `json:"database_file,omitzero" yaml:"database_file,omitzero"` // Database file path.
-	Readonly     *bool  `json:"readonly,omitzero" yaml:"readonly,omitzero"`           // Disable saving config changes to disk.
+	DatabaseFile     string `json:"database_file,omitzero" yaml:"database_file,omitzero"`         // Database file path.
+	Readonly         *bool  `json:"readonly,omitzero" yaml:"readonly,omitzero"`                   // Disable saving config changes to disk.
+	HistoryRetention string `json:"history_retention,omitzero" yaml:"history_retention,omitzero"` // Age to prune history at.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (d DataSettings) IsZero() bool {
 	return d.DatabaseFile == "" &&
-		d.Readonly == nil
+		d.Readonly == nil &&
+		d.HistoryRetention == ""
 }
 
 // LogSettings contains web settings for the program.
@@ -266,6 +298,7 @@ type WebSettings struct {
 	KeyFile        string   `json:"pkey_file,omitzero" yaml:"pkey_file,omitzero"`               // HTTPS privkey path.
 	RoutePrefix    string   `json:"route_prefix,omitzero" yaml:"route_prefix,omitzero"`         // Web endpoint prefix.
 	DisabledRoutes []string `json:"disabled_routes,omitempty" yaml:"disabled_routes,omitempty"` // Disabled API routes.
+	EnabledRoutes  []string `json:"enabled_routes,omitempty" yaml:"enabled_routes,omitempty"`   // Opt-in API routes.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
@@ -275,7 +308,8 @@ func (w WebSettings) IsZero() bool {
 		w.CertFile == "" &&
 		w.KeyFile == "" &&
 		w.RoutePrefix == "" &&
-		len(w.DisabledRoutes) == 0
+		len(w.DisabledRoutes) == 0 &&
+		len(w.EnabledRoutes) == 0
 }
 
 // Notifiers is a string map of Notify.
@@ -323,7 +357,7 @@ type Notify struct {
 	Params    map[string]string `json:"params,omitempty" yaml:"params,omitempty"`         // Param props.
 }
 
-// Censor redacts secret url_fields and params with [util.SecretValue].
+// Censor redacts secret url_fields and params with [util.SecretValue], and the password of any proxy option.
 func (n *Notify) Censor() {
 	if n == nil {
 		return
@@ -337,6 +371,12 @@ func (n *Notify) Censor() {
 		}
 	}
 
+	// options.
+	if n.Options["proxy"] != "" {
+		n.Options = util.CopyMap(n.Options)
+		n.Options["proxy"] = httpx.CensorProxyURL(n.Options["proxy"])
+	}
+
 	// params.
 	n.Params = util.CopyMap(n.Params)
 	for _, param := range shoutrrr_types.CensorableParams {
@@ -400,16 +440,45 @@ func (d ServiceDefaults) IsZero() bool {
 
 // ServiceOptions defines configuration options for a service.
 type ServiceOptions struct {
-	Active             *bool  `json:"active,omitzero" yaml:"active,omitzero"`                           // Active Service?.
-	Interval           string `json:"interval,omitzero" yaml:"interval,omitzero"`                       // AhBmCs = Sleep A hours, B minutes and C seconds between queries.
-	SemanticVersioning *bool  `json:"semantic_versioning,omitzero" yaml:"semantic_versioning,omitzero"` // Default - true = Version must exceed the previous version to trigger alerts/Commands/WebHooks.
+	Active             *bool            `json:"active,omitzero" yaml:"active,omitzero"`                           // Active Service?.
+	Interval           string           `json:"interval,omitzero" yaml:"interval,omitzero"`                       // AhBmCs = Sleep A hours, B minutes and C seconds between queries.
+	Schedule           string           `json:"schedule,omitzero" yaml:"schedule,omitzero"`                       // Cron expression of when to query, used instead of the interval.
+	Jitter             string           `json:"jitter,omitzero" yaml:"jitter,omitzero"`                           // AhBmCs = Delay each query by a random duration up to this.
+	ActiveHours        []string         `json:"active_hours,omitempty" yaml:"active_hours,omitempty"`             // 'HH:MM-HH:MM' windows to limit queries to.
+	Backoff            string           `json:"backoff,omitzero" yaml:"backoff,omitzero"`                         // AhBmCs = Retry a failed query after this, doubling with each further failure.
+	BackoffMax         string           `json:"backoff_max,omitzero" yaml:"backoff_max,omitzero"`                 // AhBmCs = Longest to wait before retrying a failed query.
+	SemanticVersioning *bool            `json:"semantic_versioning,omitzero" yaml:"semantic_versioning,omitzero"` // Default - true = Version must exceed the previous version to trigger alerts/Commands/WebHooks.
+	Reminder           *ServiceReminder `json:"reminder,omitzero" yaml:"reminder,omitzero"`                       // Remind that the latest version is still undeployed.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (o ServiceOptions) IsZero() bool {
 	return o.Active == nil &&
 		o.Interval == "" &&
-		o.SemanticVersioning == nil
+		o.Schedule == "" &&
+		o.Jitter == "" &&
+		len(o.ActiveHours) == 0 &&
+		o.Backoff == "" &&
+		o.BackoffMax == "" &&
+		o.SemanticVersioning == nil &&
+		o.Reminder == nil
+}
+
+// ReminderCadence defines when to remind that a service's latest version is still undeployed.
+type ReminderCadence struct {
+	After         string `json:"after,omitzero" yaml:"after,omitzero"`                   // AhBmCs = Remind once the latest version has been undeployed this long.
+	Every         string `json:"every,omitzero" yaml:"every,omitzero"`                   // AhBmCs = Then remind again this often.
+	EscalateAfter string `json:"escalate_after,omitzero" yaml:"escalate_after,omitzero"` // AhBmCs = Also remind the escalate_notify once undeployed this long.
+}
+
+// ServiceReminder defines reminders that a service's latest version is still undeployed.
+type ServiceReminder struct {
+	ReminderCadence `json:",inline" yaml:",inline"`
+
+	EscalateNotify []string         `json:"escalate_notify,omitempty" yaml:"escalate_notify,omitempty"` // IDs of the Notify to also remind once escalated.
+	Major          *ReminderCadence `json:"major,omitzero" yaml:"major,omitzero"`                       // Cadence for major releases.
+	Minor          *ReminderCadence `json:"minor,omitzero" yaml:"minor,omitzero"`                       // Cadence for minor releases.
+	Patch          *ReminderCadence `json:"patch,omitzero" yaml:"patch,omitzero"`                       // Cadence for patch releases.
 }
 
 // DashboardOptions defines configuration options for a service on the Web UI dashboard.
@@ -435,10 +504,17 @@ type LatestVersion struct {
 	Type              string                `json:"type,omitzero" yaml:"type,omitzero"`                               // Service Type, github/url.
 	URL               string                `json:"url,omitzero" yaml:"url,omitzero"`                                 // URL to query.
 	AccessToken       string                `json:"access_token,omitzero" yaml:"access_token,omitzero"`               // GitHub access token to use.
+	Method            string                `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
 	AllowInvalidCerts *bool                 `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *TLS                  `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
+	Proxy             *Proxy                `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // HTTP/SOCKS5 proxy to send requests through.
 	UsePreRelease     *bool                 `json:"use_prerelease,omitzero" yaml:"use_prerelease,omitzero"`           // Whether to use GitHub prereleases.
 	URLCommands       URLCommands           `json:"url_commands,omitempty" yaml:"url_commands,omitempty"`             // Commands to filter the release from the URL request.
 	Headers           []Header              `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Request Headers.
+	BasicAuth         *BasicAuth            `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`                   // Basic Auth credentials.
+	OAuth2            *OAuth2               `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OAuth2 client-credentials.
+	Body              string                `json:"body,omitzero" yaml:"body,omitzero"`                               // Request Body.
+	TargetHeader      string                `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // Header to filter the release from.
 	Require           *LatestVersionRequire `json:"require,omitzero" yaml:"require,omitzero"`                         // Requirements before treating a release as valid.
 }
 
@@ -491,12 +567,16 @@ func (l LatestVersionGitHubDefaults) IsZero() bool {
 
 // LatestVersionURLDefaults are URL-specific default values for a LatestVersion.
 type LatestVersionURLDefaults struct {
-	AllowInvalidCerts *bool `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	Method            string `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
+	AllowInvalidCerts *bool  `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *TLS   `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (l LatestVersionURLDefaults) IsZero() bool {
-	return l.AllowInvalidCerts == nil
+	return l.Method == "" &&
+		l.AllowInvalidCerts == nil &&
+		l.TLS == nil
 }
 
 // LatestVersionRequire contains commands, regex, etc. that must pass before considering a release valid.
@@ -622,37 +702,79 @@ type RequireDocker struct {
 }
 
 type DeployedVersionLookupDefaults struct {
-	Type              string `json:"type,omitzero" yaml:"type,omitzero"`                               // "manual" | "url".
+	Type              string `json:"type,omitzero" yaml:"type,omitzero"`                               // "command" | "manual" | "url".
 	AllowInvalidCerts *bool  `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Disallows invalid HTTPS certificates.
+	TLS               *TLS   `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
 	Method            string `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
 }
 
 // IsZero implements the yaml.IsZeroer interface.
 func (d DeployedVersionLookupDefaults) IsZero() bool {
 	return d.AllowInvalidCerts == nil &&
+		d.TLS == nil &&
 		d.Method == ""
 }
 
 // DeployedVersionLookup of the service.
 type DeployedVersionLookup struct {
-	Type string `json:"type,omitzero" yaml:"type,omitzero"` // Service Type, url/manual.
+	Type string `json:"type,omitzero" yaml:"type,omitzero"` // Service Type, command/docker/file/kubernetes/manual/prometheus/url.
+
+	// command
+	Command Command `json:"command,omitempty" yaml:"command,omitempty"` // Command to run.
+	Timeout string  `json:"timeout,omitzero" yaml:"timeout,omitzero"`   // Maximum duration the command may run for.
+	Output  string  `json:"output,omitzero" yaml:"output,omitzero"`     // Output to read the version from (stdout/stderr/combined).
+
+	// docker/kubernetes
+	Host      string `json:"host,omitzero" yaml:"host,omitzero"`           // Docker Engine API endpoint.
+	Container string `json:"container,omitzero" yaml:"container,omitzero"` // Name of the container.
+	Source    string `json:"source,omitzero" yaml:"source,omitzero"`       // Part of the container/workload to read the version from (tag/label/digest).
+	Label     string `json:"label,omitzero" yaml:"label,omitzero"`         // Label to read the version from.
+
+	// kubernetes
+	Kubeconfig string `json:"kubeconfig,omitzero" yaml:"kubeconfig,omitzero"` // Path to a kubeconfig.
+	Context    string `json:"context,omitzero" yaml:"context,omitzero"`       // Kubeconfig context.
+	Namespace  string `json:"namespace,omitzero" yaml:"namespace,omitzero"`   // Namespace of the workload.
+	Kind       string `json:"kind,omitzero" yaml:"kind,omitzero"`             // Kind of the workload (deployment/statefulset/daemonset).
+	Name       string `json:"name,omitzero" yaml:"name,omitzero"`             // Name of the workload.
+
+	// file
+	Path   string `json:"path,omitzero" yaml:"path,omitzero"`     // Path of the file to read.
+	Format string `json:"format,omitzero" yaml:"format,omitzero"` // Format of the file (json/yaml/toml/text).
+	Key    string `json:"key,omitzero" yaml:"key,omitzero"`       // Key to use e.g. version_current.
 
 	// manual
 	Version string `json:"version,omitzero" yaml:"version,omitzero"` // Deployed version.
 
-	// url
-	Method            string                 `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
-	URL               string                 `json:"url,omitzero" yaml:"url,omitzero"`                                 // URL to query.
-	AllowInvalidCerts *bool                  `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
-	TargetHeader      string                 `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // Header to target for the version.
-	BasicAuth         *BasicAuth             `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`                   // Basic Auth credentials.
-	Headers           []Header               `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Request Headers.
-	Body              string                 `json:"body,omitzero" yaml:"body,omitzero"`                               // Request Body.
-	JSON              string                 `json:"json,omitzero" yaml:"json,omitzero"`                               // JSON key to use e.g. version_current.
-	Regex             string                 `json:"regex,omitzero" yaml:"regex,omitzero"`                             // Regex for the version.
-	RegexTemplate     string                 `json:"regex_template,omitzero" yaml:"regex_template,omitzero"`           // Template to apply to the RegEx match.
-	HardDefaults      *DeployedVersionLookup `json:"-" yaml:"-"`                                                       // Hardcoded default values.
-	Defaults          *DeployedVersionLookup `json:"-" yaml:"-"`                                                       // Default values.
+	// prometheus
+	Metric       string            `json:"metric,omitzero" yaml:"metric,omitzero"`               // Name of the metric.
+	Labels       map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`             // Label values the metric must have.
+	VersionLabel string            `json:"version_label,omitzero" yaml:"version_label,omitzero"` // Label to read the version from.
+
+	// prometheus/url
+	Method            string                  `json:"method,omitzero" yaml:"method,omitzero"`                           // HTTP method.
+	URL               string                  `json:"url,omitzero" yaml:"url,omitzero"`                                 // URL to query.
+	AllowInvalidCerts *bool                   `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *TLS                    `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
+	Proxy             *Proxy                  `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // HTTP/SOCKS5 proxy to send requests through.
+	TargetHeader      string                  `json:"target_header,omitzero" yaml:"target_header,omitzero"`             // Header to target for the version.
+	Targets           []DeployedVersionTarget `json:"targets,omitempty" yaml:"targets,omitempty"`                       // Instances to query in place of the URL.
+	Quorum            *int                    `json:"quorum,omitzero" yaml:"quorum,omitzero"`                           // Number of targets that must report a version before it is deployed.
+	BasicAuth         *BasicAuth              `json:"basic_auth,omitzero" yaml:"basic_auth,omitzero"`                   // Basic Auth credentials.
+	OAuth2            *OAuth2                 `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OAuth2 client-credentials.
+	Headers           []Header                `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Request Headers.
+	Body              string                  `json:"body,omitzero" yaml:"body,omitzero"`                               // Request Body.
+	JSON              string                  `json:"json,omitzero" yaml:"json,omitzero"`                               // JSON key to use e.g. version_current.
+	Regex             string                  `json:"regex,omitzero" yaml:"regex,omitzero"`                             // Regex for the version.
+	RegexTemplate     string                  `json:"regex_template,omitzero" yaml:"regex_template,omitzero"`           // Template to apply to the RegEx match.
+	URLCommands       URLCommands             `json:"url_commands,omitempty" yaml:"url_commands,omitempty"`             // Commands to filter the version from the JSON/header/RegEx result.
+	HardDefaults      *DeployedVersionLookup  `json:"-" yaml:"-"`                                                       // Hardcoded default values.
+	Defaults          *DeployedVersionLookup  `json:"-" yaml:"-"`                                                       // Default values.
+}
+
+// DeployedVersionTarget is an instance of a Service to query for its deployed version.
+type DeployedVersionTarget struct {
+	Name string `json:"name,omitzero" yaml:"name,omitzero"` // Name of the instance.
+	URL  string `json:"url,omitzero" yaml:"url,omitzero"`   // URL to query.
 }
 
 // String implements fmt.Stringer and returns a JSON representation.
@@ -669,6 +791,63 @@ type BasicAuth struct {
 	Password string `json:"password" yaml:"password"`
 }
 
+// TLS settings to verify the server of the HTTP(S) request, and to authenticate to it.
+type TLS struct {
+	CAFile     string `json:"ca_file,omitzero" yaml:"ca_file,omitzero"`         // PEM bundle of CAs to trust.
+	CertFile   string `json:"cert_file,omitzero" yaml:"cert_file,omitzero"`     // PEM client certificate.
+	KeyFile    string `json:"key_file,omitzero" yaml:"key_file,omitzero"`       // PEM client key.
+	ServerName string `json:"server_name,omitzero" yaml:"server_name,omitzero"` // Server name to verify the certificate against.
+}
+
+// Proxy to send the HTTP(S) request through.
+type Proxy struct {
+	URL     string   `json:"url,omitzero" yaml:"url,omitzero"`             // http(s)/socks5(h) URL of the proxy, with optional credentials.
+	NoProxy []string `json:"no_proxy,omitempty" yaml:"no_proxy,omitempty"` // Hosts/CIDRs to reach directly.
+}
+
+// Limits on outbound requests.
+type Limits struct {
+	Global  *Limit            `json:"global,omitzero" yaml:"global,omitzero"`     // Limit on all requests.
+	PerHost *Limit            `json:"per_host,omitzero" yaml:"per_host,omitzero"` // Limit on the requests to each host.
+	Hosts   map[string]*Limit `json:"hosts,omitempty" yaml:"hosts,omitempty"`     // Limit on the requests to specific hosts.
+}
+
+// Limit on the rate and concurrency of requests.
+type Limit struct {
+	Rate          string `json:"rate,omitzero" yaml:"rate,omitzero"`                     // e.g. '10/s', '100/m' or '1000/h'.
+	Burst         int    `json:"burst,omitzero" yaml:"burst,omitzero"`                   // Requests that may be sent at once before the rate applies.
+	MaxConcurrent int    `json:"max_concurrent,omitzero" yaml:"max_concurrent,omitzero"` // Requests that may be in-flight at once.
+}
+
+// Censor redacts the password in the URL of the receiver.
+func (p *Proxy) Censor() {
+	if p == nil {
+		return
+	}
+
+	p.URL = httpx.CensorProxyURL(p.URL)
+}
+
+// OAuth2 client-credentials to get a bearer token for the HTTP(S) request with.
+type OAuth2 struct {
+	TokenURL     string   `json:"token_url,omitzero" yaml:"token_url,omitzero"`         // URL of the token endpoint.
+	ClientID     string   `json:"client_id,omitzero" yaml:"client_id,omitzero"`         // Client ID.
+	ClientSecret string   `json:"client_secret,omitzero" yaml:"client_secret,omitzero"` // Client secret.
+	Scopes       []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`             // Scopes to request.
+	Audience     string   `json:"audience,omitzero" yaml:"audience,omitzero"`           // Audience to request.
+}
+
+// Censor redacts the ClientSecret of the receiver.
+func (o *OAuth2) Censor() {
+	if o == nil {
+		return
+	}
+
+	if o.ClientSecret != "" {
+		o.ClientSecret = util.SecretValue
+	}
+}
+
 // Header to use in the HTTP request.
 type Header struct {
 	Key   string `json:"key" yaml:"key"`     // Header key, e.g. X-Sig.
@@ -697,16 +876,47 @@ type URLCommand struct {
 	Old      string `json:"old,omitzero" yaml:"old,omitzero"`           // replace:     strings.ReplaceAll(tgtString, "Old", "New").
 }
 
+// BumpCleared is the Status.Bump sent when the bump has been cleared since the previous ServiceSummary.
+const BumpCleared = "none"
+
 // Status is the Status of a Service.
 type Status struct {
-	ApprovedVersion          string `json:"approved_version,omitzero" yaml:"approved_version,omitzero"`                     // The approved version.
-	DeployedVersion          string `json:"deployed_version,omitzero" yaml:"deployed_version,omitzero"`                     // Deployed version of the Service.
-	DeployedVersionTimestamp string `json:"deployed_version_timestamp,omitzero" yaml:"deployed_version_timestamp,omitzero"` // UTC timestamp that the deployed version changed.
-	LatestVersion            string `json:"latest_version,omitzero" yaml:"latest_version,omitzero"`                         // Latest version of the Service.
-	LatestVersionTimestamp   string `json:"latest_version_timestamp,omitzero" yaml:"latest_version_timestamp,omitzero"`     // UTC timestamp that the latest version last changed.
-	LastQueried              string `json:"last_queried,omitzero" yaml:"last_queried,omitzero"`                             // UTC timestamp of the last query.
-	RegexMissesContent       uint   `json:"regex_misses_content,omitzero" yaml:"regex_misses_content,omitzero"`             // Counter for the number of regular expression misses on URL content.
-	RegexMissesVersion       uint   `json:"regex_misses_version,omitzero" yaml:"regex_misses_version,omitzero"`             // Counter for the number of regular expression misses on version.
+	ApprovedVersion          string     `json:"approved_version,omitzero" yaml:"approved_version,omitzero"`                     // The approved version.
+	DeployedVersion          string     `json:"deployed_version,omitzero" yaml:"deployed_version,omitzero"`                     // Deployed version of the Service.
+	DeployedVersionTimestamp string     `json:"deployed_version_timestamp,omitzero" yaml:"deployed_version_timestamp,omitzero"` // UTC timestamp that the deployed version changed.
+	LatestVersion            string     `json:"latest_version,omitzero" yaml:"latest_version,omitzero"`                         // Latest version of the Service.
+	LatestVersionTimestamp   string     `json:"latest_version_timestamp,omitzero" yaml:"latest_version_timestamp,omitzero"`     // UTC timestamp that the latest version last changed.
+	Bump                     string     `json:"bump,omitzero" yaml:"bump,omitzero"`                                             // Semantic version bump from the deployed/approved version to the latest version.
+	FleetState               string     `json:"fleet_state,omitzero" yaml:"fleet_state,omitzero"`                               // Aggregate state of the versions reported by the instances (latest/partial/mixed/outdated).
+	Instances                []Instance `json:"instances,omitempty" yaml:"instances,omitempty"`                                 // Versions reported by each instance.
+	DeployedVersionRollout   *Rollout   `json:"deployed_version_rollout,omitzero" yaml:"deployed_version_rollout,omitzero"`     // In-progress rollout of the deployed version.
+	LastQueried              string     `json:"last_queried,omitzero" yaml:"last_queried,omitzero"`                             // UTC timestamp of the last query.
+	RegexMissesContent       uint       `json:"regex_misses_content,omitzero" yaml:"regex_misses_content,omitzero"`             // Counter for the number of regular expression misses on URL content.
+	RegexMissesVersion       uint       `json:"regex_misses_version,omitzero" yaml:"regex_misses_version,omitzero"`             // Counter for the number of regular expression misses on version.
+	LatestVersionRetry       *Retry     `json:"latest_version_retry,omitzero" yaml:"latest_version_retry,omitzero"`             // Backoff of the failing latest version lookup.
+	DeployedVersionRetry     *Retry     `json:"deployed_version_retry,omitzero" yaml:"deployed_version_retry,omitzero"`         // Backoff of the failing deployed version lookup.
+}
+
+// Retry is the backoff of a lookup whose queries are failing.
+type Retry struct {
+	Failures    int    `json:"failures" yaml:"failures"`                           // Consecutive failed queries.
+	Backoff     string `json:"backoff,omitzero" yaml:"backoff,omitzero"`           // Delay before the next attempt.
+	NextAttempt string `json:"next_attempt,omitzero" yaml:"next_attempt,omitzero"` // UTC timestamp of the next attempt.
+}
+
+// Rollout is an in-progress rollout of the deployed version.
+// An empty Rollout marks the rollout as complete.
+type Rollout struct {
+	Version  string `json:"version,omitzero" yaml:"version,omitzero"`   // Version being rolled out.
+	Progress string `json:"progress,omitzero" yaml:"progress,omitzero"` // Description of the progress of the rollout.
+}
+
+// Instance is the deployed version reported by one instance of a Service.
+type Instance struct {
+	Name      string `json:"name" yaml:"name"`                             // Name of the instance.
+	Version   string `json:"version,omitzero" yaml:"version,omitzero"`     // Version last reported by the instance.
+	Timestamp string `json:"timestamp,omitzero" yaml:"timestamp,omitzero"` // UTC timestamp that the version of the instance changed.
+	Error     string `json:"error,omitzero" yaml:"error,omitzero"`         // Error from the last query of the instance.
 }
 
 // String implements fmt.Stringer and returns a JSON representation.
@@ -759,8 +969,11 @@ type WebHook struct {
 	Type              string   `json:"type,omitzero" yaml:"type,omitzero"`                               // "github"/"url".
 	URL               string   `json:"url,omitzero" yaml:"url,omitzero"`                                 // "https://example.com".
 	AllowInvalidCerts *bool    `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *TLS     `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
+	Proxy             *Proxy   `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // HTTP/SOCKS5 proxy to send the WebHook through.
 	Secret            string   `json:"secret,omitzero" yaml:"secret,omitzero"`                           // "SECRET".
 	Headers           []Header `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Custom Headers for the WebHook.
+	OAuth2            *OAuth2  `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OAuth2 client-credentials.
 	DesiredStatusCode *uint16  `json:"desired_status_code,omitzero" yaml:"desired_status_code,omitzero"` // e.g. 202.
 	Delay             string   `json:"delay,omitzero" yaml:"delay,omitzero"`                             // The delay before sending the WebHook.
 	MaxTries          *uint8   `json:"max_tries,omitzero" yaml:"max_tries,omitzero"`                     // Number of times to send the WebHook until we receive the desired status code.
@@ -774,8 +987,11 @@ func (w WebHook) IsZero() bool {
 		w.Type == "" &&
 		w.URL == "" &&
 		w.AllowInvalidCerts == nil &&
+		w.TLS == nil &&
+		w.Proxy == nil &&
 		w.Secret == "" &&
 		len(w.Headers) == 0 &&
+		w.OAuth2 == nil &&
 		w.DesiredStatusCode == nil &&
 		w.Delay == "" &&
 		w.MaxTries == nil &&
@@ -807,6 +1023,11 @@ func (w *WebHook) Censor() {
 			w.Headers[i].Value = util.SecretValue
 		}
 	}
+
+	// OAuth2.
+	w.OAuth2.Censor()
+	// Proxy.
+	w.Proxy.Censor()
 }
 
 // CommandSummary holds the summary of a Command.
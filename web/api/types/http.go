@@ -35,6 +35,81 @@ type WebSocketTokenAPI struct {
 	Token string `json:"token"`
 }
 
+// HistoryAPI is the response given at the /api/v1/service/history endpoint.
+type HistoryAPI struct {
+	Events []HistoryEvent `json:"events"`
+	Total  int            `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+// HistoryEvent is a version transition of a Service.
+type HistoryEvent struct {
+	ID              int64  `json:"id"`
+	Type            string `json:"type"`                      // latest/deployed/approved/skipped.
+	Version         string `json:"version,omitzero"`          // Version transitioned to.
+	PreviousVersion string `json:"previous_version,omitzero"` // Version transitioned from.
+	Source          string `json:"source,omitzero"`           // What caused the transition.
+	Timestamp       string `json:"timestamp"`                 // UTC timestamp of the transition.
+}
+
+// AuditAPI is the response given at the /api/v1/audit endpoint.
+type AuditAPI struct {
+	Entries []AuditEntry `json:"entries"`
+	Total   int          `json:"total"`
+	Limit   int          `json:"limit"`
+	Offset  int          `json:"offset"`
+}
+
+// AuditEntry is a record of an action taken on Argus.
+type AuditEntry struct {
+	ID        int64  `json:"id"`
+	Timestamp string `json:"timestamp"`           // UTC timestamp of the action.
+	Actor     string `json:"actor,omitzero"`      // Basic auth user, token identity, or 'argus'.
+	IP        string `json:"ip,omitzero"`         // Source IP of the request.
+	Action    string `json:"action"`              // What was done.
+	ServiceID string `json:"service_id,omitzero"` // Service acted on.
+	Target    string `json:"target,omitzero"`     // e.g. the Command/WebHook, or version.
+	Outcome   string `json:"outcome"`             // success/failure/accepted.
+	Detail    string `json:"detail,omitzero"`     // e.g. the error.
+}
+
+// RestoreAPI is the response given at the /api/v1/restore endpoint.
+type RestoreAPI struct {
+	Mode     string   `json:"mode"`              // merge/replace.
+	Services []string `json:"services"`          // Imported services in the config.
+	Unknown  []string `json:"unknown,omitempty"` // Imported services not in the config.
+	History  int      `json:"history"`           // History events added.
+	Audit    int      `json:"audit"`             // Audit entries added.
+}
+
+// DeploymentSummaryAPI is the response given at the /api/v1/deployment/summary endpoint.
+type DeploymentSummaryAPI struct {
+	ServiceCount   int                 `json:"service_count"`   // Services with a latest and deployed version.
+	ServicesBehind int                 `json:"services_behind"` // Services not on their latest version.
+	VersionsBehind int                 `json:"versions_behind"` // Sum of the versions each Service is behind.
+	LeadTime       DurationSummary     `json:"lead_time"`       // Time from a version being found, to it being deployed.
+	Drift          DurationSummary     `json:"drift"`           // Time each Service behind has been behind.
+	Services       []DeploymentService `json:"services"`
+}
+
+// DurationSummary aggregates durations, in seconds.
+type DurationSummary struct {
+	Count  int     `json:"count"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	P90    float64 `json:"p90"`
+	Max    float64 `json:"max"`
+}
+
+// DeploymentService is the lead time and drift of a Service.
+type DeploymentService struct {
+	ID              string   `json:"id"`
+	VersionsBehind  int      `json:"versions_behind"`
+	DriftSeconds    float64  `json:"drift_seconds"`
+	LeadTimeSeconds *float64 `json:"lead_time_seconds,omitempty"` // Of the last deployment.
+}
+
 // Response is a generic API response body.
 type Response struct {
 	Error   string `json:"error,omitzero"`
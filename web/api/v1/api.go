@@ -18,6 +18,7 @@ package v1
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 
@@ -25,6 +26,7 @@ import (
 
 	"github.com/release-argus/Argus/config"
 	"github.com/release-argus/Argus/config/decode"
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	apitype "github.com/release-argus/Argus/web/api/types"
 )
@@ -78,6 +80,14 @@ func NewAPI(cfg *config.Config) (*API, *mux.Route) {
 			),
 		)
 
+	// On baseRouter as push tokens authenticate independently of basicAuth (disable=dv_push).
+	if len(cfg.Settings.Web.PushTokens) != 0 &&
+		!slices.Contains(cfg.Settings.Web.DisabledRoutes, "dv_push") {
+		baseRouter.Path(routePrefix + "/api/v1/deployed_version/push").
+			Methods(http.MethodPost).
+			Handler(loggerMiddleware(api.audited(dbtype.AuditDeployedPush, api.httpDeployedVersionPush)))
+	}
+
 	wsRoute := baseRouter.Path(routePrefix + "/ws")
 
 	api.Router = baseRouter.PathPrefix(routePrefix).Subrouter().StrictSlash(true)
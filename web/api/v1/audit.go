@@ -0,0 +1,121 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/release-argus/Argus/config/decode"
+	dbtype "github.com/release-argus/Argus/db/types"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// auditBodyLimit is the most of a response body kept to describe a failure.
+const auditBodyLimit = 1024
+
+// auditContextKey is the context key of the *dbtype.AuditEntry for a request.
+type auditContextKey struct{}
+
+// auditRecorder captures the status code, and start of the body, of a response.
+type auditRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+// WriteHeader records the status code before writing it.
+func (a *auditRecorder) WriteHeader(statusCode int) {
+	if a.statusCode == 0 {
+		a.statusCode = statusCode
+	}
+	a.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write keeps up to auditBodyLimit bytes of the body before writing it.
+func (a *auditRecorder) Write(b []byte) (int, error) {
+	if a.statusCode == 0 {
+		a.statusCode = http.StatusOK
+	}
+	if remaining := auditBodyLimit - a.body.Len(); remaining > 0 {
+		a.body.Write(b[:min(len(b), remaining)])
+	}
+	//nolint:wrapcheck
+	return a.ResponseWriter.Write(b)
+}
+
+// audited wraps handler to record the request in the audit log as `action`.
+//
+// The entry defaults to the 'service_id' query parameter, with an outcome from the status code.
+// Handlers may refine it through [auditEntry].
+func (api *API) audited(action string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entry := &dbtype.AuditEntry{
+			Actor:     api.requestActor(r),
+			IP:        getIP(r),
+			Action:    action,
+			ServiceID: r.URL.Query().Get("service_id"),
+		}
+		recorder := &auditRecorder{ResponseWriter: w}
+
+		handler(recorder, r.WithContext(context.WithValue(r.Context(), auditContextKey{}, entry)))
+
+		entry.Timestamp = time.Now().UTC().Format(time.RFC3339)
+		if entry.Outcome == "" {
+			entry.Outcome = dbtype.AuditSuccess
+			if recorder.statusCode >= http.StatusBadRequest {
+				entry.Outcome = dbtype.AuditFailure
+			}
+		}
+		if entry.Outcome == dbtype.AuditFailure && entry.Detail == "" {
+			var response apitype.Response
+			if err := decode.Unmarshal("json", recorder.body.Bytes(), &response); err == nil {
+				entry.Detail = response.Message
+			}
+		}
+
+		api.sendAudit(*entry)
+	}
+}
+
+// auditEntry returns the audit entry of r, or nil if r is not audited.
+func auditEntry(r *http.Request) *dbtype.AuditEntry {
+	entry, _ := r.Context().Value(auditContextKey{}).(*dbtype.AuditEntry)
+	return entry
+}
+
+// requestActor returns the basic auth user of r (if basic auth is enabled).
+func (api *API) requestActor(r *http.Request) string {
+	if api.wsTokens == nil { // Basic auth disabled.
+		return ""
+	}
+	username, _, _ := r.BasicAuth()
+	return username
+}
+
+// sendAudit sends the entry to the Database.
+func (api *API) sendAudit(entry dbtype.AuditEntry) {
+	if api.Config.DatabaseChannel == nil {
+		return
+	}
+
+	api.Config.DatabaseChannel <- dbtype.Message{
+		ServiceID: entry.ServiceID,
+		Audit:     []dbtype.AuditEntry{entry},
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/release-argus/Argus/config"
+	dbtype "github.com/release-argus/Argus/db/types"
+)
+
+func TestAPI_audited(t *testing.T) {
+	// GIVEN: an API and an audited handler.
+	tests := []struct {
+		name      string
+		basicAuth bool
+		username  string
+		handler   http.HandlerFunc
+		want      dbtype.AuditEntry
+	}{
+		{
+			name:    "success, no body",
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			want: dbtype.AuditEntry{
+				IP: "192.0.2.1", Action: dbtype.AuditServiceDelete, ServiceID: "svc",
+				Outcome: dbtype.AuditSuccess},
+		},
+		{
+			name: "failure, detail from failRequest",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				failRequest(&w, errors.New("service not found"), http.StatusNotFound)
+			},
+			want: dbtype.AuditEntry{
+				IP: "192.0.2.1", Action: dbtype.AuditServiceDelete, ServiceID: "svc",
+				Outcome: dbtype.AuditFailure, Detail: "service not found"},
+		},
+		{
+			name: "refined by the handler",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				entry := auditEntry(r)
+				entry.Action = dbtype.AuditSkip
+				entry.Target = "1.2.3"
+				entry.Outcome = dbtype.AuditAccepted
+			},
+			want: dbtype.AuditEntry{
+				IP: "192.0.2.1", Action: dbtype.AuditSkip, ServiceID: "svc", Target: "1.2.3",
+				Outcome: dbtype.AuditAccepted},
+		},
+		{
+			name:      "actor from basic auth",
+			basicAuth: true,
+			username:  "alice",
+			handler:   func(w http.ResponseWriter, r *http.Request) {},
+			want: dbtype.AuditEntry{
+				Actor: "alice", IP: "192.0.2.1", Action: dbtype.AuditServiceDelete, ServiceID: "svc",
+				Outcome: dbtype.AuditSuccess},
+		},
+		{
+			name:     "actor ignored without basic auth",
+			username: "alice",
+			handler:  func(w http.ResponseWriter, r *http.Request) {},
+			want: dbtype.AuditEntry{
+				IP: "192.0.2.1", Action: dbtype.AuditServiceDelete, ServiceID: "svc",
+				Outcome: dbtype.AuditSuccess},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			databaseChannel := make(chan dbtype.Message, 4)
+			api := &API{Config: &config.Config{DatabaseChannel: databaseChannel}}
+			if tc.basicAuth {
+				api.wsTokens = newWebSocketTokenStore()
+			}
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/service/delete?service_id=svc", nil)
+			if tc.username != "" {
+				req.SetBasicAuth(tc.username, "password")
+			}
+
+			// WHEN: the request is handled.
+			w := httptest.NewRecorder()
+			api.audited(dbtype.AuditServiceDelete, tc.handler)(w, req)
+
+			prefix := fmt.Sprintf("%s\nAPI.audited(%s)", packageName, tc.name)
+
+			// THEN: the audit entry is sent to the Database.
+			if len(databaseChannel) != 1 {
+				t.Fatalf("%s message count mismatch\ngot:  %d\nwant: 1",
+					prefix, len(databaseChannel))
+			}
+			message := <-databaseChannel
+			if len(message.Audit) != 1 {
+				t.Fatalf("%s Audit count mismatch\ngot:  %+v\nwant: 1 entry",
+					prefix, message.Audit)
+			}
+			got := message.Audit[0]
+			if got.Timestamp == "" {
+				t.Errorf("%s Timestamp not set", prefix)
+			}
+			got.Timestamp = ""
+			if got != tc.want {
+				t.Errorf("%s entry mismatch\ngot:  %+v\nwant: %+v",
+					prefix, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAuditEntry__notAudited(t *testing.T) {
+	// GIVEN: a request that isn't audited.
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// WHEN: auditEntry is called on it.
+	got := auditEntry(req)
+
+	// THEN: nil is returned.
+	if got != nil {
+		t.Errorf("%s\nauditEntry() mismatch\ngot:  %+v\nwant: nil",
+			packageName, got)
+	}
+}
+
+func TestCSVSafe(t *testing.T) {
+	// GIVEN: values that may be evaluated by a spreadsheet.
+	tests := map[string]string{
+		"":            "",
+		"foo":         "foo",
+		"=SUM(A1:A2)": "'=SUM(A1:A2)",
+		"+1":          "'+1",
+		"-1":          "'-1",
+		"@cmd":        "'@cmd",
+	}
+
+	for value, want := range tests {
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: csvSafe is called.
+			got := csvSafe(value)
+
+			// THEN: formulas are neutralised.
+			if got != want {
+				t.Errorf("%s\ncsvSafe(%q) mismatch\ngot:  %q\nwant: %q",
+					packageName, value, got, want)
+			}
+		})
+	}
+}
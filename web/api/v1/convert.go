@@ -16,18 +16,29 @@
 package v1
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/release-argus/Argus/command"
 	"github.com/release-argus/Argus/config"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/notify/shoutrrr"
 	"github.com/release-argus/Argus/service"
 	deployedver "github.com/release-argus/Argus/service/deployed_version"
+	dvcommand "github.com/release-argus/Argus/service/deployed_version/types/command"
+	dvdocker "github.com/release-argus/Argus/service/deployed_version/types/docker"
+	dvfile "github.com/release-argus/Argus/service/deployed_version/types/file"
+	dvkubernetes "github.com/release-argus/Argus/service/deployed_version/types/kubernetes"
 	dvmanual "github.com/release-argus/Argus/service/deployed_version/types/manual"
+	dvprometheus "github.com/release-argus/Argus/service/deployed_version/types/prometheus"
 	dvweb "github.com/release-argus/Argus/service/deployed_version/types/web"
 	latestver "github.com/release-argus/Argus/service/latest_version"
 	"github.com/release-argus/Argus/service/latest_version/filter"
 	"github.com/release-argus/Argus/service/latest_version/filter/docker"
 	"github.com/release-argus/Argus/service/latest_version/types/github"
 	lvweb "github.com/release-argus/Argus/service/latest_version/types/web"
+	opt "github.com/release-argus/Argus/service/option"
+	"github.com/release-argus/Argus/service/shared"
 	"github.com/release-argus/Argus/util"
 	apitype "github.com/release-argus/Argus/web/api/types"
 	"github.com/release-argus/Argus/webhook"
@@ -48,7 +59,13 @@ func convertAndCensorDefaults(input *config.Defaults) apitype.Defaults {
 		Service: apitype.ServiceDefaults{
 			Options: apitype.ServiceOptions{
 				Interval:           input.Service.Options.Interval,
+				Schedule:           input.Service.Options.Schedule,
+				Jitter:             input.Service.Options.Jitter,
+				ActiveHours:        input.Service.Options.ActiveHours,
+				Backoff:            input.Service.Options.Backoff,
+				BackoffMax:         input.Service.Options.BackoffMax,
 				SemanticVersioning: input.Service.Options.SemanticVersioning,
+				Reminder:           convertReminder(input.Service.Options.Reminder),
 			},
 			LatestVersion: apitype.LatestVersionDefaults{
 				Type: input.Service.LatestVersion.Type,
@@ -61,11 +78,13 @@ func convertAndCensorDefaults(input *config.Defaults) apitype.Defaults {
 				},
 				URL: apitype.LatestVersionURLDefaults{
 					AllowInvalidCerts: input.Service.LatestVersion.URL.AllowInvalidCerts,
+					TLS:               convertTLS(input.Service.LatestVersion.URL.TLS),
 				},
 			},
 			DeployedVersionLookup: apitype.DeployedVersionLookupDefaults{
 				Type:              input.Service.DeployedVersionLookup.Type,
 				AllowInvalidCerts: input.Service.DeployedVersionLookup.AllowInvalidCerts,
+				TLS:               convertTLS(input.Service.DeployedVersionLookup.TLS),
 				Method:            input.Service.DeployedVersionLookup.Method,
 			},
 			Dashboard: apitype.DashboardOptions{
@@ -100,7 +119,13 @@ func convertAndCensorService(input *service.Service) *apitype.Service {
 	apiService.Options = apitype.ServiceOptions{
 		Active:             input.Options.Active,
 		Interval:           input.Options.Interval,
+		Schedule:           input.Options.Schedule,
+		Jitter:             input.Options.Jitter,
+		ActiveHours:        input.Options.ActiveHours,
+		Backoff:            input.Options.Backoff,
+		BackoffMax:         input.Options.BackoffMax,
 		SemanticVersioning: input.Options.SemanticVersioning,
+		Reminder:           convertReminder(input.Options.Reminder),
 	}
 
 	apiService.LatestVersion = convertAndCensorLatestVersion(input.LatestVersion)
@@ -143,6 +168,7 @@ func convertAndCensorLatestVersion(input latestver.Lookup) *apitype.LatestVersio
 			URL:           lv.URL,
 			AccessToken:   util.ValueUnlessZero(lv.AccessToken, util.SecretValue),
 			UsePreRelease: lv.UsePreRelease,
+			Proxy:         convertAndCensorProxy(lv.Proxy),
 			URLCommands:   convertURLCommands(lv.URLCommands),
 			Require:       convertAndCensorLatestVersionRequire(lv.Require),
 		}
@@ -150,11 +176,25 @@ func convertAndCensorLatestVersion(input latestver.Lookup) *apitype.LatestVersio
 		apiLV := &apitype.LatestVersion{
 			Type:              lv.Type,
 			URL:               lv.URL,
+			Method:            lv.Method,
 			AllowInvalidCerts: lv.AllowInvalidCerts,
+			TLS:               convertTLS(lv.TLS),
+			Proxy:             convertAndCensorProxy(lv.Proxy),
 			URLCommands:       convertURLCommands(lv.URLCommands),
+			OAuth2:            convertAndCensorOAuth2(lv.OAuth2),
+			Body:              lv.Body,
+			TargetHeader:      lv.TargetHeader,
 			Require:           convertAndCensorLatestVersionRequire(lv.Require),
 		}
 
+		// Basic auth (censoring the password).
+		if lv.BasicAuth != nil {
+			apiLV.BasicAuth = &apitype.BasicAuth{
+				Username: lv.BasicAuth.Username,
+				Password: util.SecretValue,
+			}
+		}
+
 		// Headers (censoring each value).
 		if len(lv.Headers) > 0 {
 			apiLV.Headers = make([]apitype.Header, len(lv.Headers))
@@ -311,30 +351,90 @@ func convertAndCensorDeployedVersionLookup(input deployedver.Lookup) *apitype.De
 			Method:            dvl.Method,
 			URL:               dvl.URL,
 			AllowInvalidCerts: dvl.AllowInvalidCerts,
+			TLS:               convertTLS(dvl.TLS),
+			Proxy:             convertAndCensorProxy(dvl.Proxy),
 			TargetHeader:      dvl.TargetHeader,
+			Quorum:            dvl.Quorum,
 			Headers:           nil,
 			Body:              dvl.Body,
 			JSON:              dvl.JSON,
 			Regex:             dvl.Regex,
 			RegexTemplate:     dvl.RegexTemplate,
+			URLCommands:       convertURLCommands(dvl.URLCommands),
 		}
 
-		// Basic auth.
-		if dvl.BasicAuth != nil {
-			apiDVL.BasicAuth = &apitype.BasicAuth{
-				Username: dvl.BasicAuth.Username,
-				Password: util.SecretValue,
+		apiDVL.BasicAuth, apiDVL.Headers = convertAndCensorDeployedVersionAuth(dvl.BasicAuth, dvl.Headers)
+		apiDVL.OAuth2 = convertAndCensorOAuth2(dvl.OAuth2)
+		// Targets.
+		if len(dvl.Targets) != 0 {
+			apiDVL.Targets = make([]apitype.DeployedVersionTarget, len(dvl.Targets))
+			for i, target := range dvl.Targets {
+				apiDVL.Targets[i] = apitype.DeployedVersionTarget{
+					Name: target.Name,
+					URL:  target.URL,
+				}
 			}
 		}
 
-		// Headers.
-		apiDVL.Headers = make([]apitype.Header, len(dvl.Headers))
-		for i := range dvl.Headers {
-			apiDVL.Headers[i] = apitype.Header{
-				Key:   dvl.Headers[i].Key,
-				Value: util.SecretValue,
-			}
+		return &apiDVL
+	case *dvcommand.Lookup:
+		return &apitype.DeployedVersionLookup{
+			Type:          input.GetType(),
+			Command:       apitype.Command(dvl.Command.Copy()),
+			Timeout:       dvl.Timeout,
+			Output:        dvl.Output,
+			JSON:          dvl.JSON,
+			Regex:         dvl.Regex,
+			RegexTemplate: dvl.RegexTemplate,
+		}
+	case *dvdocker.Lookup:
+		return &apitype.DeployedVersionLookup{
+			Type:          input.GetType(),
+			Host:          dvl.Host,
+			Container:     dvl.Container,
+			Source:        dvl.Source,
+			Label:         dvl.Label,
+			Regex:         dvl.Regex,
+			RegexTemplate: dvl.RegexTemplate,
+		}
+	case *dvfile.Lookup:
+		return &apitype.DeployedVersionLookup{
+			Type:          input.GetType(),
+			Path:          dvl.Path,
+			Format:        dvl.Format,
+			Key:           dvl.Key,
+			Regex:         dvl.Regex,
+			RegexTemplate: dvl.RegexTemplate,
+		}
+	case *dvkubernetes.Lookup:
+		return &apitype.DeployedVersionLookup{
+			Type:          input.GetType(),
+			Kubeconfig:    dvl.Kubeconfig,
+			Context:       dvl.Context,
+			Namespace:     dvl.Namespace,
+			Kind:          dvl.Kind,
+			Name:          dvl.Name,
+			Container:     dvl.Container,
+			Source:        dvl.Source,
+			Label:         dvl.Label,
+			Regex:         dvl.Regex,
+			RegexTemplate: dvl.RegexTemplate,
+		}
+	case *dvprometheus.Lookup:
+		apiDVL := apitype.DeployedVersionLookup{
+			Type:              input.GetType(),
+			URL:               dvl.URL,
+			AllowInvalidCerts: dvl.AllowInvalidCerts,
+			TLS:               convertTLS(dvl.TLS),
+			Proxy:             convertAndCensorProxy(dvl.Proxy),
+			OAuth2:            convertAndCensorOAuth2(dvl.OAuth2),
+			Metric:            dvl.Metric,
+			Labels:            maps.Clone(dvl.Labels),
+			VersionLabel:      dvl.VersionLabel,
+			Regex:             dvl.Regex,
+			RegexTemplate:     dvl.RegexTemplate,
 		}
+		apiDVL.BasicAuth, apiDVL.Headers = convertAndCensorDeployedVersionAuth(dvl.BasicAuth, dvl.Headers)
 
 		return &apiDVL
 	case *dvmanual.Lookup:
@@ -347,6 +447,137 @@ func convertAndCensorDeployedVersionLookup(input deployedver.Lookup) *apitype.De
 	return nil
 }
 
+// convertAndCensorDeployedVersionAuth converts the BasicAuth and Headers of a deployed version request,
+// censoring the password and header values.
+func convertAndCensorDeployedVersionAuth(basicAuth *dvweb.BasicAuth, headers shared.Headers) (*apitype.BasicAuth, []apitype.Header) {
+	// Basic auth.
+	var apiBasicAuth *apitype.BasicAuth
+	if basicAuth != nil {
+		apiBasicAuth = &apitype.BasicAuth{
+			Username: basicAuth.Username,
+			Password: util.SecretValue,
+		}
+	}
+
+	// Headers.
+	apiHeaders := make([]apitype.Header, len(headers))
+	for i := range headers {
+		apiHeaders[i] = apitype.Header{
+			Key:   headers[i].Key,
+			Value: util.SecretValue,
+		}
+	}
+
+	return apiBasicAuth, apiHeaders
+}
+
+// convertTLS converts TLS to API type.
+func convertTLS(input *httpx.TLS) *apitype.TLS {
+	if input == nil {
+		return nil
+	}
+
+	return &apitype.TLS{
+		CAFile:     input.CAFile,
+		CertFile:   input.CertFile,
+		KeyFile:    input.KeyFile,
+		ServerName: input.ServerName,
+	}
+}
+
+// convertReminder converts Reminder to API type.
+func convertReminder(input *opt.Reminder) *apitype.ServiceReminder {
+	if input == nil {
+		return nil
+	}
+
+	return &apitype.ServiceReminder{
+		ReminderCadence: *convertReminderCadence(&input.ReminderCadence),
+		EscalateNotify:  input.EscalateNotify,
+		Major:           convertReminderCadence(input.Major),
+		Minor:           convertReminderCadence(input.Minor),
+		Patch:           convertReminderCadence(input.Patch),
+	}
+}
+
+// convertReminderCadence converts ReminderCadence to API type.
+func convertReminderCadence(input *opt.ReminderCadence) *apitype.ReminderCadence {
+	if input == nil {
+		return nil
+	}
+
+	return &apitype.ReminderCadence{
+		After:         input.After,
+		Every:         input.Every,
+		EscalateAfter: input.EscalateAfter,
+	}
+}
+
+// convertAndCensorProxy converts Proxy to API type, censoring the password in its URL.
+func convertAndCensorProxy(input *httpx.Proxy) *apitype.Proxy {
+	if input == nil {
+		return nil
+	}
+
+	apiProxy := &apitype.Proxy{
+		URL:     input.URL,
+		NoProxy: slices.Clone(input.NoProxy),
+	}
+	apiProxy.Censor()
+
+	return apiProxy
+}
+
+// convertLimits converts Limits to API type.
+func convertLimits(input *httpx.Limits) *apitype.Limits {
+	if input == nil {
+		return nil
+	}
+
+	limits := &apitype.Limits{
+		Global:  convertLimit(input.Global),
+		PerHost: convertLimit(input.PerHost),
+	}
+	if input.Hosts != nil {
+		limits.Hosts = make(map[string]*apitype.Limit, len(input.Hosts))
+		for host, limit := range input.Hosts {
+			limits.Hosts[host] = convertLimit(limit)
+		}
+	}
+	return limits
+}
+
+// convertLimit converts Limit to API type.
+func convertLimit(input *httpx.Limit) *apitype.Limit {
+	if input == nil {
+		return nil
+	}
+
+	return &apitype.Limit{
+		Rate:          input.Rate,
+		Burst:         input.Burst,
+		MaxConcurrent: input.MaxConcurrent,
+	}
+}
+
+// convertAndCensorOAuth2 converts OAuth2 to API type, censoring the client secret.
+func convertAndCensorOAuth2(input *httpx.OAuth2) *apitype.OAuth2 {
+	if input == nil {
+		return nil
+	}
+
+	apiOAuth2 := &apitype.OAuth2{
+		TokenURL:     input.TokenURL,
+		ClientID:     input.ClientID,
+		ClientSecret: input.ClientSecret,
+		Scopes:       input.Scopes,
+		Audience:     input.Audience,
+	}
+	apiOAuth2.Censor()
+
+	return apiOAuth2
+}
+
 //
 // Notify.
 //
@@ -435,8 +666,11 @@ func convertAndCensorWebHookDefaults(input webhook.Defaults) apitype.WebHook {
 		Type:              input.Type,
 		URL:               input.URL,
 		AllowInvalidCerts: input.AllowInvalidCerts,
+		TLS:               convertTLS(input.TLS),
+		Proxy:             convertAndCensorProxy(input.Proxy),
 		Secret:            util.ValueUnlessZero(input.Secret, util.SecretValue),
 		Headers:           convertWebHookHeaders(input.Headers),
+		OAuth2:            convertAndCensorOAuth2(input.OAuth2),
 		DesiredStatusCode: input.DesiredStatusCode,
 		Delay:             input.Delay,
 		MaxTries:          input.MaxTries,
@@ -471,8 +705,11 @@ func convertAndCensorWebHook(input *webhook.WebHook) apitype.WebHook {
 		Type:              input.Type,
 		URL:               input.URL,
 		AllowInvalidCerts: input.AllowInvalidCerts,
+		TLS:               convertTLS(input.TLS),
+		Proxy:             convertAndCensorProxy(input.Proxy),
 		Secret:            util.ValueUnlessZero(input.Secret, util.SecretValue),
 		Headers:           convertWebHookHeaders(input.Headers),
+		OAuth2:            convertAndCensorOAuth2(input.OAuth2),
 		DesiredStatusCode: input.DesiredStatusCode,
 		Delay:             input.Delay,
 		MaxTries:          input.MaxTries,
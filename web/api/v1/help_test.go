@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -251,3 +252,70 @@ func testFaviconSettings(png string, svg string) *config.FaviconSettings {
 		PNG: png,
 	}
 }
+
+// fakeDatabase is a dbtype.Database returning canned history/audit entries, archives and import summaries.
+type fakeDatabase struct {
+	events       []dbtype.Event
+	auditEntries []dbtype.AuditEntry
+	archive      []byte
+	summary      dbtype.ImportSummary
+	err          error
+
+	historyQuery dbtype.HistoryQuery
+	auditQuery   dbtype.AuditQuery
+	exportOpts   dbtype.ExportOptions
+	imported     []byte
+	importOpts   dbtype.ImportOptions
+}
+
+// page returns the [offset, offset+limit) slice of items (limit 0 for all).
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := len(items)
+	if limit > 0 {
+		end = min(offset+limit, end)
+	}
+	return items[offset:end]
+}
+
+func (f *fakeDatabase) History(_ context.Context, query dbtype.HistoryQuery) ([]dbtype.Event, int, error) {
+	f.historyQuery = query
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	events := make([]dbtype.Event, 0, len(f.events))
+	for _, event := range f.events {
+		if event.ServiceID == "" || event.ServiceID == query.ServiceID {
+			events = append(events, event)
+		}
+	}
+	return page(events, query.Limit, query.Offset), len(events), nil
+}
+
+func (f *fakeDatabase) Audit(_ context.Context, query dbtype.AuditQuery) ([]dbtype.AuditEntry, int, error) {
+	f.auditQuery = query
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	return page(f.auditEntries, query.Limit, query.Offset), len(f.auditEntries), nil
+}
+
+func (f *fakeDatabase) Export(_ context.Context, w io.Writer, opts dbtype.ExportOptions) error {
+	f.exportOpts = opts
+	if f.err != nil {
+		return f.err
+	}
+	_, err := w.Write(f.archive)
+	return err
+}
+
+func (f *fakeDatabase) Import(_ context.Context, r io.Reader, opts dbtype.ImportOptions) (dbtype.ImportSummary, error) {
+	f.importOpts = opts
+	var err error
+	if f.imported, err = io.ReadAll(r); err != nil {
+		return dbtype.ImportSummary{}, err
+	}
+	return f.summary, f.err
+}
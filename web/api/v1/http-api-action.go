@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strings"
 
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	apitype "github.com/release-argus/Argus/web/api/types"
 )
@@ -143,6 +144,11 @@ func (api *API) httpServiceRunActions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit := auditEntry(r)
+	if audit != nil {
+		audit.Target = payload.Target
+	}
+
 	// SKIP this release.
 	if payload.Target == ActionSkip {
 		msg := fmt.Sprintf(
@@ -150,6 +156,10 @@ func (api *API) httpServiceRunActions(w http.ResponseWriter, r *http.Request) {
 			serviceID, svc.Status.LatestVersion(),
 		)
 		logx.Info(msg, logFrom, true)
+		if audit != nil {
+			audit.Action = dbtype.AuditSkip
+			audit.Target = svc.Status.LatestVersion()
+		}
 		svc.HandleSkip()
 		return
 	}
@@ -179,6 +189,10 @@ func (api *API) httpServiceRunActions(w http.ResponseWriter, r *http.Request) {
 		),
 	)
 	logx.Info(msg, logFrom, true)
+	// The results of the Commands/WebHooks are audited as they finish.
+	if audit != nil {
+		audit.Outcome = dbtype.AuditAccepted
+	}
 	switch payload.Target {
 	case ActionAll, ActionFailed:
 		go svc.HandleFailedActions()
@@ -0,0 +1,208 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+const (
+	auditDefaultLimit = 50  // Entries returned (as JSON) when no limit is given.
+	auditMaxLimit     = 500 // Most entries returned (as JSON) in one page.
+)
+
+// auditCSVHeader is the header row of a CSV export of the audit log.
+var auditCSVHeader = []string{
+	"id", "timestamp", "actor", "ip", "action", "service_id", "target", "outcome", "detail",
+}
+
+// httpAudit returns the audit log (newest first), filtered and paginated, as JSON or CSV.
+//
+// Method: GET
+//
+// Query Parameters:
+//
+//	service_id: Only entries for this Service.
+//	actor: Only entries by this actor.
+//	action: Only entries of this action.
+//	outcome: Only entries with this outcome (success, failure, accepted).
+//	since: Only entries at/after this RFC3339 timestamp.
+//	until: Only entries before this RFC3339 timestamp.
+//	limit: Maximum number of entries to return (JSON: default 50, max 500. CSV: default all).
+//	offset: Number of entries to skip.
+//	format: 'json' (default) or 'csv'.
+//
+// Response:
+//
+//	JSON object containing the entries, and the total number of matching entries,
+//	or a CSV file of the entries.
+func (api *API) httpAudit(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpAudit", Secondary: getIP(r)}
+
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format != "" && format != "json" && format != "csv" {
+		failRequest(&w,
+			fmt.Errorf("invalid format %q, must be one of json, csv", format),
+			http.StatusBadRequest)
+		return
+	}
+	query, err := parseAuditQuery(r, format == "csv")
+	if err != nil {
+		failRequest(&w, err, http.StatusBadRequest)
+		return
+	}
+
+	if api.Config.Database == nil {
+		failRequest(&w, errors.New("database unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	entries, total, err := api.Config.Database.Audit(r.Context(), query)
+	if err != nil {
+		logx.Error(err, logFrom, true)
+		failRequest(&w, errors.New("failed to query audit log"), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "csv" {
+		writeAuditCSV(w, entries, logFrom)
+		return
+	}
+
+	response := apitype.AuditAPI{
+		Entries: make([]apitype.AuditEntry, len(entries)),
+		Total:   total,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	}
+	for i, entry := range entries {
+		response.Entries[i] = apitype.AuditEntry{
+			ID:        entry.ID,
+			Timestamp: entry.Timestamp,
+			Actor:     entry.Actor,
+			IP:        entry.IP,
+			Action:    entry.Action,
+			ServiceID: entry.ServiceID,
+			Target:    entry.Target,
+			Outcome:   entry.Outcome,
+			Detail:    entry.Detail,
+		}
+	}
+
+	api.writeJSON(w, response, logFrom)
+}
+
+// parseAuditQuery reads the filters and pagination of an audit log request.
+// An export (CSV) returns all entries unless a limit is given.
+func parseAuditQuery(r *http.Request, export bool) (dbtype.AuditQuery, error) {
+	params := r.URL.Query()
+	query := dbtype.AuditQuery{
+		ServiceID: params.Get("service_id"),
+		Actor:     params.Get("actor"),
+		Action:    params.Get("action"),
+		Outcome:   params.Get("outcome"),
+	}
+	if !export {
+		query.Limit = auditDefaultLimit
+	}
+
+	// since/until.
+	for _, bound := range []struct {
+		name string
+		dst  *string
+	}{
+		{"since", &query.Since},
+		{"until", &query.Until},
+	} {
+		value := params.Get(bound.name)
+		if value == "" {
+			continue
+		}
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return query, fmt.Errorf("invalid %s %q, must be an RFC3339 timestamp", bound.name, value)
+		}
+		*bound.dst = timestamp.UTC().Format(time.RFC3339)
+	}
+
+	// limit.
+	if limit := params.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			return query, fmt.Errorf("invalid limit %q, must be a positive integer", limit)
+		}
+		query.Limit = n
+		if !export {
+			query.Limit = min(n, auditMaxLimit)
+		}
+	}
+
+	// offset.
+	if offset := params.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return query, fmt.Errorf("invalid offset %q, must be a non-negative integer", offset)
+		}
+		query.Offset = n
+	}
+
+	return query, nil
+}
+
+// writeAuditCSV writes the entries to w as a CSV file.
+func writeAuditCSV(w http.ResponseWriter, entries []dbtype.AuditEntry, logFrom logx.LogFrom) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="argus-audit.csv"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	writer := csv.NewWriter(w)
+	_ = writer.Write(auditCSVHeader)
+	for _, entry := range entries {
+		_ = writer.Write([]string{
+			strconv.FormatInt(entry.ID, 10),
+			entry.Timestamp,
+			csvSafe(entry.Actor),
+			csvSafe(entry.IP),
+			csvSafe(entry.Action),
+			csvSafe(entry.ServiceID),
+			csvSafe(entry.Target),
+			csvSafe(entry.Outcome),
+			csvSafe(entry.Detail),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		logx.Error(err, logFrom, true)
+	}
+}
+
+// csvSafe prefixes values that spreadsheets would evaluate as a formula with a single quote.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
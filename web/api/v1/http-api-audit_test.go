@@ -0,0 +1,207 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/release-argus/Argus/config"
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestHTTP_HTTPAudit(t *testing.T) {
+	entries := []dbtype.AuditEntry{
+		{ID: 2, Timestamp: "2026-01-01T00:02:00Z", Actor: "alice", IP: "192.0.2.1",
+			Action: dbtype.AuditServiceEdit, ServiceID: "svc", Outcome: dbtype.AuditFailure,
+			Detail: "=HYPERLINK(\"x\")"},
+		{ID: 1, Timestamp: "2026-01-01T00:01:00Z", Actor: dbtype.AuditActorArgus,
+			Action: dbtype.AuditCommandRun, ServiceID: "svc", Target: "ls -lah", Outcome: dbtype.AuditSuccess},
+	}
+	// GIVEN: an API and a request for the audit log.
+	tests := []struct {
+		name            string
+		params          map[string]string
+		reader          *fakeDatabase
+		wantQuery       *dbtype.AuditQuery
+		wantBody        string
+		wantContentType string
+		wantStatusCode  int
+	}{
+		{
+			name:      "JSON, defaults",
+			params:    map[string]string{},
+			reader:    &fakeDatabase{auditEntries: entries},
+			wantQuery: &dbtype.AuditQuery{Limit: 50},
+			wantBody: `^{"entries":\[` +
+				`{"id":2,"timestamp":"2026-01-01T00:02:00Z","actor":"alice","ip":"192.0.2.1","action":"service_edit","service_id":"svc","outcome":"failure","detail":"=HYPERLINK\(\\"x\\"\)"},` +
+				`{"id":1,"timestamp":"2026-01-01T00:01:00Z","actor":"argus","action":"command_run","service_id":"svc","target":"ls -lah","outcome":"success"}` +
+				`\],"total":2,"limit":50,"offset":0}\n$`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name: "JSON, filters",
+			params: map[string]string{
+				"service_id": "svc", "actor": "alice", "action": "service_edit", "outcome": "failure",
+				"since": "2026-01-01T01:00:00+01:00", "until": "2026-01-02T00:00:00Z",
+				"limit": "1000", "offset": "1"},
+			reader: &fakeDatabase{auditEntries: entries},
+			wantQuery: &dbtype.AuditQuery{
+				ServiceID: "svc", Actor: "alice", Action: "service_edit", Outcome: "failure",
+				Since: "2026-01-01T00:00:00Z", Until: "2026-01-02T00:00:00Z",
+				Limit: 500, Offset: 1},
+			wantBody:        `"total":2,"limit":500,"offset":1}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name:      "CSV, all by default",
+			params:    map[string]string{"format": "csv"},
+			reader:    &fakeDatabase{auditEntries: entries},
+			wantQuery: &dbtype.AuditQuery{},
+			wantBody: `^id,timestamp,actor,ip,action,service_id,target,outcome,detail\n` +
+				`2,2026-01-01T00:02:00Z,alice,192.0.2.1,service_edit,svc,,failure,"'=HYPERLINK\(""x""\)"\n` +
+				`1,2026-01-01T00:01:00Z,argus,,command_run,svc,ls -lah,success,\n$`,
+			wantContentType: "text/csv",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name:            "CSV, limit not capped",
+			params:          map[string]string{"format": "CSV", "limit": "1000"},
+			reader:          &fakeDatabase{auditEntries: entries},
+			wantQuery:       &dbtype.AuditQuery{Limit: 1000},
+			wantContentType: "text/csv",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name:           "invalid format",
+			params:         map[string]string{"format": "xml"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid format \\"xml\\", must be one of json, csv"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid since",
+			params:         map[string]string{"since": "yesterday"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid since \\"yesterday\\", must be an RFC3339 timestamp"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit",
+			params:         map[string]string{"limit": "-1"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid limit \\"-1\\", must be a positive integer"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid offset",
+			params:         map[string]string{"offset": "x"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid offset \\"x\\", must be a non-negative integer"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "no database",
+			params:         map[string]string{},
+			wantBody:       `{"message":"database unavailable"}`,
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "query error",
+			params:         map[string]string{},
+			reader:         &fakeDatabase{err: errors.New("disk on fire")},
+			wantBody:       `{"message":"failed to query audit log"}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := &API{Config: &config.Config{}}
+			if tc.reader != nil {
+				api.Config.Database = tc.reader
+			}
+
+			target := "/api/v1/audit"
+			params := url.Values{}
+			for key, value := range tc.params {
+				params.Set(key, value)
+			}
+
+			// WHEN: that HTTP request is sent.
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.URL.RawQuery = params.Encode()
+			w := httptest.NewRecorder()
+			api.httpAudit(w, req)
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpAudit(%v)", packageName, tc.params)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wantStatusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wantBody, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantBody,
+				)
+			}
+
+			// AND: the expected Content-Type is returned.
+			if got := res.Header.Get("Content-Type"); !util.RegexCheck("^"+tc.wantContentType, got) {
+				t.Errorf(
+					"%s Content-Type mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantContentType,
+				)
+			}
+
+			// AND: the Database is queried as expected.
+			if tc.wantQuery != nil {
+				if got, want := fmt.Sprintf("%+v", tc.reader.auditQuery), fmt.Sprintf("%+v", *tc.wantQuery); got != want {
+					t.Errorf(
+						"%s query mismatch\ngot:  %s\nwant: %s",
+						prefix, got, want,
+					)
+				}
+			}
+		})
+	}
+}
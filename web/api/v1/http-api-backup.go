@@ -0,0 +1,146 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// maxRestoreSize is the largest backup archive accepted by /api/v1/restore.
+const maxRestoreSize = 256 << 20 // 256 MiB.
+
+// httpBackup returns a backup archive of the database.
+// The database is copied with SQLite's online backup API, so is consistent with ongoing writes.
+// The config file is left out as it may hold secrets, use --data.export to include it.
+//
+// Method: GET
+//
+// Response:
+//
+//	A gzipped tarball holding 'manifest.json' and 'argus.db'.
+func (api *API) httpBackup(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpBackup", Secondary: getIP(r)}
+
+	if api.Config.Database == nil {
+		failRequest(&w, errors.New("database unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	var archive bytes.Buffer
+	if err := api.Config.Database.Export(r.Context(), &archive, dbtype.ExportOptions{}); err != nil {
+		logx.Error(err, logFrom, true)
+		failRequest(&w, errors.New("failed to export backup"), http.StatusInternalServerError)
+		return
+	}
+
+	filename := fmt.Sprintf("argus-backup-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := w.Write(archive.Bytes()); err != nil {
+		logx.Error(err, logFrom, true)
+	}
+}
+
+// httpRestore imports a backup archive (from /api/v1/backup, or --data.export) into the database.
+// Any config in the archive is validated, but not applied, as the running config
+// cannot be swapped. Use --data.import to restore the config file too.
+//
+// Method: POST
+//
+// Query Parameters:
+//
+//	mode: 'merge' (default) to add to the existing state,
+//	  or 'replace' to discard the existing state first.
+//	map: Comma-separated 'archive=current' service ID mappings.
+//
+// Body: The backup archive.
+//
+// Response:
+//
+//	JSON object summarising what was imported.
+func (api *API) httpRestore(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpRestore", Secondary: getIP(r)}
+	queryParams := r.URL.Query()
+
+	mode := strings.ToLower(queryParams.Get("mode"))
+	if mode == "" {
+		mode = dbtype.ImportMerge
+	}
+	if entry := auditEntry(r); entry != nil {
+		entry.Target = mode
+	}
+	if mode != dbtype.ImportMerge && mode != dbtype.ImportReplace {
+		failRequest(&w,
+			fmt.Errorf("invalid mode %q, must be one of %s, %s", mode, dbtype.ImportMerge, dbtype.ImportReplace),
+			http.StatusBadRequest)
+		return
+	}
+	serviceIDs, err := dbtype.ParseServiceIDs(queryParams.Get("map"))
+	if err != nil {
+		failRequest(&w, err, http.StatusBadRequest)
+		return
+	}
+
+	if api.Config.Database == nil {
+		failRequest(&w, errors.New("database unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxRestoreSize)
+	summary, err := api.Config.Database.Import(r.Context(), body,
+		dbtype.ImportOptions{Mode: mode, ServiceIDs: serviceIDs})
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesErr):
+			failRequest(&w,
+				fmt.Errorf("archive too large, max %d bytes", maxBytesErr.Limit),
+				http.StatusRequestEntityTooLarge)
+		case errors.Is(err, dbtype.ErrInvalidArchive):
+			// Flatten the chain, as each wrap repeats the cause.
+			failRequest(&w, errors.New(err.Error()), http.StatusBadRequest)
+		default:
+			logx.Error(err, logFrom, true)
+			failRequest(&w, errors.New("failed to import backup"), http.StatusInternalServerError)
+		}
+		return
+	}
+	logx.Info(
+		fmt.Sprintf("Imported backup (%s) - %d services, %d history events, %d audit entries",
+			summary.Mode, len(summary.Services)+len(summary.Unknown), summary.History, summary.Audit),
+		logFrom, true,
+	)
+
+	api.writeJSON(w,
+		apitype.RestoreAPI{
+			Mode:     summary.Mode,
+			Services: summary.Services,
+			Unknown:  summary.Unknown,
+			History:  summary.History,
+			Audit:    summary.Audit,
+		},
+		logFrom)
+}
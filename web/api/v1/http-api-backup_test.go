@@ -0,0 +1,276 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/release-argus/Argus/config"
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestHTTP_HTTPBackup(t *testing.T) {
+	// GIVEN: an API and a request for a backup.
+	tests := []struct {
+		name            string
+		database        *fakeDatabase
+		wantBody        string
+		wantContentType string
+		wantDisposition string
+		wantStatusCode  int
+	}{
+		{
+			name:            "success",
+			database:        &fakeDatabase{archive: []byte("archive-bytes")},
+			wantBody:        `^archive-bytes$`,
+			wantContentType: "application/gzip",
+			wantDisposition: `^attachment; filename="argus-backup-\d{8}T\d{6}Z\.tar\.gz"$`,
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name:            "no database",
+			wantBody:        `{"message":"database unavailable"}`,
+			wantDisposition: `^$`,
+			wantStatusCode:  http.StatusServiceUnavailable,
+		},
+		{
+			name:            "export error",
+			database:        &fakeDatabase{err: errors.New("disk on fire")},
+			wantBody:        `{"message":"failed to export backup"}`,
+			wantDisposition: `^$`,
+			wantStatusCode:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := &API{Config: &config.Config{}}
+			if tc.database != nil {
+				api.Config.Database = tc.database
+			}
+
+			// WHEN: that HTTP request is sent.
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/backup", nil)
+			w := httptest.NewRecorder()
+			api.httpBackup(w, req)
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpBackup()", packageName)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wantStatusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wantBody, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantBody,
+				)
+			}
+
+			// AND: the config is left out of the archive.
+			if tc.database != nil && tc.database.exportOpts.Config {
+				t.Errorf(
+					"%s Export() options mismatch\ngot:  %+v\nwant: config excluded",
+					prefix, tc.database.exportOpts,
+				)
+			}
+
+			// AND: the expected headers are returned.
+			if got := res.Header.Get("Content-Type"); !util.RegexCheck("^"+tc.wantContentType, got) {
+				t.Errorf(
+					"%s Content-Type mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantContentType,
+				)
+			}
+			if got := res.Header.Get("Content-Disposition"); !util.RegexCheck(tc.wantDisposition, got) {
+				t.Errorf(
+					"%s Content-Disposition mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantDisposition,
+				)
+			}
+		})
+	}
+}
+
+func TestHTTP_HTTPRestore(t *testing.T) {
+	summary := dbtype.ImportSummary{
+		Mode:     dbtype.ImportReplace,
+		Services: []string{"alpha", "beta"},
+		Unknown:  []string{"gamma"},
+		History:  3,
+		Audit:    2,
+	}
+	// GIVEN: an API and a request to restore a backup.
+	tests := []struct {
+		name           string
+		params         map[string]string
+		database       *fakeDatabase
+		wantOpts       *dbtype.ImportOptions
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name:           "merge by default",
+			params:         map[string]string{},
+			database:       &fakeDatabase{summary: dbtype.ImportSummary{Mode: dbtype.ImportMerge, Services: []string{"alpha"}}},
+			wantOpts:       &dbtype.ImportOptions{Mode: dbtype.ImportMerge},
+			wantBody:       `^{"mode":"merge","services":\["alpha"\],"history":0,"audit":0}\n$`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:     "replace, with service IDs mapped",
+			params:   map[string]string{"mode": "Replace", "map": "old=alpha, older=beta"},
+			database: &fakeDatabase{summary: summary},
+			wantOpts: &dbtype.ImportOptions{
+				Mode:       dbtype.ImportReplace,
+				ServiceIDs: map[string]string{"old": "alpha", "older": "beta"}},
+			wantBody:       `^{"mode":"replace","services":\["alpha","beta"\],"unknown":\["gamma"\],"history":3,"audit":2}\n$`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "invalid mode",
+			params:         map[string]string{"mode": "overwrite"},
+			database:       &fakeDatabase{},
+			wantBody:       `{"message":"invalid mode \\"overwrite\\", must be one of merge, replace"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid map",
+			params:         map[string]string{"map": "alpha"},
+			database:       &fakeDatabase{},
+			wantBody:       `{"message":"invalid service ID mapping \\"alpha\\", want 'archive=current'"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "no database",
+			params:         map[string]string{},
+			wantBody:       `{"message":"database unavailable"}`,
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "invalid archive",
+			params:         map[string]string{},
+			database:       &fakeDatabase{err: fmt.Errorf("%w: missing %q", dbtype.ErrInvalidArchive, "argus.db")},
+			wantOpts:       &dbtype.ImportOptions{Mode: dbtype.ImportMerge},
+			wantBody:       `{"message":"invalid archive: missing \\"argus.db\\""}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "archive too large",
+			params:         map[string]string{},
+			database:       &fakeDatabase{err: &http.MaxBytesError{Limit: 5}},
+			wantBody:       `{"message":"archive too large, max 5 bytes"}`,
+			wantStatusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "import error",
+			params:         map[string]string{},
+			database:       &fakeDatabase{err: errors.New("disk on fire")},
+			wantBody:       `{"message":"failed to import backup"}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := &API{Config: &config.Config{}}
+			if tc.database != nil {
+				api.Config.Database = tc.database
+			}
+
+			params := url.Values{}
+			for key, value := range tc.params {
+				params.Set(key, value)
+			}
+
+			// WHEN: that HTTP request is sent with an archive.
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/restore", strings.NewReader("archive-bytes"))
+			req.URL.RawQuery = params.Encode()
+			w := httptest.NewRecorder()
+			api.httpRestore(w, req)
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpRestore(%v)", packageName, tc.params)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wantStatusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wantBody, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantBody,
+				)
+			}
+
+			// AND: the archive is imported with the expected options.
+			if tc.wantOpts != nil {
+				if got, want := fmt.Sprintf("%+v", tc.database.importOpts), fmt.Sprintf("%+v", *tc.wantOpts); got != want {
+					t.Errorf(
+						"%s options mismatch\ngot:  %s\nwant: %s",
+						prefix, got, want,
+					)
+				}
+				if got := string(tc.database.imported); got != "archive-bytes" {
+					t.Errorf(
+						"%s archive mismatch\ngot:  %q\nwant: %q",
+						prefix, got, "archive-bytes",
+					)
+				}
+			}
+		})
+	}
+}
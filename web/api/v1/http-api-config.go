@@ -32,8 +32,9 @@ func (api *API) httpConfig(w http.ResponseWriter, r *http.Request) {
 	// Settings.
 	cfg.Settings = apitype.Settings{
 		Data: apitype.DataSettings{
-			DatabaseFile: api.Config.Settings.Data.DatabaseFile,
-			Readonly:     api.Config.Settings.Data.Readonly,
+			DatabaseFile:     api.Config.Settings.Data.DatabaseFile,
+			Readonly:         api.Config.Settings.Data.Readonly,
+			HistoryRetention: api.Config.Settings.Data.HistoryRetention,
 		},
 		Log: apitype.LogSettings{
 			Timestamps: api.Config.Settings.Log.Timestamps,
@@ -46,7 +47,10 @@ func (api *API) httpConfig(w http.ResponseWriter, r *http.Request) {
 			KeyFile:        api.Config.Settings.Web.KeyFile,
 			RoutePrefix:    api.Config.Settings.Web.RoutePrefix,
 			DisabledRoutes: api.Config.Settings.Web.DisabledRoutes,
+			EnabledRoutes:  api.Config.Settings.Web.EnabledRoutes,
 		},
+		Proxy:  convertAndCensorProxy(api.Config.Settings.Proxy),
+		Limits: convertLimits(api.Config.Settings.Limits),
 	}
 
 	// Defaults.Service.LatestVersion.Common.Require.
@@ -67,10 +71,17 @@ func (api *API) httpConfig(w http.ResponseWriter, r *http.Request) {
 		Service: apitype.ServiceDefaults{
 			Options: apitype.ServiceOptions{
 				Interval:           api.Config.Defaults.Service.Options.Interval,
+				Schedule:           api.Config.Defaults.Service.Options.Schedule,
+				Jitter:             api.Config.Defaults.Service.Options.Jitter,
+				ActiveHours:        api.Config.Defaults.Service.Options.ActiveHours,
+				Backoff:            api.Config.Defaults.Service.Options.Backoff,
+				BackoffMax:         api.Config.Defaults.Service.Options.BackoffMax,
 				SemanticVersioning: api.Config.Defaults.Service.Options.SemanticVersioning,
+				Reminder:           convertReminder(api.Config.Defaults.Service.Options.Reminder),
 			},
 			DeployedVersionLookup: apitype.DeployedVersionLookupDefaults{
 				AllowInvalidCerts: api.Config.Defaults.Service.DeployedVersionLookup.AllowInvalidCerts,
+				TLS:               convertTLS(api.Config.Defaults.Service.DeployedVersionLookup.TLS),
 			},
 			Dashboard: apitype.DashboardOptions{
 				AutoApprove: api.Config.Defaults.Service.Dashboard.AutoApprove,
@@ -85,7 +96,9 @@ func (api *API) httpConfig(w http.ResponseWriter, r *http.Request) {
 					UsePreRelease: api.Config.Defaults.Service.LatestVersion.GitHub.UsePreRelease,
 				},
 				URL: apitype.LatestVersionURLDefaults{
+					Method:            api.Config.Defaults.Service.LatestVersion.URL.Method,
 					AllowInvalidCerts: api.Config.Defaults.Service.LatestVersion.URL.AllowInvalidCerts,
+					TLS:               convertTLS(api.Config.Defaults.Service.LatestVersion.URL.TLS),
 				},
 			},
 			Notify:  serviceNotifyDefaults,
@@ -0,0 +1,145 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	"github.com/release-argus/Argus/service/status"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// httpDeploymentSummary returns the deployment lead time and drift across all services.
+//
+// Lead times come from the history when the database is available,
+// otherwise from the last deployment of each service.
+//
+// Method: GET
+//
+// Response:
+//
+//	JSON object containing the aggregate lead time and drift, and those of each service.
+func (api *API) httpDeploymentSummary(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpDeploymentSummary", Secondary: getIP(r)}
+	now := time.Now()
+
+	api.Config.OrderMu.RLock()
+	order := slices.Clone(api.Config.Order)
+	services := make([]apitype.DeploymentService, 0, len(order))
+	var drifts, leadTimes []float64
+	for _, id := range order {
+		svc := api.Config.Service[id]
+		serviceInfo := svc.Status.GetServiceInfo()
+		if serviceInfo.LatestVersion == "" || serviceInfo.DeployedVersion == "" {
+			continue
+		}
+
+		service := apitype.DeploymentService{ID: id}
+		var since time.Time
+		service.VersionsBehind, since = svc.Status.Drift()
+		if !since.IsZero() {
+			service.DriftSeconds = max(now.Sub(since).Seconds(), 0)
+		}
+		if service.VersionsBehind != 0 {
+			drifts = append(drifts, service.DriftSeconds)
+		}
+		if leadTime, ok := svc.Status.LeadTime(); ok {
+			service.LeadTimeSeconds = new(leadTime.Seconds())
+			leadTimes = append(leadTimes, leadTime.Seconds())
+		}
+		services = append(services, service)
+	}
+	api.Config.OrderMu.RUnlock()
+
+	// Use every deployment in the history when available.
+	if api.Config.Database != nil {
+		var err error
+		if leadTimes, err = api.historyLeadTimes(r, order); err != nil {
+			logx.Error(err, logFrom, true)
+			failRequest(&w, errors.New("failed to query history"), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := apitype.DeploymentSummaryAPI{
+		ServiceCount: len(services),
+		LeadTime:     summariseDurations(leadTimes),
+		Drift:        summariseDurations(drifts),
+		Services:     services,
+	}
+	for _, service := range services {
+		if service.VersionsBehind != 0 {
+			response.ServicesBehind++
+			response.VersionsBehind += service.VersionsBehind
+		}
+	}
+
+	api.writeJSON(w, response, logFrom)
+}
+
+// historyLeadTimes returns the lead time, in seconds, of each deployment in the history of the services.
+func (api *API) historyLeadTimes(r *http.Request, serviceIDs []string) ([]float64, error) {
+	var leadTimes []float64
+	for _, id := range serviceIDs {
+		events, _, err := api.Config.Database.History(r.Context(), dbtype.HistoryQuery{
+			ServiceID: id,
+			Types:     []string{dbtype.EventLatest, dbtype.EventDeployed},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		slices.Reverse(events) // Oldest first.
+		for _, leadTime := range status.LeadTimes(events) {
+			leadTimes = append(leadTimes, leadTime.Seconds())
+		}
+	}
+
+	return leadTimes, nil
+}
+
+// summariseDurations returns the count, mean, median, 90th percentile and max of the durations (in seconds).
+func summariseDurations(durations []float64) apitype.DurationSummary {
+	summary := apitype.DurationSummary{Count: len(durations)}
+	if len(durations) == 0 {
+		return summary
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	var total float64
+	for _, duration := range sorted {
+		total += duration
+	}
+	summary.Mean = total / float64(len(sorted))
+	summary.Median = percentile(sorted, 50)
+	summary.P90 = percentile(sorted, 90)
+	summary.Max = sorted[len(sorted)-1]
+
+	return summary
+}
+
+// percentile returns the nearest-rank p-th percentile of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
@@ -0,0 +1,173 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/config"
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/service"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestHTTP_HTTPDeploymentSummary(t *testing.T) {
+	now := time.Now().UTC()
+	timestamp := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339) }
+	// setLatest has svc find version the given time ago.
+	setLatest := func(svc *service.Service, version string, ago time.Duration) {
+		svc.Status.SetLastQueried(timestamp(ago))
+		svc.Status.SetLatestVersion(version, "", false)
+	}
+	// GIVEN: a service behind, one up to date, and one without a deployed version.
+	newServices := func() (service.Services, []string) {
+		behind := testService(t, "behind", "url", "url", true)
+		setLatest(behind, "1.0.0", 5*time.Hour)
+		behind.Status.SetDeployedVersion("1.0.0", timestamp(4*time.Hour), false)
+		setLatest(behind, "1.1.0", 2*time.Hour)
+		setLatest(behind, "1.2.0", time.Hour)
+		current := testService(t, "current", "url", "url", true)
+		setLatest(current, "2.0.0", time.Hour)
+		current.Status.SetDeployedVersion("2.0.0", timestamp(30*time.Minute), false)
+		unknown := testService(t, "unknown", "url", "url", true)
+		setLatest(unknown, "3.0.0", time.Hour)
+		return service.Services{"behind": behind, "current": current, "unknown": unknown},
+			[]string{"behind", "current", "unknown"}
+	}
+	tests := []struct {
+		name           string
+		database       *fakeDatabase
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "lead times from the services",
+			wantBody: `^{"service_count":2,"services_behind":1,"versions_behind":2,` +
+				`"lead_time":{"count":2,"mean":2700,"median":1800,"p90":3600,"max":3600},` +
+				`"drift":{"count":1,"mean":72\d\d(\.\d+)?,"median":72\d\d(\.\d+)?,"p90":72\d\d(\.\d+)?,"max":72\d\d(\.\d+)?},` +
+				`"services":\[` +
+				`{"id":"behind","versions_behind":2,"drift_seconds":72\d\d(\.\d+)?,"lead_time_seconds":3600},` +
+				`{"id":"current","versions_behind":0,"drift_seconds":0,"lead_time_seconds":1800}` +
+				`\]}\n$`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "lead times from the history",
+			database: &fakeDatabase{events: []dbtype.Event{
+				{ServiceID: "behind", Type: dbtype.EventDeployed, Version: "0.2.0", Timestamp: "2026-01-02T00:20:00Z"},
+				{ServiceID: "behind", Type: dbtype.EventLatest, Version: "0.2.0", Timestamp: "2026-01-02T00:00:00Z"},
+				{ServiceID: "behind", Type: dbtype.EventDeployed, Version: "0.1.0", Timestamp: "2026-01-01T00:10:00Z"},
+				{ServiceID: "behind", Type: dbtype.EventLatest, Version: "0.1.0", Timestamp: "2026-01-01T00:00:00Z"},
+				{ServiceID: "current", Type: dbtype.EventDeployed, Version: "2.0.0", Timestamp: "2026-01-01T00:30:00Z"},
+				{ServiceID: "current", Type: dbtype.EventLatest, Version: "2.0.0", Timestamp: "2026-01-01T00:00:00Z"},
+			}},
+			wantBody:       `"lead_time":{"count":3,"mean":1200,"median":1200,"p90":1800,"max":1800},`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "history error",
+			database:       &fakeDatabase{err: errors.New("disk on fire")},
+			wantBody:       `{"message":"failed to query history"}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			services, order := newServices()
+			api := &API{Config: &config.Config{Service: services, Order: order}}
+			if tc.database != nil {
+				api.Config.Database = tc.database
+			}
+
+			// WHEN: the deployment summary is requested.
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/deployment/summary", nil)
+			w := httptest.NewRecorder()
+			api.httpDeploymentSummary(w, req)
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpDeploymentSummary()", packageName)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wantStatusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wantBody, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantBody,
+				)
+			}
+		})
+	}
+}
+
+func TestSummariseDurations(t *testing.T) {
+	// GIVEN: some durations.
+	tests := map[string]struct {
+		durations []float64
+		want      string
+	}{
+		"none": {
+			want: "{Count:0 Mean:0 Median:0 P90:0 Max:0}",
+		},
+		"one": {
+			durations: []float64{5},
+			want:      "{Count:1 Mean:5 Median:5 P90:5 Max:5}",
+		},
+		"unsorted": {
+			durations: []float64{10, 1, 4, 3, 2, 9, 8, 7, 6, 5},
+			want:      "{Count:10 Mean:5.5 Median:5 P90:9 Max:10}",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: summariseDurations is called on them.
+			got := fmt.Sprintf("%+v", summariseDurations(tc.durations))
+
+			// THEN: the summary is as expected.
+			if got != tc.want {
+				t.Errorf("%s\nsummariseDurations(%v) mismatch\ngot:  %s\nwant: %s",
+					packageName, tc.durations, got, tc.want)
+			}
+		})
+	}
+}
@@ -747,6 +747,14 @@ func (api *API) httpServiceEdit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if audit := auditEntry(r); audit != nil && serviceID != newService.ID {
+		// Created, or renamed.
+		audit.ServiceID = newService.ID
+		if serviceID != "" {
+			audit.Detail = fmt.Sprintf("renamed from %q", serviceID)
+		}
+	}
+
 	newServiceSummary := newService.Summary()
 	// Announce the edit.
 	api.announceEdit(oldServiceSummary, newServiceSummary)
@@ -0,0 +1,157 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/internal/logx"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+const (
+	historyDefaultLimit = 50  // Events returned when no limit is given.
+	historyMaxLimit     = 500 // Most events returned in one page.
+)
+
+// historyTypes are the event types that can be filtered on.
+var historyTypes = []string{
+	dbtype.EventLatest,
+	dbtype.EventDeployed,
+	dbtype.EventApproved,
+	dbtype.EventSkipped,
+	dbtype.EventReminded,
+}
+
+// httpServiceHistory returns a page of the version history for the given service (newest first).
+//
+// Method: GET
+//
+// Query Parameters:
+//
+//	service_id: The ID of the Service to get the history of.
+//	limit: Maximum number of events to return (default 50, max 500).
+//	offset: Number of events to skip.
+//	type: Comma-separated event types to include (latest, deployed, approved, skipped, reminded).
+//
+// Response:
+//
+//	JSON object containing the events, and the total number of matching events.
+func (api *API) httpServiceHistory(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpServiceHistory", Secondary: getIP(r)}
+	serviceID, ok := requireQueryParam(w, r, "service_id")
+	if !ok {
+		return
+	}
+
+	query, err := parseHistoryQuery(r)
+	if err != nil {
+		failRequest(&w, err, http.StatusBadRequest)
+		return
+	}
+	query.ServiceID = serviceID
+
+	// Check Service still exists in this ordering.
+	api.Config.OrderMu.RLock()
+	svc := api.Config.Service[serviceID]
+	api.Config.OrderMu.RUnlock()
+	if svc == nil {
+		err := fmt.Errorf("service %q not found", serviceID)
+		logx.Error(err, logFrom, true)
+		failRequest(&w, err, http.StatusNotFound)
+		return
+	}
+
+	if api.Config.Database == nil {
+		failRequest(&w, errors.New("database unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	events, total, err := api.Config.Database.History(r.Context(), query)
+	if err != nil {
+		logx.Error(err, logFrom, true)
+		failRequest(&w, errors.New("failed to query history"), http.StatusInternalServerError)
+		return
+	}
+
+	response := apitype.HistoryAPI{
+		Events: make([]apitype.HistoryEvent, len(events)),
+		Total:  total,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+	for i, event := range events {
+		response.Events[i] = apitype.HistoryEvent{
+			ID:              event.ID,
+			Type:            event.Type,
+			Version:         event.Version,
+			PreviousVersion: event.PreviousVersion,
+			Source:          event.Source,
+			Timestamp:       event.Timestamp,
+		}
+	}
+
+	api.writeJSON(w, response, logFrom)
+}
+
+// parseHistoryQuery reads the pagination and type filters of a history request.
+func parseHistoryQuery(r *http.Request) (dbtype.HistoryQuery, error) {
+	params := r.URL.Query()
+	query := dbtype.HistoryQuery{Limit: historyDefaultLimit}
+
+	// limit.
+	if limit := params.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			return query, fmt.Errorf("invalid limit %q, must be a positive integer", limit)
+		}
+		query.Limit = min(n, historyMaxLimit)
+	}
+
+	// offset.
+	if offset := params.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return query, fmt.Errorf("invalid offset %q, must be a non-negative integer", offset)
+		}
+		query.Offset = n
+	}
+
+	// type.
+	for _, value := range params["type"] {
+		for eventType := range strings.SplitSeq(value, ",") {
+			eventType = strings.TrimSpace(eventType)
+			if eventType == "" {
+				continue
+			}
+			if !slices.Contains(historyTypes, eventType) {
+				return query, fmt.Errorf("invalid type %q, must be one of %s",
+					eventType, strings.Join(historyTypes, ", "))
+			}
+			if !slices.Contains(query.Types, eventType) {
+				query.Types = append(query.Types, eventType)
+			}
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,199 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	dbtype "github.com/release-argus/Argus/db/types"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestHTTP_HTTPServiceHistory(t *testing.T) {
+	testSVC := testService(t, "TestHTTP_HTTPServiceHistory", "url", "url", true)
+	events := []dbtype.Event{
+		{ID: 3, ServiceID: testSVC.ID, Type: dbtype.EventDeployed, Version: "1.1.0", PreviousVersion: "1.0.0",
+			Source: dbtype.SourceActions, Timestamp: "2026-01-01T00:03:00Z"},
+		{ID: 2, ServiceID: testSVC.ID, Type: dbtype.EventApproved, Version: "1.1.0",
+			Source: dbtype.SourceAPI, Timestamp: "2026-01-01T00:02:00Z"},
+		{ID: 1, ServiceID: testSVC.ID, Type: dbtype.EventLatest, Version: "1.1.0", PreviousVersion: "1.0.0",
+			Source: dbtype.SourceLatestVersion, Timestamp: "2026-01-01T00:01:00Z"},
+	}
+	// GIVEN: an API and a request for the history of a service.
+	tests := []struct {
+		name           string
+		params         map[string]string
+		reader         *fakeDatabase
+		wantQuery      *dbtype.HistoryQuery
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name:      "defaults",
+			params:    map[string]string{},
+			reader:    &fakeDatabase{events: events},
+			wantQuery: &dbtype.HistoryQuery{ServiceID: testSVC.ID, Limit: 50},
+			wantBody: `^{"events":\[` +
+				`{"id":3,"type":"deployed","version":"1.1.0","previous_version":"1.0.0","source":"actions","timestamp":"2026-01-01T00:03:00Z"},` +
+				`{"id":2,"type":"approved","version":"1.1.0","source":"api","timestamp":"2026-01-01T00:02:00Z"},` +
+				`{"id":1,"type":"latest","version":"1.1.0","previous_version":"1.0.0","source":"latest_version","timestamp":"2026-01-01T00:01:00Z"}` +
+				`\],"total":3,"limit":50,"offset":0}\n$`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "limit and offset",
+			params:         map[string]string{"limit": "1", "offset": "1"},
+			reader:         &fakeDatabase{events: events},
+			wantQuery:      &dbtype.HistoryQuery{ServiceID: testSVC.ID, Limit: 1, Offset: 1},
+			wantBody:       `^{"events":\[{"id":2,[^]]+\],"total":3,"limit":1,"offset":1}\n$`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "limit capped",
+			params:         map[string]string{"limit": "100000"},
+			reader:         &fakeDatabase{events: events},
+			wantQuery:      &dbtype.HistoryQuery{ServiceID: testSVC.ID, Limit: 500},
+			wantBody:       `"limit":500,`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "types",
+			params: map[string]string{"type": "deployed, approved,deployed"},
+			reader: &fakeDatabase{events: events},
+			wantQuery: &dbtype.HistoryQuery{ServiceID: testSVC.ID, Limit: 50,
+				Types: []string{dbtype.EventDeployed, dbtype.EventApproved}},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "invalid limit",
+			params:         map[string]string{"limit": "0"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid limit \\"0\\", must be a positive integer"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid offset",
+			params:         map[string]string{"offset": "-1"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid offset \\"-1\\", must be a non-negative integer"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid type",
+			params:         map[string]string{"type": "foo"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"invalid type \\"foo\\", must be one of latest, deployed, approved, skipped, reminded"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown service",
+			params:         map[string]string{"service_id": "bish-bash-bosh"},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"service .+ not found"`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "no service_id provided",
+			params:         map[string]string{"service_id": ""},
+			reader:         &fakeDatabase{},
+			wantBody:       `{"message":"missing required query parameter: service_id"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "no database",
+			params:         map[string]string{},
+			wantBody:       `{"message":"database unavailable"}`,
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "query error",
+			params:         map[string]string{},
+			reader:         &fakeDatabase{err: errors.New("disk on fire")},
+			wantBody:       `{"message":"failed to query history"}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := testAPI(t, "TestHTTP_HTTPServiceHistory.yml")
+			api.Config.Service[testSVC.ID] = testSVC
+			api.Config.Order = append(api.Config.Order, testSVC.ID)
+			if tc.reader != nil {
+				api.Config.Database = tc.reader
+			}
+
+			target := "/api/v1/service/history"
+			params := url.Values{}
+			params.Set("service_id", testSVC.ID)
+			for key, value := range tc.params {
+				params.Set(key, value)
+			}
+
+			// WHEN: that HTTP request is sent.
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.URL.RawQuery = params.Encode()
+			w := httptest.NewRecorder()
+			api.httpServiceHistory(w, req)
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpServiceHistory(%v)", packageName, tc.params)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wantStatusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wantBody, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wantBody,
+				)
+			}
+
+			// AND: the Database is queried as expected.
+			if tc.wantQuery != nil {
+				if got, want := fmt.Sprintf("%+v", tc.reader.historyQuery), fmt.Sprintf("%+v", *tc.wantQuery); got != want {
+					t.Errorf(
+						"%s query mismatch\ngot:  %s\nwant: %s",
+						prefix, got, want,
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 provides the API for the webserver.
+package v1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/release-argus/Argus/config"
+	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/logx"
+	deployedver "github.com/release-argus/Argus/service/deployed_version"
+	dvmanual "github.com/release-argus/Argus/service/deployed_version/types/manual"
+	apitype "github.com/release-argus/Argus/web/api/types"
+)
+
+// pushToken returns the push token presented in the Authorization header of r,
+// or nil if it doesn't match any configured.
+func (api *API) pushToken(r *http.Request) *config.WebSettingsPushToken {
+	bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || bearer == "" {
+		return nil
+	}
+	// Hash purely to prevent ConstantTimeCompare leaking lengths.
+	bearerHash := sha256.Sum256([]byte(bearer))
+
+	// Check every token to avoid leaking which matched through timing.
+	var match *config.WebSettingsPushToken
+	for _, token := range api.Config.Settings.Web.PushTokens {
+		if ConstantTimeCompare(bearerHash, token.TokenHash) && match == nil {
+			match = token
+		}
+	}
+	return match
+}
+
+// httpDeployedVersionPush sets the deployed version of the target service
+// to the version pushed (e.g. by a CI/CD pipeline after a deploy).
+//
+// Authenticated by a push token (settings.web.push_tokens), independently of basic auth.
+//
+// Method: POST
+//
+// Headers:
+//
+//	Authorization: Bearer <push token>
+//
+// Query Parameters:
+//
+//	service_id: The ID of the Service to set the DeployedVersion of.
+//	version: The deployed version (optional if provided in the body).
+//
+// Body:
+//
+//	Optional JSON object containing the version, e.g. {"version":"1.2.3"}.
+//
+// Response:
+//
+//	On success: JSON object containing the deployed version and the current UTC datetime.
+//	On error: HTTP 401/403/404/409 for auth/scope/service/lock failures,
+//	HTTP 429 Too Many Requests (with a Retry-After header) if pushed too soon after the last update,
+//	or HTTP 400 Bad Request if the version is missing or invalid.
+func (api *API) httpDeployedVersionPush(w http.ResponseWriter, r *http.Request) {
+	logFrom := logx.LogFrom{Primary: "httpDeployedVersionPush", Secondary: getIP(r)}
+
+	// Authenticate.
+	token := api.pushToken(r)
+	if token == nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="restricted", charset="UTF-8"`)
+		failRequest(&w, errors.New("invalid or missing push token"), http.StatusUnauthorized)
+		return
+	}
+	audit := auditEntry(r)
+	if audit != nil {
+		audit.Actor = "token:" + token.Name
+	}
+
+	// Service to push to.
+	serviceID, ok := requireQueryParam(w, r, "service_id")
+	if !ok {
+		return
+	}
+	if !token.Allows(serviceID) {
+		err := fmt.Errorf("push token %q may not push to service %q", token.Name, serviceID)
+		logx.Warn(err, logFrom, true)
+		failRequest(&w, err, http.StatusForbidden)
+		return
+	}
+
+	// Version from the query, or the body.
+	version := r.URL.Query().Get("version")
+	if version == "" {
+		var body struct {
+			Version string `json:"version"`
+		}
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 1024))
+		if err == nil && len(payload) != 0 {
+			err = decode.Unmarshal("json", payload, &body)
+		}
+		if err != nil {
+			logx.Error(err, logFrom, true)
+			failRequest(&w, err, http.StatusBadRequest)
+			return
+		}
+		version = body.Version
+	}
+	if audit != nil {
+		audit.Target = version
+	}
+
+	// Pushes take the per-service lock shared; reject if an edit/delete holds it.
+	op := api.acquireServiceOp(serviceID)
+	defer api.releaseServiceOp(serviceID, op)
+	if !op.mu.TryRLock() {
+		failRequest(
+			&w,
+			fmt.Errorf("push to %q failed, another operation is in progress for this service", serviceID),
+			http.StatusConflict,
+		)
+		return
+	}
+	defer op.mu.RUnlock()
+
+	// Check whether service exists.
+	api.Config.OrderMu.RLock()
+	svc := api.Config.Service[serviceID]
+	api.Config.OrderMu.RUnlock()
+	if svc == nil {
+		err := fmt.Errorf("service %q not found", serviceID)
+		logx.Error(err, logFrom, true)
+		failRequest(&w, err, http.StatusNotFound)
+		return
+	}
+
+	// Apply the version.
+	if err := deployedver.Push(&svc.Options, &svc.Status, version); err != nil {
+		logx.Error(err, logFrom, true)
+		if errors.Is(err, dvmanual.ErrRateLimited) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(dvmanual.RateLimit.Seconds())))
+			failRequest(&w, err, http.StatusTooManyRequests)
+			return
+		}
+		failRequest(&w, err, http.StatusBadRequest)
+		return
+	}
+
+	api.writeJSON(
+		w,
+		apitype.RefreshAPI{
+			Version: svc.Status.DeployedVersion(),
+			Date:    time.Now().UTC(),
+		},
+		logFrom,
+	)
+}
@@ -0,0 +1,275 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package v1
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/release-argus/Argus/config"
+	"github.com/release-argus/Argus/util"
+)
+
+func TestHTTP_DeployedVersionPush(t *testing.T) {
+	type wants struct {
+		bodyRegex       string
+		statusCode      int
+		retryAfter      string
+		deployedVersion string
+	}
+
+	// GIVEN: an API with push tokens, and a request to push the deployed_version of a service.
+	file := "TestHTTP_DeployedVersionPush.yml"
+	api := testAPI(t, file)
+	apiMu := sync.RWMutex{}
+	api.Config.Settings.Web.PushTokens = []*config.WebSettingsPushToken{
+		{Name: "all", Token: "all-secret"},
+		{Name: "scoped", Token: "scoped-secret", Services: []string{"scoped/allowed"}},
+	}
+	for _, token := range api.Config.Settings.Web.PushTokens {
+		_ = token.CheckValues()
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		serviceID     *string
+		semVer        bool
+		lastDeployed  string
+		params        map[string]string
+		body          string
+		wants         wants
+	}{
+		{
+			name:          "version in query",
+			authorization: "Bearer all-secret",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:       `{"version":"1\.2\.3","timestamp":"[^"]+"}`,
+				statusCode:      http.StatusOK,
+				deployedVersion: "1.2.3",
+			},
+		},
+		{
+			name:          "version in body",
+			authorization: "Bearer all-secret",
+			body:          `{"version":"4.5.6"}`,
+			wants: wants{
+				bodyRegex:       `{"version":"4\.5\.6",`,
+				statusCode:      http.StatusOK,
+				deployedVersion: "4.5.6",
+			},
+		},
+		{
+			name:          "no version",
+			authorization: "Bearer all-secret",
+			wants: wants{
+				bodyRegex:  `{"message":"version: <required>"}`,
+				statusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:          "invalid body",
+			authorization: "Bearer all-secret",
+			body:          `{"version":`,
+			wants: wants{
+				bodyRegex:  `{"message":".+"}`,
+				statusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:          "semantic_versioning/valid",
+			authorization: "Bearer all-secret",
+			semVer:        true,
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:       `{"version":"1\.2\.3",`,
+				statusCode:      http.StatusOK,
+				deployedVersion: "1.2.3",
+			},
+		},
+		{
+			name:          "semantic_versioning/invalid",
+			authorization: "Bearer all-secret",
+			semVer:        true,
+			params:        map[string]string{"version": "not-semver"},
+			wants: wants{
+				bodyRegex:  `failed to convert \\"not-semver\\" to a semantic version`,
+				statusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:          "rate-limited",
+			authorization: "Bearer all-secret",
+			lastDeployed:  "1.0.0",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:       `{"message":"manual version updates are rate-limited.*"}`,
+				statusCode:      http.StatusTooManyRequests,
+				retryAfter:      "1",
+				deployedVersion: "1.0.0",
+			},
+		},
+		{
+			name:   "no token",
+			params: map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"invalid or missing push token"}`,
+				statusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:          "unknown token",
+			authorization: "Bearer unknown-secret",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"invalid or missing push token"}`,
+				statusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:          "basic auth, not a push token",
+			authorization: "Basic YWxsLXNlY3JldA==",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"invalid or missing push token"}`,
+				statusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:          "scoped/allowed",
+			authorization: "Bearer scoped-secret",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:       `{"version":"1\.2\.3",`,
+				statusCode:      http.StatusOK,
+				deployedVersion: "1.2.3",
+			},
+		},
+		{
+			name:          "scoped/forbidden",
+			authorization: "Bearer scoped-secret",
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"push token \\"scoped\\" may not push to service \\"scoped/forbidden\\""}`,
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:          "no service_id",
+			authorization: "Bearer all-secret",
+			serviceID:     new(""),
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"missing required query parameter: service_id"}`,
+				statusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:          "unknown service",
+			authorization: "Bearer all-secret",
+			serviceID:     new("unknown"),
+			params:        map[string]string{"version": "1.2.3"},
+			wants: wants{
+				bodyRegex:  `{"message":"service \\"unknown\\" not found"}`,
+				statusCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := testService(t, tc.name, "url", "manual", tc.semVer)
+			apiMu.Lock()
+			api.Config.Service[svc.ID] = svc
+			apiMu.Unlock()
+			if tc.lastDeployed != "" {
+				svc.Status.SetDeployedVersion(tc.lastDeployed, time.Now().UTC().Format(time.RFC3339), false)
+			}
+
+			params := url.Values{}
+			for k, v := range tc.params {
+				params.Set(k, v)
+			}
+			// Set service_id.
+			params.Set("service_id", util.DerefOr(tc.serviceID, svc.ID))
+
+			// WHEN: that HTTP request is sent.
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/deployed_version/push", strings.NewReader(tc.body))
+			req.URL.RawQuery = params.Encode()
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			w := httptest.NewRecorder()
+			apiMu.Lock()
+			api.httpDeployedVersionPush(w, req)
+			apiMu.Unlock()
+			res := w.Result()
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			prefix := fmt.Sprintf("%s\nAPI.httpDeployedVersionPush()", packageName)
+
+			// THEN: the expected status code is returned.
+			if got, want := res.StatusCode, tc.wants.statusCode; got != want {
+				t.Errorf(
+					"%s status code mismatch\ngot:  %d\nwant: %d",
+					prefix, got, want,
+				)
+			}
+
+			// AND: the expected Retry-After header is returned.
+			if got := res.Header.Get("Retry-After"); got != tc.wants.retryAfter {
+				t.Errorf(
+					"%s Retry-After mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wants.retryAfter,
+				)
+			}
+
+			// AND: the expected body is returned.
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf(
+					"%s unexpected error:\n%v",
+					prefix, err,
+				)
+			}
+			if got := string(data); !util.RegexCheck(tc.wants.bodyRegex, got) {
+				t.Errorf(
+					"%s body mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wants.bodyRegex,
+				)
+			}
+
+			// AND: the DeployedVersion is expected.
+			if got := svc.Status.DeployedVersion(); got != tc.wants.deployedVersion {
+				t.Errorf(
+					"%s DeployedVersion mismatch\ngot:  %q\nwant: %q",
+					prefix, got, tc.wants.deployedVersion,
+				)
+			}
+		})
+	}
+}
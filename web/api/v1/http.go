@@ -27,6 +27,7 @@ import (
 	"github.com/vearutop/statigz/brotli"
 
 	"github.com/release-argus/Argus/config/decode"
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/logx"
 	"github.com/release-argus/Argus/util"
 	"github.com/release-argus/Argus/util/errfmt"
@@ -63,13 +64,15 @@ func (api *API) SetupRoutesAPI() {
 	//   GET, service order.
 	v1Router.HandleFunc("/service/order", api.httpServiceOrderGet).Methods(http.MethodGet)
 	//   PUT, service order (disable=order_edit).
-	v1Router.HandleFunc("/service/order", api.httpServiceOrderSet).Methods(http.MethodPut)
+	v1Router.HandleFunc("/service/order", api.audited(dbtype.AuditOrderEdit, api.httpServiceOrderSet)).Methods(http.MethodPut)
 	//   GET, service summary.
 	v1Router.HandleFunc("/service/summary", api.httpServiceSummary).Methods(http.MethodGet)
+	//   GET, service history.
+	v1Router.HandleFunc("/service/history", api.httpServiceHistory).Methods(http.MethodGet)
 	//   GET, service actions (webhooks/commands).
 	v1Router.HandleFunc("/service/actions", api.httpServiceGetActions).Methods(http.MethodGet)
 	//   POST, service actions (disable=service_actions).
-	v1Router.HandleFunc("/service/actions", api.httpServiceRunActions).Methods(http.MethodPost)
+	v1Router.HandleFunc("/service/actions", api.audited(dbtype.AuditApprove, api.httpServiceRunActions)).Methods(http.MethodPost)
 	//   GET, service - get details on specific service.
 	v1Router.HandleFunc("/service/config", api.httpServiceDetail).Methods(http.MethodGet)
 	//   GET, service - get details on service defaults.
@@ -83,15 +86,27 @@ func (api *API) SetupRoutesAPI() {
 	//   POST, service - test notify (disable=notify_test).
 	v1Router.HandleFunc("/notify/test", api.httpNotifyTest).Methods(http.MethodPost)
 	//   PUT, service - update details (disable=service_edit).
-	v1Router.HandleFunc("/service/config", api.httpServiceEdit).Methods(http.MethodPut)
+	v1Router.HandleFunc("/service/config", api.audited(dbtype.AuditServiceEdit, api.httpServiceEdit)).Methods(http.MethodPut)
 	//   PUT, service - new service (disable=service_create).
-	v1Router.HandleFunc("/service/new", api.httpServiceEdit).Methods(http.MethodPut)
+	v1Router.HandleFunc("/service/new", api.audited(dbtype.AuditServiceCreate, api.httpServiceEdit)).Methods(http.MethodPut)
 	//   DELETE, service - delete service (disable=service_delete).
-	v1Router.HandleFunc("/service/delete", api.httpServiceDelete).Methods(http.MethodDelete)
+	v1Router.HandleFunc("/service/delete", api.audited(dbtype.AuditServiceDelete, api.httpServiceDelete)).Methods(http.MethodDelete)
+	//   GET, audit log (JSON/CSV).
+	v1Router.HandleFunc("/audit", api.httpAudit).Methods(http.MethodGet)
+	//   GET, backup archive of the database (enable=backup).
+	if slices.Contains(api.Config.Settings.Web.EnabledRoutes, "backup") {
+		v1Router.HandleFunc("/backup", api.audited(dbtype.AuditBackupExport, api.httpBackup)).Methods(http.MethodGet)
+	}
+	//   POST, import a backup archive into the database (enable=restore).
+	if slices.Contains(api.Config.Settings.Web.EnabledRoutes, "restore") {
+		v1Router.HandleFunc("/restore", api.audited(dbtype.AuditBackupImport, api.httpRestore)).Methods(http.MethodPost)
+	}
 	//   GET, service - template strings.
 	v1Router.HandleFunc("/template", api.httpTemplateParse).Methods(http.MethodGet)
 	// GET, counts for Heimdall.
 	v1Router.HandleFunc("/counts", api.httpCounts).Methods(http.MethodGet)
+	// GET, deployment lead time and drift summary.
+	v1Router.HandleFunc("/deployment/summary", api.httpDeploymentSummary).Methods(http.MethodGet)
 
 	// Disable specified routes.
 	api.DisableRoutes()
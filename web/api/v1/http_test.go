@@ -339,6 +339,74 @@ func TestHTTP_SetupRoutesAPI__disableRoutes(t *testing.T) {
 	}
 }
 
+func TestHTTP_SetupRoutesAPI__optInRoutes(t *testing.T) {
+	// GIVEN: the routes opted in to.
+	tests := []struct {
+		name          string
+		method, path  string
+		enabledRoutes []string
+		wantStatus    int
+	}{
+		{
+			name:       "backup not enabled",
+			method:     http.MethodGet,
+			path:       "/api/v1/backup",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:          "backup enabled",
+			method:        http.MethodGet,
+			path:          "/api/v1/backup",
+			enabledRoutes: []string{"backup"},
+			wantStatus:    http.StatusServiceUnavailable, // No database.
+		},
+		{
+			name:          "restore not enabled",
+			method:        http.MethodPost,
+			path:          "/api/v1/restore",
+			enabledRoutes: []string{"backup"},
+			wantStatus:    http.StatusNotFound,
+		},
+		{
+			name:          "restore enabled",
+			method:        http.MethodPost,
+			path:          "/api/v1/restore",
+			enabledRoutes: []string{"restore"},
+			wantStatus:    http.StatusServiceUnavailable, // No database.
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config_test.BareConfig(t, true)
+			cfg.Settings.Web.EnabledRoutes = tc.enabledRoutes
+			api, _ := NewAPI(cfg)
+			api.SetupRoutesAPI()
+			ts := httptest.NewServer(api.Router)
+			t.Cleanup(ts.Close)
+
+			// WHEN: a request is made to the route.
+			req, err := http.NewRequest(tc.method, ts.URL+tc.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = resp.Body.Close()
+
+			// THEN: the route is only served when enabled.
+			if got := resp.StatusCode; got != tc.wantStatus {
+				t.Errorf("%s\nAPI.SetupRoutesAPI() %s %s status code mismatch\ngot:  %d\nwant: %d",
+					packageName, tc.method, tc.path, got, tc.wantStatus)
+			}
+		})
+	}
+}
+
 func TestHTTP_SetupRoutesNodeJS(t *testing.T) {
 	// GIVEN: an API with NodeJS routes.
 	tests := []struct {
@@ -0,0 +1,120 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LatestVersionDrift holds how long each service has been behind its latest version.
+//
+// The age is computed when scraped, so it grows between lookups.
+var LatestVersionDrift = newDriftCollector()
+
+func init() {
+	prometheus.MustRegister(LatestVersionDrift)
+}
+
+// DriftCollector is a prometheus.Collector of the time since each service fell behind its latest version.
+type DriftCollector struct {
+	mu    sync.RWMutex
+	desc  *prometheus.Desc
+	since map[string]time.Time // Service ID -> time the oldest undeployed version was found.
+	now   func() time.Time
+}
+
+// newDriftCollector returns a DriftCollector for 'latest_version_drift_seconds'.
+func newDriftCollector() *DriftCollector {
+	return &DriftCollector{
+		desc: prometheus.NewDesc(
+			"latest_version_drift_seconds",
+			"Time since the oldest latest version this service has not deployed was found (0=up to date/unknown).",
+			[]string{"id"}, nil,
+		),
+		since: make(map[string]time.Time),
+		now:   time.Now,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *DriftCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *DriftCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := c.now()
+	for id := range c.since {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc, prometheus.GaugeValue,
+			c.seconds(id, now),
+			id,
+		)
+	}
+}
+
+// Set records the service with the given id as behind since `since` (zero if up to date/unknown).
+func (c *DriftCollector) Set(id string, since time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.since[id] = since
+}
+
+// Delete removes the service with the given id.
+func (c *DriftCollector) Delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.since, id)
+}
+
+// Seconds returns how long the service with the given id has been behind.
+func (c *DriftCollector) Seconds(id string) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.seconds(id, c.now())
+}
+
+// seconds returns how long the service with the given id has been behind at `now`.
+func (c *DriftCollector) seconds(id string, now time.Time) float64 {
+	since := c.since[id]
+	if since.IsZero() {
+		return 0
+	}
+	return max(now.Sub(since).Seconds(), 0)
+}
+
+// ObserveDeploymentLeadTime records a deployment of the service with the given id
+// that was found `leadTime` before it was deployed.
+func ObserveDeploymentLeadTime(id string, leadTime time.Duration) {
+	DeploymentLeadTimeSeconds.WithLabelValues(id).Observe(leadTime.Seconds())
+	DeploymentLeadTimeLast.WithLabelValues(id).Set(leadTime.Seconds())
+}
+
+// DeleteDeploymentMetrics removes the drift and lead time metrics of the service with the given id.
+func DeleteDeploymentMetrics(id string) {
+	LatestVersionDrift.Delete(id)
+	DeletePrometheusGauge(LatestVersionVersionsBehind, id, "")
+	DeletePrometheusGauge(DeploymentLeadTimeLast, id, "")
+	DeploymentLeadTimeSeconds.DeleteLabelValues(id)
+}
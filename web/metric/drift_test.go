@@ -0,0 +1,60 @@
+// Copyright [2026] [Argus]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package metric
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestDriftCollector(t *testing.T) {
+	// GIVEN: a DriftCollector with a service behind, and one up to date.
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	collector := newDriftCollector()
+	collector.now = func() time.Time { return now }
+	collector.Set("behind", now.Add(-90*time.Minute))
+	collector.Set("current", time.Time{})
+
+	// WHEN: it is collected.
+	// THEN: the drift of each service is reported.
+	want := `
+		# HELP latest_version_drift_seconds Time since the oldest latest version this service has not deployed was found (0=up to date/unknown).
+		# TYPE latest_version_drift_seconds gauge
+		latest_version_drift_seconds{id="behind"} 5400
+		latest_version_drift_seconds{id="current"} 0
+	`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want)); err != nil {
+		t.Errorf("%s\nDriftCollector.Collect() mismatch\n%v",
+			packageName, err)
+	}
+	if got := collector.Seconds("behind"); got != 5400 {
+		t.Errorf("%s\nDriftCollector.Seconds() mismatch\ngot:  %f\nwant: 5400",
+			packageName, got)
+	}
+
+	// WHEN: a service is deleted.
+	collector.Delete("behind")
+
+	// THEN: it is no longer reported.
+	if got := testutil.CollectAndCount(collector); got != 1 {
+		t.Errorf("%s\nDriftCollector.Delete() count mismatch\ngot:  %d\nwant: 1",
+			packageName, got)
+	}
+}
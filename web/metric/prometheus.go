@@ -33,6 +33,18 @@ const (
 	LatestVersionUnknown
 )
 
+// LatestVersionBumpLevel is the semantic version bump from the deployed version to the latest version,
+// as reported by the latest_version_bump metric.
+type LatestVersionBumpLevel int
+
+const (
+	LatestVersionBumpNone LatestVersionBumpLevel = iota
+	LatestVersionBumpPrerelease
+	LatestVersionBumpPatch
+	LatestVersionBumpMinor
+	LatestVersionBumpMajor
+)
+
 type LatestVersionQueryResult int
 
 const (
@@ -49,6 +61,16 @@ const (
 	DeployedVersionQueryResultSuccess
 )
 
+type DeployedVersionFleetState int
+
+const (
+	DeployedVersionFleetUnknown DeployedVersionFleetState = iota
+	DeployedVersionFleetLatest
+	DeployedVersionFleetPartial
+	DeployedVersionFleetMixed
+	DeployedVersionFleetOutdated
+)
+
 // Prometheus metric.
 var (
 	// ServiceCountCurrent holds the number of services in the configuration.
@@ -107,6 +129,28 @@ var (
 			"result",
 		},
 	)
+	// DeployedVersionFleetStateLast holds the aggregate state of the instances of a service - [DeployedVersionFleetState].
+	DeployedVersionFleetStateLast = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployed_version_fleet_state",
+			Help: "Aggregate state of the versions reported by this service's instances (0=unknown, 1=latest, 2=partial, 3=mixed, 4=outdated).",
+		},
+		[]string{
+			"id",
+		},
+	)
+	// DeployedVersionInstance holds the version reported by each instance of a service.
+	DeployedVersionInstance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployed_version_instance",
+			Help: "Version reported by each of this service's instances (1 for the current version of the instance).",
+		},
+		[]string{
+			"id",
+			"instance",
+			"version",
+		},
+	)
 	// LatestVersionIsDeployed tracks the deployment state of the latest version - [LatestVersionDeployedState].
 	LatestVersionIsDeployed = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -117,6 +161,49 @@ var (
 			"id",
 		},
 	)
+	// LatestVersionBump tracks the semantic version bump level from the deployed version to the latest version - [LatestVersionBumpLevel].
+	LatestVersionBump = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "latest_version_bump",
+			Help: "Semantic version bump from this service's deployed version to its latest version (0=none/unknown, 1=prerelease, 2=patch, 3=minor, 4=major).",
+		},
+		[]string{
+			"id",
+		},
+	)
+	// LatestVersionVersionsBehind tracks the number of latest versions found since the deployed version.
+	LatestVersionVersionsBehind = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "latest_version_versions_behind",
+			Help: "Number of latest versions found that this service's deployed version is behind (0=up to date/unknown).",
+		},
+		[]string{
+			"id",
+		},
+	)
+	// DeploymentLeadTimeLast holds the lead time of the last deployment.
+	DeploymentLeadTimeLast = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployment_lead_time_last_seconds",
+			Help: "Time from this service's last deployed version being found, to it being deployed.",
+		},
+		[]string{
+			"id",
+		},
+	)
+	// DeploymentLeadTimeSeconds tracks the time from a version being found, to it being deployed.
+	DeploymentLeadTimeSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "deployment_lead_time_seconds",
+			Help: "Time from a latest version being found, to it being deployed.",
+			Buckets: []float64{
+				5 * 60, 15 * 60, 60 * 60, 3 * 60 * 60, 6 * 60 * 60, 12 * 60 * 60,
+				24 * 60 * 60, 2 * 24 * 60 * 60, 7 * 24 * 60 * 60, 14 * 24 * 60 * 60, 30 * 24 * 60 * 60},
+		},
+		[]string{
+			"id",
+		},
+	)
 	// UpdatesCurrent tracks the count of updates available/skipped.
 	UpdatesCurrent = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -164,6 +251,27 @@ var (
 			"service_id",
 		},
 	)
+	// HTTPRequestQueueCurrent holds the number of outbound requests waiting for a request limit.
+	HTTPRequestQueueCurrent = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "http_request_queue_current",
+			Help: "Number of outbound requests waiting for a rate/concurrency limit.",
+		},
+		[]string{
+			"host",
+		},
+	)
+	// HTTPRequestWaitSeconds tracks how long outbound requests waited for a request limit.
+	HTTPRequestWaitSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_wait_seconds",
+			Help:    "Time outbound requests waited for a rate/concurrency limit.",
+			Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{
+			"host",
+		},
+	)
 )
 
 // ActionResult* constants are used as 'result' label values for:
@@ -175,6 +283,11 @@ const (
 	ActionResultFail    = "FAIL"
 )
 
+// ActionResultNotModified is used as a 'result' label value for
+// deployed_version_query_result_total and latest_version_query_result_total
+// when a conditional request returned a 304 Not Modified.
+const ActionResultNotModified = "NOT_MODIFIED"
+
 // ServiceState* constants are used as 'state' label values for service_count_current.
 const (
 	ServiceStateActive   = "active"
@@ -302,6 +415,62 @@ func GetVersionDeployedState(serviceInfo serviceinfo.ServiceInfo) LatestVersionD
 	}
 }
 
+// GetVersionBumpLevel returns the semantic version bump level of the latest version.
+//
+// Returns:
+// - LatestVersionBumpMajor: The major version increased.
+// - LatestVersionBumpMinor: The minor version increased.
+// - LatestVersionBumpPatch: The patch version increased.
+// - LatestVersionBumpPrerelease: Only the prerelease/metadata changed.
+// - LatestVersionBumpNone: No newer latest version, or a version is not semantic.
+func GetVersionBumpLevel(serviceInfo serviceinfo.ServiceInfo) LatestVersionBumpLevel {
+	switch serviceInfo.Bump {
+	case serviceinfo.BumpMajor:
+		return LatestVersionBumpMajor
+	case serviceinfo.BumpMinor:
+		return LatestVersionBumpMinor
+	case serviceinfo.BumpPatch:
+		return LatestVersionBumpPatch
+	case serviceinfo.BumpPrerelease:
+		return LatestVersionBumpPrerelease
+	default:
+		return LatestVersionBumpNone
+	}
+}
+
+// GetFleetState returns the metric value of a [serviceinfo.FleetState].
+func GetFleetState(serviceInfo serviceinfo.ServiceInfo) DeployedVersionFleetState {
+	switch serviceInfo.FleetState {
+	case serviceinfo.FleetLatest:
+		return DeployedVersionFleetLatest
+	case serviceinfo.FleetPartial:
+		return DeployedVersionFleetPartial
+	case serviceinfo.FleetMixed:
+		return DeployedVersionFleetMixed
+	case serviceinfo.FleetOutdated:
+		return DeployedVersionFleetOutdated
+	default:
+		return DeployedVersionFleetUnknown
+	}
+}
+
+// SetDeployedVersionInstance sets the version reported by an instance of the service with the given id,
+// removing any previous version of that instance.
+func SetDeployedVersionInstance(id, instance, version string) {
+	DeleteDeployedVersionInstance(id, instance)
+	DeployedVersionInstance.WithLabelValues(id, instance, version).Set(1)
+}
+
+// DeleteDeployedVersionInstance removes the version of an instance of the service with the given id.
+func DeleteDeployedVersionInstance(id, instance string) {
+	DeployedVersionInstance.DeletePartialMatch(prometheus.Labels{"id": id, "instance": instance})
+}
+
+// DeleteDeployedVersionInstances removes the versions of every instance of the service with the given id.
+func DeleteDeployedVersionInstances(id string) {
+	DeployedVersionInstance.DeletePartialMatch(prometheus.Labels{"id": id})
+}
+
 // SetUpdatesCurrent updates the UpdatesCurrent Prometheus metric with the given delta.
 // The metric is updated based on the given result value, which indicates the status:
 //   - LatestVersionDeployed: Latest version deployed (does not modify metric).
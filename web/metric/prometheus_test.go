@@ -193,6 +193,11 @@ func TestPrometheusGaugeVec(t *testing.T) {
 			metric: LatestVersionIsDeployed,
 			args:   []string{"SERVICE_ID", ""},
 		},
+		{
+			name:   "DeployedVersionFleetStateLast",
+			metric: DeployedVersionFleetStateLast,
+			args:   []string{"SERVICE_ID", ""},
+		},
 	}
 
 	for _, tc := range tests {
@@ -480,3 +485,112 @@ func TestMetricsAndVersionState(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVersionBumpLevel(t *testing.T) {
+	// GIVEN: a ServiceInfo with a Bump.
+	tests := []struct {
+		bump string
+		want LatestVersionBumpLevel
+	}{
+		{bump: serviceinfo.BumpNone, want: LatestVersionBumpNone},
+		{bump: serviceinfo.BumpPrerelease, want: LatestVersionBumpPrerelease},
+		{bump: serviceinfo.BumpPatch, want: LatestVersionBumpPatch},
+		{bump: serviceinfo.BumpMinor, want: LatestVersionBumpMinor},
+		{bump: serviceinfo.BumpMajor, want: LatestVersionBumpMajor},
+		{bump: "unknown", want: LatestVersionBumpNone},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.bump, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: GetVersionBumpLevel is called.
+			got := GetVersionBumpLevel(serviceinfo.ServiceInfo{Bump: tc.bump})
+
+			// THEN: the level is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nGetVersionBumpLevel(bump=%q) mismatch\ngot:  %d\nwant: %d",
+					packageName, tc.bump, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestGetFleetState(t *testing.T) {
+	// GIVEN: a ServiceInfo with a FleetState.
+	tests := []struct {
+		fleetState string
+		want       DeployedVersionFleetState
+	}{
+		{fleetState: serviceinfo.FleetUnknown, want: DeployedVersionFleetUnknown},
+		{fleetState: serviceinfo.FleetLatest, want: DeployedVersionFleetLatest},
+		{fleetState: serviceinfo.FleetPartial, want: DeployedVersionFleetPartial},
+		{fleetState: serviceinfo.FleetMixed, want: DeployedVersionFleetMixed},
+		{fleetState: serviceinfo.FleetOutdated, want: DeployedVersionFleetOutdated},
+		{fleetState: "unknown", want: DeployedVersionFleetUnknown},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.fleetState, func(t *testing.T) {
+			t.Parallel()
+
+			// WHEN: GetFleetState is called.
+			got := GetFleetState(serviceinfo.ServiceInfo{FleetState: tc.fleetState})
+
+			// THEN: the state is as expected.
+			if got != tc.want {
+				t.Errorf(
+					"%s\nGetFleetState(fleetState=%q) mismatch\ngot:  %d\nwant: %d",
+					packageName, tc.fleetState, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestDeployedVersionInstance(t *testing.T) {
+	// GIVEN: a service with instances.
+	id := "TestDeployedVersionInstance"
+
+	// WHEN: the versions of two instances are set.
+	SetDeployedVersionInstance(id, "a", "1.2.3")
+	SetDeployedVersionInstance(id, "b", "1.2.3")
+
+	// THEN: both instances are reported.
+	if got, want := testutil.CollectAndCount(DeployedVersionInstance), 2; got != want {
+		t.Errorf(
+			"%s\nSetDeployedVersionInstance() count mismatch\ngot:  %d\nwant: %d",
+			packageName, got, want,
+		)
+	}
+
+	// WHEN: the version of an instance changes.
+	SetDeployedVersionInstance(id, "a", "1.2.4")
+
+	// THEN: the previous version of that instance is removed.
+	if got, want := testutil.CollectAndCount(DeployedVersionInstance), 2; got != want {
+		t.Errorf(
+			"%s\nSetDeployedVersionInstance() count mismatch after change\ngot:  %d\nwant: %d",
+			packageName, got, want,
+		)
+	}
+	if got := testutil.ToFloat64(DeployedVersionInstance.WithLabelValues(id, "a", "1.2.4")); got != 1 {
+		t.Errorf(
+			"%s\nSetDeployedVersionInstance() value mismatch\ngot:  %f\nwant: 1",
+			packageName, got,
+		)
+	}
+
+	// WHEN: the instances are deleted.
+	DeleteDeployedVersionInstances(id)
+
+	// THEN: no instances are reported.
+	if got, want := testutil.CollectAndCount(DeployedVersionInstance), 0; got != want {
+		t.Errorf(
+			"%s\nDeleteDeployedVersionInstances() count mismatch\ngot:  %d\nwant: %d",
+			packageName, got, want,
+		)
+	}
+}
@@ -1,4 +1,5 @@
-import type { Headers } from '@/utils/api/types/config/shared';
+import type { URLCommand } from '@/utils/api/types/config/service/latest-version';
+import type { Headers, OAuth2, Proxy, TLS } from '@/utils/api/types/config/shared';
 
 export const DEPLOYED_VERSION_LOOKUP_TYPE = {
 	MANUAL: { label: 'Manual', value: 'manual' },
@@ -40,11 +41,15 @@ export type DeployedVersionLookupURL = {
 	method?: DeployedVersionLookupURLMethod;
 	url?: string;
 	allow_invalid_certs?: boolean | null;
+	tls?: TLS;
+	proxy?: Proxy;
 	basic_auth?: BasicAuthType;
+	oauth2?: OAuth2;
 	headers?: Headers;
 	body?: string;
 	target_header?: string;
 	json?: string;
 	regex?: string;
 	regex_template?: string;
+	url_commands?: URLCommand[];
 };
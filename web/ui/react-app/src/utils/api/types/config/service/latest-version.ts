@@ -1,5 +1,15 @@
-import type { Command, Headers } from '@/utils/api/types/config/shared';
+import type {
+	Command,
+	Headers,
+	OAuth2,
+	Proxy,
+	TLS,
+} from '@/utils/api/types/config/shared';
 import type { NullString } from '@/utils/api/types/config-edit/shared/null-string';
+import type {
+	BasicAuthType,
+	DeployedVersionLookupURLMethod,
+} from '@/utils/api/types/config/service/deployed-version';
 
 export const LATEST_VERSION_LOOKUP_TYPE = {
 	GITHUB: { label: 'GitHub', value: 'github' },
@@ -32,7 +42,9 @@ export type LatestVersionLookupGitHubDefaults = {
 };
 // URL-specific defaults.
 export type LatestVersionLookupURLDefaults = {
+	method?: DeployedVersionLookupURLMethod;
 	allow_invalid_certs?: boolean | null;
+	tls?: TLS;
 };
 
 export type LatestVersionLookupDefaults = {
@@ -179,11 +191,19 @@ export type LatestVersionLookupGitHub = LatestVersionLookupBase & {
 	type: typeof LATEST_VERSION_LOOKUP_TYPE.GITHUB.value | null;
 	access_token?: string;
 	use_prerelease?: boolean;
+	proxy?: Proxy;
 };
 
 /* Type: url */
 export type LatestVersionLookupURL = LatestVersionLookupBase & {
 	type: typeof LATEST_VERSION_LOOKUP_TYPE.URL.value | null;
+	method?: DeployedVersionLookupURLMethod;
 	allow_invalid_certs?: boolean;
+	tls?: TLS;
+	proxy?: Proxy;
 	headers?: Headers;
+	basic_auth?: BasicAuthType;
+	oauth2?: OAuth2;
+	body?: string;
+	target_header?: string;
 };
@@ -1,5 +1,24 @@
 export type ServiceOptions = {
 	active?: boolean;
 	interval?: string;
+	schedule?: string;
+	jitter?: string;
+	active_hours?: string[];
+	backoff?: string;
+	backoff_max?: string;
 	semantic_versioning?: boolean | null;
+	reminder?: ServiceReminder;
+};
+
+export type ReminderCadence = {
+	after?: string;
+	every?: string;
+	escalate_after?: string;
+};
+
+export type ServiceReminder = ReminderCadence & {
+	escalate_notify?: string[];
+	major?: ReminderCadence;
+	minor?: ReminderCadence;
+	patch?: ReminderCadence;
 };
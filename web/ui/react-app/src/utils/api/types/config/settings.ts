@@ -1,3 +1,5 @@
+import type { Proxy } from '@/utils/api/types/config/shared';
+
 export type SettingsLog = {
 	level?: string;
 	timestamps?: boolean;
@@ -6,6 +8,7 @@ export type SettingsLog = {
 export type SettingsData = {
 	database_file?: string;
 	readonly?: boolean;
+	history_retention?: string;
 };
 
 export type SettingsWeb = {
@@ -16,8 +19,22 @@ export type SettingsWeb = {
 	listen_port: string;
 };
 
+export type SettingsLimit = {
+	rate?: string;
+	burst?: number;
+	max_concurrent?: number;
+};
+
+export type SettingsLimits = {
+	global?: SettingsLimit;
+	per_host?: SettingsLimit;
+	hosts?: Record<string, SettingsLimit>;
+};
+
 export type Settings = {
 	data?: SettingsData;
 	log?: SettingsLog;
 	web?: SettingsWeb;
+	proxy?: Proxy;
+	limits?: SettingsLimits;
 };
@@ -8,4 +8,24 @@ export type Header = {
 };
 export type Headers = Header[];
 
+export type OAuth2 = {
+	token_url: string;
+	client_id: string;
+	client_secret?: string;
+	scopes?: string[];
+	audience?: string;
+};
+
+export type TLS = {
+	ca_file?: string;
+	cert_file?: string;
+	key_file?: string;
+	server_name?: string;
+};
+
+export type Proxy = {
+	url?: string;
+	no_proxy?: string[];
+};
+
 export type EmptyObject = Record<string, never>;
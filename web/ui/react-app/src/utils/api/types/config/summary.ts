@@ -56,13 +56,43 @@ export type ActionModalData = {
 
 export type ServiceUpdateState = 'AVAILABLE' | 'SKIPPED' | 'UP_TO_DATE' | null;
 
+// 'none' = the bump was cleared (only sent in updates).
+export type VersionBump = 'none' | 'prerelease' | 'patch' | 'minor' | 'major';
+
+export type FleetState = 'latest' | 'partial' | 'mixed' | 'outdated';
+
+export type InstanceSummaryType = {
+	name: string;
+	version?: string;
+	timestamp?: string;
+	error?: string;
+};
+
+export type RetrySummaryType = {
+	failures: number;
+	backoff?: string;
+	next_attempt?: string;
+};
+
+// Empty = the rollout completed (only sent in updates).
+export type RolloutSummaryType = {
+	version?: string;
+	progress?: string;
+};
+
 export type StatusSummaryType = {
 	approved_version?: string;
 	deployed_version?: string;
 	deployed_version_timestamp?: string;
 	latest_version?: string;
 	latest_version_timestamp?: string;
+	bump?: VersionBump;
+	fleet_state?: FleetState;
+	instances?: InstanceSummaryType[];
+	deployed_version_rollout?: RolloutSummaryType;
 	last_queried?: string;
+	latest_version_retry?: RetrySummaryType;
+	deployed_version_retry?: RetrySummaryType;
 	state?: ServiceUpdateState;
 };
 
@@ -92,3 +122,70 @@ export type ActionAPIType = {
 	command: CommandSummaryListType;
 	webhook: WebHookSummaryListType;
 };
+
+export type HistoryEventType = {
+	id: number;
+	type: 'latest' | 'deployed' | 'approved' | 'skipped' | 'reminded';
+	version?: string;
+	previous_version?: string;
+	source?: string;
+	timestamp: string;
+};
+
+export type HistoryAPIType = {
+	events: HistoryEventType[];
+	total: number;
+	limit: number;
+	offset: number;
+};
+
+export type AuditEntryType = {
+	id: number;
+	timestamp: string;
+	actor: string;
+	ip?: string;
+	action: string;
+	service_id?: string;
+	target?: string;
+	outcome: 'success' | 'failure' | 'accepted';
+	detail?: string;
+};
+
+export type AuditAPIType = {
+	entries: AuditEntryType[];
+	total: number;
+	limit: number;
+	offset: number;
+};
+
+export type RestoreAPIType = {
+	mode: 'merge' | 'replace';
+	services: string[];
+	unknown?: string[];
+	history: number;
+	audit: number;
+};
+
+export type DurationSummaryType = {
+	count: number;
+	mean: number;
+	median: number;
+	p90: number;
+	max: number;
+};
+
+export type DeploymentServiceType = {
+	id: string;
+	versions_behind: number;
+	drift_seconds: number;
+	lead_time_seconds?: number;
+};
+
+export type DeploymentSummaryAPIType = {
+	service_count: number;
+	services_behind: number;
+	versions_behind: number;
+	lead_time: DurationSummaryType;
+	drift: DurationSummaryType;
+	services: DeploymentServiceType[];
+};
@@ -1,4 +1,4 @@
-import type { Headers } from '@/utils/api/types/config/shared';
+import type { Headers, OAuth2, Proxy, TLS } from '@/utils/api/types/config/shared';
 
 export const WEBHOOK_TYPE = {
 	GITHUB: { label: 'GitHub', value: 'github' },
@@ -16,7 +16,10 @@ export type WebHook = {
 	type?: WebHookType | null;
 	url?: string;
 	allow_invalid_certs?: boolean | null;
+	tls?: TLS;
+	proxy?: Proxy;
 	headers?: Headers;
+	oauth2?: OAuth2;
 	secret?: string;
 	desired_status_code?: number;
 	delay?: string;
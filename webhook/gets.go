@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/util"
 )
 
@@ -73,6 +74,33 @@ func (w *WebHook) GetAllowInvalidCerts() bool {
 	)
 }
 
+// GetTLS resolves the TLS settings, merging those of the WebHook with its Main and Defaults.
+func (w *WebHook) GetTLS() *httpx.TLS {
+	return httpx.MergeTLS(
+		w.TLS,
+		w.Main.TLS,
+		w.Defaults.TLS,
+	)
+}
+
+// GetProxy resolves the proxy to send the WebHook through.
+func (w *WebHook) GetProxy() *httpx.Proxy {
+	return util.FirstNonNilPtr(
+		w.Proxy,
+		w.Main.Proxy,
+		w.Defaults.Proxy,
+	)
+}
+
+// GetOAuth2 resolves the OAuth2 client-credentials to get a bearer token with.
+func (w *WebHook) GetOAuth2() *httpx.OAuth2 {
+	return util.FirstNonNilPtr(
+		w.OAuth2,
+		w.Main.OAuth2,
+		w.Defaults.OAuth2,
+	)
+}
+
 // GetDelay resolves the delay to use before auto-approving the WebHook.
 func (w *WebHook) GetDelay() string {
 	return util.FirstNonDefault(
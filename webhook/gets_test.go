@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/dashboard"
 	"github.com/release-argus/Argus/service/status"
@@ -229,6 +230,178 @@ func TestWebHook_GetAllowInvalidCerts(t *testing.T) {
 	}
 }
 
+func TestWebHook_GetTLS(t *testing.T) {
+	// GIVEN: a WebHook.
+	tests := []struct {
+		name                               string
+		rootValue, mainValue, defaultValue *httpx.TLS
+		want                               *httpx.TLS
+	}{
+		{
+			name: "none",
+			want: nil,
+		},
+		{
+			name:         "root overrides all",
+			rootValue:    &httpx.TLS{ServerName: "root"},
+			mainValue:    &httpx.TLS{ServerName: "main"},
+			defaultValue: &httpx.TLS{ServerName: "default"},
+			want:         &httpx.TLS{ServerName: "root"},
+		},
+		{
+			name:         "fields merged",
+			rootValue:    &httpx.TLS{ServerName: "root"},
+			mainValue:    &httpx.TLS{CAFile: "ca.pem"},
+			defaultValue: &httpx.TLS{CertFile: "cert.pem", KeyFile: "key.pem"},
+			want: &httpx.TLS{
+				CAFile:     "ca.pem",
+				CertFile:   "cert.pem",
+				KeyFile:    "key.pem",
+				ServerName: "root",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := testWebHook(true, false, false)
+			webhook.TLS = tc.rootValue
+			webhook.Main.TLS = tc.mainValue
+			webhook.Defaults.TLS = tc.defaultValue
+
+			// WHEN: GetTLS is called.
+			got := webhook.GetTLS()
+
+			// THEN: the function returns the correct result.
+			if (got == nil) != (tc.want == nil) ||
+				(got != nil && *got != *tc.want) {
+				t.Errorf(
+					"%s\nWebHook.GetTLS() value mismatch\ngot:  %+v\nwant: %+v",
+					packageName, got, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestWebHook_GetProxy(t *testing.T) {
+	// GIVEN: a WebHook.
+	tests := []struct {
+		name                               string
+		rootValue, mainValue, defaultValue *httpx.Proxy
+		want                               string
+	}{
+		{
+			name: "none",
+			want: "",
+		},
+		{
+			name:         "root overrides all",
+			rootValue:    &httpx.Proxy{URL: "http://root:3128"},
+			mainValue:    &httpx.Proxy{URL: "http://main:3128"},
+			defaultValue: &httpx.Proxy{URL: "http://default:3128"},
+			want:         "http://root:3128",
+		},
+		{
+			name:         "main overrides default",
+			mainValue:    &httpx.Proxy{URL: "http://main:3128"},
+			defaultValue: &httpx.Proxy{URL: "http://default:3128"},
+			want:         "http://main:3128",
+		},
+		{
+			name:         "default",
+			defaultValue: &httpx.Proxy{URL: "http://default:3128"},
+			want:         "http://default:3128",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := testWebHook(true, false, false)
+			webhook.Proxy = tc.rootValue
+			webhook.Main.Proxy = tc.mainValue
+			webhook.Defaults.Proxy = tc.defaultValue
+
+			// WHEN: GetProxy is called.
+			got := webhook.GetProxy()
+
+			// THEN: the function returns the correct result.
+			gotURL := ""
+			if got != nil {
+				gotURL = got.URL
+			}
+			if gotURL != tc.want {
+				t.Errorf(
+					"%s\nWebHook.GetProxy() value mismatch\ngot:  %q\nwant: %q",
+					packageName, gotURL, tc.want,
+				)
+			}
+		})
+	}
+}
+
+func TestWebHook_GetOAuth2(t *testing.T) {
+	// GIVEN: a WebHook.
+	tests := []struct {
+		name                               string
+		rootValue, mainValue, defaultValue *httpx.OAuth2
+		want                               string
+	}{
+		{
+			name:         "root overrides all",
+			want:         "root",
+			rootValue:    &httpx.OAuth2{ClientID: "root"},
+			mainValue:    &httpx.OAuth2{ClientID: "main"},
+			defaultValue: &httpx.OAuth2{ClientID: "default"},
+		},
+		{
+			name:         "main overrides default",
+			want:         "main",
+			mainValue:    &httpx.OAuth2{ClientID: "main"},
+			defaultValue: &httpx.OAuth2{ClientID: "default"},
+		},
+		{
+			name:         "default is last resort",
+			want:         "default",
+			defaultValue: &httpx.OAuth2{ClientID: "default"},
+		},
+		{
+			name: "none",
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := testWebHook(true, false, false)
+			webhook.OAuth2 = tc.rootValue
+			webhook.Main.OAuth2 = tc.mainValue
+			webhook.Defaults.OAuth2 = tc.defaultValue
+
+			// WHEN: GetOAuth2 is called.
+			got := webhook.GetOAuth2()
+
+			// THEN: the function returns the correct result.
+			var gotClientID string
+			if got != nil {
+				gotClientID = got.ClientID
+			}
+			if gotClientID != tc.want {
+				t.Errorf(
+					"%s\nWebHook.GetOAuth2() value mismatch\ngot:  %q\nwant: %q",
+					packageName, gotClientID, tc.want,
+				)
+			}
+		})
+	}
+}
+
 func TestWebHook_GetDelay(t *testing.T) {
 	// GIVEN: a WebHook.
 	tests := []struct {
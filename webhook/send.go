@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	dbtype "github.com/release-argus/Argus/db/types"
 	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/logx"
 	serviceinfo "github.com/release-argus/Argus/service/status/info"
@@ -91,6 +92,7 @@ func (w *WebHook) Send(serviceInfo serviceinfo.ServiceInfo, useDelay bool) error
 		w.ServiceStatus.Deleting,
 	)
 	if sendErrs == nil {
+		w.ServiceStatus.Audit(dbtype.AuditWebHookSend, w.ID, nil)
 		return nil
 	}
 
@@ -102,6 +104,7 @@ func (w *WebHook) Send(serviceInfo serviceinfo.ServiceInfo, useDelay bool) error
 	failed := true
 	w.SetFail(&failed)
 	w.AnnounceSend()
+	w.ServiceStatus.Audit(dbtype.AuditWebHookSend, w.ID, err)
 	if !w.GetSilentFails() {
 		//#nosec G104 -- Errors are logged to CLI
 		//nolint:errcheck // ^
@@ -133,10 +136,13 @@ func (w *WebHook) try(logFrom logx.LogFrom) error {
 	req = req.WithContext(ctx)
 	defer cancel()
 
-	client := httpx.Client
-	// HTTPS insecure skip verify.
-	if w.GetAllowInvalidCerts() {
-		client = httpx.InsecureClient
+	client, err := httpx.ClientFor(w.GetTLS(), w.GetProxy(), w.GetAllowInvalidCerts())
+	if err != nil {
+		return fmt.Errorf("tls: %w", err)
+	}
+	// OAuth2.
+	if err := w.GetOAuth2().Authorize(req, client); err != nil {
+		return err //nolint:wrapcheck
 	}
 
 	resp, err := client.Do(req)
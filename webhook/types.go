@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/release-argus/Argus/config/decode"
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/notify/shoutrrr"
 	"github.com/release-argus/Argus/service/status"
 	"github.com/release-argus/Argus/util"
@@ -52,16 +53,19 @@ type Headers []Header
 
 // Base is the base struct for WebHook.
 type Base struct {
-	Type              string  `json:"type,omitzero" yaml:"type,omitzero"`                               // "github"/"url".
-	URL               string  `json:"url,omitzero" yaml:"url,omitzero"`                                 // "https://example.com".
-	AllowInvalidCerts *bool   `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
-	CustomHeaders     Headers `json:"custom_headers,omitempty" yaml:"custom_headers,omitempty"`         // Deprecated: Use Headers.
-	Headers           Headers `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Custom Headers for the WebHook.
-	Secret            string  `json:"secret,omitzero" yaml:"secret,omitzero"`                           // 'SECRET'.
-	DesiredStatusCode *uint16 `json:"desired_status_code,omitzero" yaml:"desired_status_code,omitzero"` // e.g. 202.
-	Delay             string  `json:"delay,omitzero" yaml:"delay,omitzero"`                             // The delay before sending the WebHook.
-	MaxTries          *uint8  `json:"max_tries,omitzero" yaml:"max_tries,omitzero"`                     // Number of times to attempt sending the WebHook until we receive the desired status code.
-	SilentFails       *bool   `json:"silent_fails,omitzero" yaml:"silent_fails,omitzero"`               // Whether to notify if this WebHook fails MaxTries times.
+	Type              string        `json:"type,omitzero" yaml:"type,omitzero"`                               // "github"/"url".
+	URL               string        `json:"url,omitzero" yaml:"url,omitzero"`                                 // "https://example.com".
+	AllowInvalidCerts *bool         `json:"allow_invalid_certs,omitzero" yaml:"allow_invalid_certs,omitzero"` // Default - false = Disallows invalid HTTPS certificates.
+	TLS               *httpx.TLS    `json:"tls,omitzero" yaml:"tls,omitzero"`                                 // CA bundle, client certificate and server name.
+	Proxy             *httpx.Proxy  `json:"proxy,omitzero" yaml:"proxy,omitzero"`                             // HTTP/SOCKS5 proxy to send the WebHook through.
+	CustomHeaders     Headers       `json:"custom_headers,omitempty" yaml:"custom_headers,omitempty"`         // Deprecated: Use Headers.
+	Headers           Headers       `json:"headers,omitempty" yaml:"headers,omitempty"`                       // Custom Headers for the WebHook.
+	Secret            string        `json:"secret,omitzero" yaml:"secret,omitzero"`                           // 'SECRET'.
+	OAuth2            *httpx.OAuth2 `json:"oauth2,omitzero" yaml:"oauth2,omitzero"`                           // OAuth2 client-credentials to get a bearer token with.
+	DesiredStatusCode *uint16       `json:"desired_status_code,omitzero" yaml:"desired_status_code,omitzero"` // e.g. 202.
+	Delay             string        `json:"delay,omitzero" yaml:"delay,omitzero"`                             // The delay before sending the WebHook.
+	MaxTries          *uint8        `json:"max_tries,omitzero" yaml:"max_tries,omitzero"`                     // Number of times to attempt sending the WebHook until we receive the desired status code.
+	SilentFails       *bool         `json:"silent_fails,omitzero" yaml:"silent_fails,omitzero"`               // Whether to notify if this WebHook fails MaxTries times.
 }
 
 // WebHooksDefaults is a string map of Defaults.
@@ -235,8 +239,11 @@ func (w *WebHook) Copy(serviceStatus *status.Status, notifiers Notifiers) *WebHo
 			Type:              w.Type,
 			URL:               w.URL,
 			AllowInvalidCerts: util.ClonePtr(w.AllowInvalidCerts),
+			TLS:               w.TLS.Copy(),
+			Proxy:             w.Proxy.Copy(),
 			Headers:           util.CopySlice(w.Headers),
 			Secret:            w.Secret,
+			OAuth2:            w.OAuth2.Copy(),
 			DesiredStatusCode: util.ClonePtr(w.DesiredStatusCode),
 			Delay:             w.Delay,
 			MaxTries:          util.ClonePtr(w.MaxTries),
@@ -269,8 +276,8 @@ func (whd WebHooksDefaults) IsZero() bool {
 
 // IsZero implements the yaml.IsZeroer interface.
 func (d *Defaults) IsZero() bool {
-	return d == nil || (d.Type == "" && d.URL == "" && d.AllowInvalidCerts == nil &&
-		len(d.Headers) == 0 && d.Secret == "" && d.DesiredStatusCode == nil &&
+	return d == nil || (d.Type == "" && d.URL == "" && d.AllowInvalidCerts == nil && d.TLS == nil && d.Proxy == nil &&
+		len(d.Headers) == 0 && d.Secret == "" && d.OAuth2 == nil && d.DesiredStatusCode == nil &&
 		d.Delay == "" && d.MaxTries == nil && d.SilentFails == nil)
 }
 
@@ -285,8 +292,8 @@ func (w *WebHooks) IsZero() bool {
 
 // IsDefault reports whether all WebHook fields are at their default (zero) values.
 func (w *WebHook) IsDefault() bool {
-	return w.Type == "" && w.URL == "" && w.AllowInvalidCerts == nil && len(w.Headers) == 0 &&
-		w.Secret == "" && w.DesiredStatusCode == nil && w.Delay == "" &&
+	return w.Type == "" && w.URL == "" && w.AllowInvalidCerts == nil && w.TLS == nil && w.Proxy == nil && len(w.Headers) == 0 &&
+		w.Secret == "" && w.OAuth2 == nil && w.DesiredStatusCode == nil && w.Delay == "" &&
 		w.MaxTries == nil && w.SilentFails == nil
 }
 
@@ -206,6 +206,36 @@ func (b *Base) CheckValues() (error, bool) {
 			)
 		}
 	}
+	// tls
+	if err := b.TLS.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "tls",
+				Err: err,
+			},
+		)
+	}
+	// proxy
+	if err := b.Proxy.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "proxy",
+				Err: err,
+			},
+		)
+	}
+	// oauth2
+	if err := b.OAuth2.CheckValues(); err != nil {
+		errs = append(
+			errs,
+			&decode.ErrKeyField{
+				Key: "oauth2",
+				Err: err,
+			},
+		)
+	}
 	// delay
 	if b.Delay != "" {
 		// Treat integers as seconds by default.
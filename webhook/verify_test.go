@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/release-argus/Argus/internal/httpx"
 	"github.com/release-argus/Argus/internal/test"
 	"github.com/release-argus/Argus/service/dashboard"
 	"github.com/release-argus/Argus/service/status"
@@ -273,6 +274,9 @@ func TestWebHook_CheckValues(t *testing.T) {
 		url, secret      *string
 		customHeaders    Headers
 		headers          Headers
+		tls              *httpx.TLS
+		proxy            *httpx.Proxy
+		oauth2           *httpx.OAuth2
 		errRegex         string
 		changed          bool
 	}{
@@ -344,6 +348,40 @@ func TestWebHook_CheckValues(t *testing.T) {
 				{Key: "bar", Value: "{{ version }"},
 			},
 		},
+		{
+			name: "invalid tls",
+			errRegex: test.TrimYAML(`
+				^tls:
+					key_file: <required>.*$`,
+			),
+			tls: &httpx.TLS{CertFile: "cert.pem"},
+		},
+		{
+			name: "invalid proxy",
+			errRegex: test.TrimYAML(`
+				^proxy:
+					url: <required>.*$`,
+			),
+			proxy: &httpx.Proxy{},
+		},
+		{
+			name: "valid oauth2",
+			oauth2: &httpx.OAuth2{
+				TokenURL: "https://example.com/token",
+				ClientID: "argus",
+			},
+		},
+		{
+			name: "invalid oauth2",
+			errRegex: test.TrimYAML(`
+				^oauth2:
+					token_url: "[^"]+" <invalid>.*$`,
+			),
+			oauth2: &httpx.OAuth2{
+				TokenURL: "not a url",
+				ClientID: "argus",
+			},
+		},
 		{
 			name: "custom_headers -> headers",
 			customHeaders: Headers{
@@ -388,6 +426,9 @@ func TestWebHook_CheckValues(t *testing.T) {
 			}
 			input.CustomHeaders = tc.customHeaders
 			input.Headers = tc.headers
+			input.TLS = tc.tls
+			input.Proxy = tc.proxy
+			input.OAuth2 = tc.oauth2
 
 			// THEN: any error is as expected, and changed state matches expected.
 			_, _ = test.AssertCheckValuesWithErrorAndChanged(